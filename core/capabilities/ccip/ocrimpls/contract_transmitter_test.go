@@ -624,6 +624,10 @@ func (g *TestGasEstimatorConfig) FeeHistory() evmconfig.FeeHistory {
 	return &TestFeeHistoryConfig{}
 }
 
+func (g *TestGasEstimatorConfig) Composite() evmconfig.Composite {
+	return &TestCompositeConfig{}
+}
+
 func (g *TestGasEstimatorConfig) EIP1559DynamicFees() bool   { return false }
 func (g *TestGasEstimatorConfig) LimitDefault() uint64       { return 1e6 }
 func (g *TestGasEstimatorConfig) BumpPercent() uint16        { return 2 }
@@ -639,6 +643,7 @@ func (g *TestGasEstimatorConfig) BumpTxDepth() uint32        { return 42 }
 func (g *TestGasEstimatorConfig) LimitTransfer() uint64      { return 42 }
 func (g *TestGasEstimatorConfig) PriceMax() *assets.Wei      { return assets.GWei(1) }
 func (g *TestGasEstimatorConfig) PriceMin() *assets.Wei      { return assets.GWei(1) }
+func (g *TestGasEstimatorConfig) BlobPriceMax() *assets.Wei  { return assets.GWei(1) }
 func (g *TestGasEstimatorConfig) Mode() string               { return "FixedPrice" }
 func (g *TestGasEstimatorConfig) LimitJobType() evmconfig.LimitJobType {
 	return &TestLimitJobTypeConfig{}
@@ -676,6 +681,10 @@ type TestFeeHistoryConfig struct {
 	evmconfig.FeeHistory
 }
 
+type TestCompositeConfig struct {
+	evmconfig.Composite
+}
+
 type transactionsConfig struct {
 	evmconfig.Transactions
 	e         *TestEvmConfig
@@ -36,12 +36,35 @@ func NewHeadSaver(lggr logger.Logger, orm ORM, config commontypes.Config, htConf
 }
 
 func (hs *headSaver) Save(ctx context.Context, head *evmtypes.Head) error {
+	previous := hs.heads.LatestHead()
 	// adding new head might form a cycle, so it's better to validate cached chain before persisting it
 	if err := hs.heads.AddHeads(head); err != nil {
 		return err
 	}
 
-	return hs.orm.IdempotentInsertHead(ctx, head)
+	if err := hs.orm.IdempotentInsertHead(ctx, head); err != nil {
+		return err
+	}
+
+	hs.recordReorg(ctx, previous, hs.heads.LatestHead())
+	return nil
+}
+
+// recordReorg records the reorg from the previous to the latest head, if any. Failing to persist it doesn't fail
+// the head, as the reorg history is only kept for auditing.
+func (hs *headSaver) recordReorg(ctx context.Context, previous, latest *evmtypes.Head) {
+	reorg := findReorg(previous, latest)
+	if reorg == nil {
+		return
+	}
+	promReorgs.WithLabelValues(latest.EVMChainID.String()).Inc()
+	promReorgDepth.WithLabelValues(latest.EVMChainID.String()).Observe(float64(reorg.Depth))
+	hs.logger.Warnw("Chain reorg detected", "depth", reorg.Depth, "commonAncestor", reorg.CommonAncestorHash,
+		"commonAncestorNumber", reorg.CommonAncestorNumber, "previousHead", previous, "newHead", latest)
+
+	if err := hs.orm.InsertReorg(ctx, reorg); err != nil {
+		hs.logger.Errorw("Failed to save reorg", "err", err, "newHead", latest)
+	}
 }
 
 func (hs *headSaver) Load(ctx context.Context, latestFinalized int64) (chain *evmtypes.Head, err error) {
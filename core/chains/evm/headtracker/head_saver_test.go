@@ -1,6 +1,7 @@
 package headtracker_test
 
 import (
+	"context"
 	"math/big"
 	"testing"
 	"time"
@@ -158,3 +159,64 @@ func TestHeadSaver_Load(t *testing.T) {
 	require.NotNil(t, uncleChain)
 	require.Equal(t, uint32(2), uncleChain.ChainLength()) // h2Uncle -> h1
 }
+
+type reorgsORM struct {
+	headtracker.ORM
+	reorgs []*headtracker.Reorg
+}
+
+func (o *reorgsORM) InsertReorg(_ context.Context, reorg *headtracker.Reorg) error {
+	o.reorgs = append(o.reorgs, reorg)
+	return nil
+}
+
+func TestHeadSaver_Save_RecordsReorgs(t *testing.T) {
+	t.Parallel()
+
+	orm := &reorgsORM{ORM: headtracker.NewNullORM()}
+	saver := headtracker.NewHeadSaver(logger.Test(t), orm, &config{finalityDepth: 1}, &headTrackerConfig{historyDepth: 10})
+	ctx := tests.Context(t)
+
+	// create chain
+	// H0 <- H1 <- H2 <- H3
+	//         \
+	//           H2' <- H3' <- H4'
+	//
+	newHead := func(num int, parent common.Hash) *evmtypes.Head {
+		h := evmtypes.NewHead(big.NewInt(int64(num)), utils.NewHash(), parent, ubig.NewI(0))
+		return &h
+	}
+	h0 := newHead(0, utils.NewHash())
+	h1 := newHead(1, h0.Hash)
+	h2 := newHead(2, h1.Hash)
+	h3 := newHead(3, h2.Hash)
+	h2Fork := newHead(2, h1.Hash)
+	h3Fork := newHead(3, h2Fork.Hash)
+	h4Fork := newHead(4, h3Fork.Hash)
+
+	for _, h := range []*evmtypes.Head{h0, h1, h2, h3} {
+		require.NoError(t, saver.Save(ctx, h))
+	}
+	require.Empty(t, orm.reorgs)
+
+	// heads of the fork lower than the latest one don't reorg the chain
+	require.NoError(t, saver.Save(ctx, h2Fork))
+	require.Empty(t, orm.reorgs)
+
+	// the fork becomes canonical once it's as high as the latest head
+	require.NoError(t, saver.Save(ctx, h3Fork))
+	require.Len(t, orm.reorgs, 1)
+	reorg := orm.reorgs[0]
+	require.Equal(t, h1.Hash, reorg.CommonAncestorHash)
+	require.Equal(t, int64(1), reorg.CommonAncestorNumber)
+	require.Equal(t, int64(2), reorg.Depth)
+	require.Equal(t, h3.Hash, reorg.PreviousHeadHash)
+	require.Equal(t, h3Fork.Hash, reorg.NewHeadHash)
+	require.Equal(t, int64(3), reorg.NewHeadNumber)
+	require.Equal(t, evmtypes.HashArray{h3.Hash, h2.Hash}, reorg.OrphanedHashes)
+
+	// saving a known head or extending the canonical chain isn't a reorg
+	require.NoError(t, saver.Save(ctx, h2))
+	require.NoError(t, saver.Save(ctx, h4Fork))
+	require.Len(t, orm.reorgs, 1)
+}
@@ -6,6 +6,7 @@ import (
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/lib/pq"
 	pkgerrors "github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
@@ -26,6 +27,10 @@ type ORM interface {
 	LatestHeads(ctx context.Context, minBlockNumber int64) (heads []*evmtypes.Head, err error)
 	// HeadByHash fetches the head with the given hash from the db, returns nil if none exists
 	HeadByHash(ctx context.Context, hash common.Hash) (head *evmtypes.Head, err error)
+	// InsertReorg saves the reorg, and sets its ID and detection time
+	InsertReorg(ctx context.Context, reorg *Reorg) error
+	// Reorgs returns a page of the reorgs, most recent first, and the total count of reorgs
+	Reorgs(ctx context.Context, offset, limit int) (reorgs []Reorg, count int, err error)
 }
 
 var _ ORM = &DbORM{}
@@ -84,6 +89,29 @@ func (orm *DbORM) HeadByHash(ctx context.Context, hash common.Hash) (head *evmty
 	return head, err
 }
 
+func (orm *DbORM) InsertReorg(ctx context.Context, reorg *Reorg) error {
+	orphaned := make(pq.ByteaArray, len(reorg.OrphanedHashes))
+	for i, hash := range reorg.OrphanedHashes {
+		orphaned[i] = hash.Bytes()
+	}
+	reorg.EVMChainID = orm.chainID
+	query := `INSERT INTO evm.reorgs (evm_chain_id, common_ancestor_hash, common_ancestor_number, depth, previous_head_hash,
+		previous_head_number, new_head_hash, new_head_number, orphaned_hashes)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) RETURNING id, detected_at`
+	err := orm.ds.QueryRowxContext(ctx, query, orm.chainID, reorg.CommonAncestorHash, reorg.CommonAncestorNumber, reorg.Depth,
+		reorg.PreviousHeadHash, reorg.PreviousHeadNumber, reorg.NewHeadHash, reorg.NewHeadNumber, orphaned).Scan(&reorg.ID, &reorg.DetectedAt)
+	return pkgerrors.Wrap(err, "InsertReorg failed")
+}
+
+func (orm *DbORM) Reorgs(ctx context.Context, offset, limit int) (reorgs []Reorg, count int, err error) {
+	if err = orm.ds.GetContext(ctx, &count, `SELECT count(*) FROM evm.reorgs WHERE evm_chain_id = $1`, orm.chainID); err != nil {
+		return nil, 0, pkgerrors.Wrap(err, "Reorgs failed to count reorgs")
+	}
+	err = orm.ds.SelectContext(ctx, &reorgs, `SELECT * FROM evm.reorgs WHERE evm_chain_id = $1 ORDER BY detected_at DESC, id DESC OFFSET $2 LIMIT $3`, orm.chainID, offset, limit)
+	err = pkgerrors.Wrap(err, "Reorgs failed")
+	return
+}
+
 type nullORM struct{}
 
 func NewNullORM() ORM {
@@ -109,3 +137,11 @@ func (orm *nullORM) LatestHeads(ctx context.Context, minBlockNumer int64) (heads
 func (orm *nullORM) HeadByHash(ctx context.Context, hash common.Hash) (head *evmtypes.Head, err error) {
 	return nil, nil
 }
+
+func (orm *nullORM) InsertReorg(ctx context.Context, reorg *Reorg) error {
+	return nil
+}
+
+func (orm *nullORM) Reorgs(ctx context.Context, offset, limit int) (reorgs []Reorg, count int, err error) {
+	return nil, 0, nil
+}
@@ -1,6 +1,7 @@
 package headtracker_test
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -11,6 +12,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker"
 	"github.com/smartcontractkit/chainlink/v2/evm/testutils"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/evm/types"
 )
 
 func TestORM_IdempotentInsertHead(t *testing.T) {
@@ -109,3 +111,47 @@ func TestORM_LatestHeads_NoRows(t *testing.T) {
 	require.Zero(t, len(heads))
 	require.NoError(t, err)
 }
+
+func TestORM_Reorgs(t *testing.T) {
+	t.Parallel()
+
+	db := testutils.NewSqlxDB(t)
+	orm := headtracker.NewORM(*testutils.FixtureChainID, db)
+	ctx := tests.Context(t)
+
+	reorgs, count, err := orm.Reorgs(ctx, 0, 10)
+	require.NoError(t, err)
+	require.Empty(t, reorgs)
+	require.Zero(t, count)
+
+	for i := int64(1); i <= 3; i++ {
+		reorg := &headtracker.Reorg{
+			CommonAncestorHash:   testutils.NewHash(),
+			CommonAncestorNumber: 10 * i,
+			Depth:                i,
+			PreviousHeadHash:     testutils.NewHash(),
+			PreviousHeadNumber:   10*i + i,
+			NewHeadHash:          testutils.NewHash(),
+			NewHeadNumber:        10*i + i,
+			OrphanedHashes:       evmtypes.HashArray{testutils.NewHash()},
+		}
+		require.NoError(t, orm.InsertReorg(ctx, reorg))
+		require.NotZero(t, reorg.ID)
+		require.False(t, reorg.DetectedAt.IsZero())
+	}
+
+	// reorgs of other chains are excluded
+	otherORM := headtracker.NewORM(*big.NewInt(1337), db)
+	require.NoError(t, otherORM.InsertReorg(ctx, &headtracker.Reorg{
+		CommonAncestorHash: testutils.NewHash(), Depth: 1, PreviousHeadHash: testutils.NewHash(), NewHeadHash: testutils.NewHash(),
+	}))
+
+	reorgs, count, err = orm.Reorgs(ctx, 1, 1)
+	require.NoError(t, err)
+	require.Equal(t, 3, count)
+	require.Len(t, reorgs, 1)
+	assert.Equal(t, int64(2), reorgs[0].Depth)
+	assert.Equal(t, int64(20), reorgs[0].CommonAncestorNumber)
+	assert.Len(t, reorgs[0].OrphanedHashes, 1)
+	assert.Equal(t, *testutils.FixtureChainID, *reorgs[0].EVMChainID.ToInt())
+}
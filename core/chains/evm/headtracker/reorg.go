@@ -0,0 +1,75 @@
+package headtracker
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	evmtypes "github.com/smartcontractkit/chainlink/v2/evm/types"
+	ubig "github.com/smartcontractkit/chainlink/v2/evm/utils/big"
+)
+
+var (
+	promReorgs = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "head_tracker_reorgs",
+		Help: "The total number of chain reorganizations detected by the head tracker",
+	}, []string{"evmChainID"})
+	promReorgDepth = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "head_tracker_reorg_depth",
+		Help:    "The number of blocks orphaned by the chain reorganizations detected by the head tracker",
+		Buckets: []float64{1, 2, 3, 5, 10, 20, 50, 100, 500},
+	}, []string{"evmChainID"})
+)
+
+// Reorg is a reorganization of the chain, detected when the latest head doesn't descend from the previous one.
+type Reorg struct {
+	ID                   int64
+	EVMChainID           ubig.Big
+	CommonAncestorHash   common.Hash
+	CommonAncestorNumber int64
+	// Depth is the number of blocks of the previous chain orphaned by the new one.
+	Depth              int64
+	PreviousHeadHash   common.Hash
+	PreviousHeadNumber int64
+	NewHeadHash        common.Hash
+	NewHeadNumber      int64
+	// OrphanedHashes are the hashes of the orphaned blocks, from the previous head down to the common ancestor.
+	OrphanedHashes evmtypes.HashArray
+	DetectedAt     time.Time
+}
+
+// findReorg returns the reorg from the previous to the latest head, or nil if the latest head descends from the
+// previous one. Only the heads linked in the cache are walked, so reorgs deeper than the history kept are not found.
+func findReorg(previous, latest *evmtypes.Head) *Reorg {
+	if previous == nil || latest == nil || previous.Hash == latest.Hash || latest.ParentHash == previous.Hash {
+		return nil
+	}
+
+	canonical := make(map[common.Hash]struct{})
+	for head := latest; head != nil; head = head.Parent.Load() {
+		canonical[head.Hash] = struct{}{}
+	}
+
+	var orphaned evmtypes.HashArray
+	for head := previous; head != nil; head = head.Parent.Load() {
+		if _, ok := canonical[head.Hash]; ok {
+			if len(orphaned) == 0 {
+				return nil
+			}
+			return &Reorg{
+				CommonAncestorHash:   head.Hash,
+				CommonAncestorNumber: head.Number,
+				Depth:                previous.Number - head.Number,
+				PreviousHeadHash:     previous.Hash,
+				PreviousHeadNumber:   previous.Number,
+				NewHeadHash:          latest.Hash,
+				NewHeadNumber:        latest.Number,
+				OrphanedHashes:       orphaned,
+			}
+		}
+		orphaned = append(orphaned, head.Hash)
+	}
+	return nil
+}
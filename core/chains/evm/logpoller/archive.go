@@ -0,0 +1,416 @@
+package logpoller
+
+import (
+	"bufio"
+	"cmp"
+	"compress/gzip"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"math/big"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	pkgerrors "github.com/pkg/errors"
+
+	ubig "github.com/smartcontractkit/chainlink/v2/evm/utils/big"
+)
+
+const (
+	// archiveVersion is bumped whenever the archive format changes in a backwards incompatible way.
+	archiveVersion = 1
+	// archiveChunkSize is the number of blocks queried at once while exporting logs.
+	archiveChunkSize = 10000
+	// archiveMaxScanTokenSize is the largest record accepted while importing an archive.
+	archiveMaxScanTokenSize = 16 * 1024 * 1024
+)
+
+// ArchiveSummary describes the contents of a log archive that was exported or imported.
+type ArchiveSummary struct {
+	FromBlock int64
+	ToBlock   int64
+	Filters   []string
+	Blocks    int
+	Logs      int
+}
+
+// An archive is a gzipped stream of newline delimited JSON records. The first record is the header,
+// followed by every block holding logs matching the filters, each immediately followed by its logs.
+type archiveRecord struct {
+	Header *archiveHeader  `json:"header,omitempty"`
+	Block  *LogPollerBlock `json:"block,omitempty"`
+	Log    *Log            `json:"log,omitempty"`
+}
+
+type archiveHeader struct {
+	Version   int       `json:"version"`
+	ChainID   *ubig.Big `json:"chainID"`
+	FromBlock int64     `json:"fromBlock"`
+	ToBlock   int64     `json:"toBlock"`
+	Filters   []Filter  `json:"filters"`
+}
+
+// ExportLogs writes the finalized logs matching the named filters between fromBlock and toBlock,
+// along with the blocks holding them, to w. All filters are exported if none are named.
+// toBlock is capped at the latest finalized block, so that the archive only holds logs which
+// can't be reorged.
+func (lp *logPoller) ExportLogs(ctx context.Context, w io.Writer, filterNames []string, fromBlock, toBlock int64) (ArchiveSummary, error) {
+	filters, err := lp.archiveFilters(filterNames)
+	if err != nil {
+		return ArchiveSummary{}, err
+	}
+
+	finalized, err := lp.orm.SelectLatestFinalizedBlock(ctx)
+	if err != nil {
+		return ArchiveSummary{}, fmt.Errorf("failed to get latest finalized block: %w", err)
+	}
+	if toBlock <= 0 || toBlock > finalized.BlockNumber {
+		toBlock = finalized.BlockNumber
+	}
+	if fromBlock < 0 || fromBlock > toBlock {
+		return ArchiveSummary{}, fmt.Errorf("invalid block range %d-%d, latest finalized block is %d", fromBlock, toBlock, finalized.BlockNumber)
+	}
+
+	summary := ArchiveSummary{FromBlock: fromBlock, ToBlock: toBlock}
+	for _, filter := range filters {
+		summary.Filters = append(summary.Filters, filter.Name)
+	}
+
+	gz := gzip.NewWriter(w)
+	enc := json.NewEncoder(gz)
+	header := archiveHeader{
+		Version:   archiveVersion,
+		ChainID:   ubig.New(lp.ec.ConfiguredChainID()),
+		FromBlock: fromBlock,
+		ToBlock:   toBlock,
+		Filters:   filters,
+	}
+	if err = enc.Encode(archiveRecord{Header: &header}); err != nil {
+		return ArchiveSummary{}, err
+	}
+
+	for start := fromBlock; start <= toBlock; start += archiveChunkSize {
+		end := min(start+archiveChunkSize-1, toBlock)
+		logs, err := lp.selectArchiveLogs(ctx, filters, start, end)
+		if err != nil {
+			return ArchiveSummary{}, err
+		}
+		if len(logs) == 0 {
+			continue
+		}
+		blocks, err := lp.orm.GetBlocksRange(ctx, logs[0].BlockNumber, logs[len(logs)-1].BlockNumber)
+		if err != nil {
+			return ArchiveSummary{}, fmt.Errorf("failed to get blocks %d-%d: %w", logs[0].BlockNumber, logs[len(logs)-1].BlockNumber, err)
+		}
+		blocksByNumber := make(map[int64]LogPollerBlock, len(blocks))
+		for _, block := range blocks {
+			blocksByNumber[block.BlockNumber] = block
+		}
+
+		for i, log := range logs {
+			if i == 0 || log.BlockNumber != logs[i-1].BlockNumber {
+				block, ok := blocksByNumber[log.BlockNumber]
+				if !ok {
+					// Old blocks are pruned while their logs are kept, so the block is rebuilt from the log.
+					block = LogPollerBlock{
+						EvmChainId:           log.EvmChainId,
+						BlockHash:            log.BlockHash,
+						BlockNumber:          log.BlockNumber,
+						BlockTimestamp:       log.BlockTimestamp,
+						FinalizedBlockNumber: log.BlockNumber,
+					}
+				} else if block.BlockHash != log.BlockHash {
+					return ArchiveSummary{}, fmt.Errorf("log %d in block %d has hash %s, but the saved block has hash %s", log.LogIndex, log.BlockNumber, log.BlockHash, block.BlockHash)
+				}
+				if err = enc.Encode(archiveRecord{Block: &block}); err != nil {
+					return ArchiveSummary{}, err
+				}
+				summary.Blocks++
+			}
+			if err = enc.Encode(archiveRecord{Log: &log}); err != nil {
+				return ArchiveSummary{}, err
+			}
+			summary.Logs++
+		}
+	}
+
+	if err = gz.Close(); err != nil {
+		return ArchiveSummary{}, err
+	}
+	lp.lggr.Infow("Exported logs", "fromBlock", fromBlock, "toBlock", toBlock, "filters", summary.Filters, "blocks", summary.Blocks, "logs", summary.Logs)
+	return summary, nil
+}
+
+// archiveFilters returns the registered filters with the given names, or all of them if none are given.
+func (lp *logPoller) archiveFilters(names []string) ([]Filter, error) {
+	registered := lp.GetFilters()
+	if len(names) == 0 {
+		names = slices.Sorted(maps.Keys(registered))
+	}
+	filters := make([]Filter, 0, len(names))
+	for _, name := range names {
+		filter, ok := registered[name]
+		if !ok {
+			return nil, fmt.Errorf("filter %q is not registered", name)
+		}
+		filters = append(filters, filter)
+	}
+	return filters, nil
+}
+
+// selectArchiveLogs returns the logs between start and end matching any of the filters, ordered by
+// block and log index.
+func (lp *logPoller) selectArchiveLogs(ctx context.Context, filters []Filter, start, end int64) ([]Log, error) {
+	type logID struct {
+		blockHash common.Hash
+		logIndex  int64
+	}
+	seen := make(map[logID]struct{})
+	var logs []Log
+	for _, filter := range filters {
+		for _, address := range filter.Addresses {
+			found, err := lp.orm.SelectLogsWithSigs(ctx, start, end, address, filter.EventSigs)
+			if err != nil {
+				return nil, fmt.Errorf("failed to select logs of filter %q: %w", filter.Name, err)
+			}
+			for _, log := range found {
+				id := logID{log.BlockHash, log.LogIndex}
+				if _, ok := seen[id]; ok || !filter.matches(log) {
+					continue
+				}
+				seen[id] = struct{}{}
+				logs = append(logs, log)
+			}
+		}
+	}
+	slices.SortFunc(logs, func(a, b Log) int {
+		return cmp.Or(cmp.Compare(a.BlockNumber, b.BlockNumber), cmp.Compare(a.LogIndex, b.LogIndex))
+	})
+	return logs, nil
+}
+
+// ImportLogs saves the logs of an archive written by ExportLogs. Only logs matching the filters already
+// registered on this node are imported, the filters of the archive are not registered. The blocks of
+// the archive are checked against the canonical headers fetched from the RPC in batches: their hashes
+// must match, and the address and topics of each log must be in the logs bloom of its block. The import
+// is aborted on the first mismatch. Logs imported before the mismatch are kept: they were verified, and
+// importing again is idempotent.
+func (lp *logPoller) ImportLogs(ctx context.Context, r io.Reader) (ArchiveSummary, error) {
+	filters := lp.GetFilters()
+	if len(filters) == 0 {
+		return ArchiveSummary{}, pkgerrors.New("no filters are registered, logs are only imported for registered filters")
+	}
+
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return ArchiveSummary{}, fmt.Errorf("failed to read archive: %w", err)
+	}
+	defer gz.Close()
+
+	scanner := bufio.NewScanner(gz)
+	scanner.Buffer(nil, archiveMaxScanTokenSize)
+	next := func() (*archiveRecord, error) {
+		if !scanner.Scan() {
+			if err := scanner.Err(); err != nil {
+				return nil, fmt.Errorf("failed to read archive: %w", err)
+			}
+			return nil, io.EOF
+		}
+		var record archiveRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return nil, fmt.Errorf("failed to decode archive record: %w", err)
+		}
+		return &record, nil
+	}
+
+	record, err := next()
+	if err != nil {
+		return ArchiveSummary{}, err
+	}
+	header := record.Header
+	if header == nil {
+		return ArchiveSummary{}, pkgerrors.New("archive is missing its header")
+	}
+	if header.Version != archiveVersion {
+		return ArchiveSummary{}, fmt.Errorf("unsupported archive version %d, expected %d", header.Version, archiveVersion)
+	}
+	chainID := ubig.New(lp.ec.ConfiguredChainID())
+	if header.ChainID == nil || header.ChainID.Cmp(chainID) != 0 {
+		return ArchiveSummary{}, fmt.Errorf("archive holds logs of chain %s, expected chain %s", header.ChainID, chainID)
+	}
+
+	latest, err := lp.orm.SelectLatestBlock(ctx)
+	if err != nil && !pkgerrors.Is(err, sql.ErrNoRows) {
+		return ArchiveSummary{}, fmt.Errorf("failed to get latest block: %w", err)
+	}
+
+	summary := ArchiveSummary{FromBlock: header.FromBlock, ToBlock: header.ToBlock}
+	matched := make(map[string]struct{})
+
+	var blocks []LogPollerBlock
+	var logs []Log
+	flush := func() error {
+		if len(blocks) == 0 {
+			return nil
+		}
+		if err := lp.importArchiveBatch(ctx, blocks, logs, latest); err != nil {
+			return err
+		}
+		summary.Blocks += len(blocks)
+		summary.Logs += len(logs)
+		blocks, logs = blocks[:0], logs[:0]
+		return nil
+	}
+
+	for {
+		record, err = next()
+		if pkgerrors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return summary, err
+		}
+		switch {
+		case record.Block != nil:
+			block := *record.Block
+			if block.BlockNumber < header.FromBlock || block.BlockNumber > header.ToBlock {
+				return summary, fmt.Errorf("block %d is outside of the archive range %d-%d", block.BlockNumber, header.FromBlock, header.ToBlock)
+			}
+			if len(blocks) > 0 && block.BlockNumber <= blocks[len(blocks)-1].BlockNumber {
+				return summary, fmt.Errorf("block %d is out of order", block.BlockNumber)
+			}
+			if len(blocks) >= int(lp.backfillBatchSize) {
+				if err = flush(); err != nil {
+					return summary, err
+				}
+			}
+			blocks = append(blocks, block)
+		case record.Log != nil:
+			log := *record.Log
+			if len(blocks) == 0 {
+				return summary, fmt.Errorf("log %d of block %d is not preceded by its block", log.LogIndex, log.BlockNumber)
+			}
+			block := blocks[len(blocks)-1]
+			if log.BlockNumber != block.BlockNumber || log.BlockHash != block.BlockHash {
+				return summary, fmt.Errorf("log %d of block %d (%s) doesn't belong to block %d (%s)", log.LogIndex, log.BlockNumber, log.BlockHash, block.BlockNumber, block.BlockHash)
+			}
+			names := matchingFilters(filters, log)
+			if len(names) == 0 {
+				continue
+			}
+			for _, name := range names {
+				matched[name] = struct{}{}
+			}
+			log.EvmChainId = chainID
+			logs = append(logs, log)
+		default:
+			return summary, pkgerrors.New("archive holds an empty record")
+		}
+	}
+	if err = flush(); err != nil {
+		return summary, err
+	}
+	summary.Filters = slices.Sorted(maps.Keys(matched))
+
+	lp.lggr.Infow("Imported logs", "fromBlock", summary.FromBlock, "toBlock", summary.ToBlock, "filters", summary.Filters, "blocks", summary.Blocks, "logs", summary.Logs)
+	return summary, nil
+}
+
+// importArchiveBatch checks the archived blocks and their logs against the canonical chain, then saves
+// the logs. Blocks are only saved when they aren't newer than the latest block the LogPoller has
+// processed, so that importing an archive never moves the polling forward and skips logs of other filters.
+func (lp *logPoller) importArchiveBatch(ctx context.Context, blocks []LogPollerBlock, logs []Log, latest *LogPollerBlock) error {
+	if err := lp.verifyArchiveBlocks(ctx, blocks, logs); err != nil {
+		return err
+	}
+	if err := lp.orm.InsertLogs(ctx, logs); err != nil {
+		return fmt.Errorf("failed to save logs: %w", err)
+	}
+	if latest == nil {
+		return nil
+	}
+	for _, block := range blocks {
+		if block.BlockNumber > latest.BlockNumber {
+			break
+		}
+		if err := lp.orm.InsertBlock(ctx, block.BlockHash, block.BlockNumber, block.BlockTimestamp, block.FinalizedBlockNumber); err != nil {
+			return fmt.Errorf("failed to save block %d: %w", block.BlockNumber, err)
+		}
+	}
+	return nil
+}
+
+// matchingFilters returns the names of the filters matching the log.
+func matchingFilters(filters map[string]Filter, log Log) []string {
+	var names []string
+	for name, filter := range filters {
+		if filter.matches(log) {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// archiveBlockHeader is the part of a block header the archived blocks and logs are verified against.
+type archiveBlockHeader struct {
+	Hash  common.Hash `json:"hash"`
+	Bloom types.Bloom `json:"logsBloom"`
+}
+
+// verifyArchiveBlocks checks the hashes of the archived blocks against the canonical headers, and that the
+// address and topics of each archived log are in the logs bloom of its block, so that logs of contracts
+// or events which didn't emit in the block are rejected. Headers are fetched rpcBatchSize at a time, with
+// a single eth_getBlockByNumber call per block.
+func (lp *logPoller) verifyArchiveBlocks(ctx context.Context, blocks []LogPollerBlock, logs []Log) error {
+	headers := make(map[int64]*archiveBlockHeader, len(blocks))
+	batchSize := max(int(lp.rpcBatchSize), 1)
+	for start := 0; start < len(blocks); start += batchSize {
+		batch := blocks[start:min(start+batchSize, len(blocks))]
+		reqs := make([]rpc.BatchElem, len(batch))
+		for i, block := range batch {
+			reqs[i] = rpc.BatchElem{
+				Method: "eth_getBlockByNumber",
+				Args:   []any{hexutil.EncodeBig(big.NewInt(block.BlockNumber)), false},
+				Result: &archiveBlockHeader{},
+			}
+		}
+		if err := lp.ec.BatchCallContext(ctx, reqs); err != nil {
+			return fmt.Errorf("failed to get blocks %d-%d to verify the archive: %w", batch[0].BlockNumber, batch[len(batch)-1].BlockNumber, err)
+		}
+		for i, req := range reqs {
+			block := batch[i]
+			if req.Error != nil {
+				return fmt.Errorf("failed to get block %d to verify the archive: %w", block.BlockNumber, req.Error)
+			}
+			header := req.Result.(*archiveBlockHeader)
+			if header.Hash == (common.Hash{}) {
+				return fmt.Errorf("block %d of the archive was not found", block.BlockNumber)
+			}
+			if header.Hash != block.BlockHash {
+				return fmt.Errorf("block %d of the archive has hash %s, but the canonical block has hash %s", block.BlockNumber, block.BlockHash, header.Hash)
+			}
+			headers[block.BlockNumber] = header
+		}
+	}
+
+	for _, log := range logs {
+		header, ok := headers[log.BlockNumber]
+		if !ok {
+			return fmt.Errorf("log %d of block %d of the archive doesn't belong to an archived block", log.LogIndex, log.BlockNumber)
+		}
+		if !types.BloomLookup(header.Bloom, log.Address) {
+			return fmt.Errorf("log %d of block %d of the archive doesn't match the logs bloom of the block", log.LogIndex, log.BlockNumber)
+		}
+		for _, topic := range log.GetTopics() {
+			if !types.BloomLookup(header.Bloom, topic) {
+				return fmt.Errorf("log %d of block %d of the archive doesn't match the logs bloom of the block", log.LogIndex, log.BlockNumber)
+			}
+		}
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package logpoller
 
 import (
 	"context"
+	"io"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -129,3 +130,15 @@ func (d disabled) FindLCA(ctx context.Context) (*LogPollerBlock, error) {
 func (d disabled) DeleteLogsAndBlocksAfter(ctx context.Context, start int64) error {
 	return ErrDisabled
 }
+
+func (d disabled) Subscribe(ctx context.Context, filter Filter) (Subscription, error) {
+	return nil, ErrDisabled
+}
+
+func (d disabled) ExportLogs(ctx context.Context, w io.Writer, filterNames []string, fromBlock, toBlock int64) (ArchiveSummary, error) {
+	return ArchiveSummary{}, ErrDisabled
+}
+
+func (d disabled) ImportLogs(ctx context.Context, r io.Reader) (ArchiveSummary, error) {
+	return ArchiveSummary{}, ErrDisabled
+}
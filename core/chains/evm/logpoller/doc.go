@@ -19,4 +19,9 @@
 //   - After calling Replay(fromBlock), all blocks including that one to the latest chain tip will be polled
 //     with the current filter. This can be used on first time job add to specify a start block from which you wish to capture
 //     existing logs.
+//   - After calling Subscribe with a filter, matching logs are pushed to the subscriber as soon as they are saved, along
+//     with notifications of the blocks removed by reorgs, so clients don't need to poll the db for new logs.
+//   - Finalized logs exported with ExportLogs can be loaded on another node of the same chain with ImportLogs, instead
+//     of backfilling them over RPC. Only logs of the filters registered on the importing node are saved, once their blocks
+//     are checked against the canonical chain and their logs against the logs bloom of their block.
 package logpoller
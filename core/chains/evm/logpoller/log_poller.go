@@ -7,6 +7,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"io"
 	"math/big"
 	"math/rand/v2"
 	"sort"
@@ -72,6 +73,13 @@ type LogPoller interface {
 
 	// chainlink-common query filtering
 	FilteredLogs(ctx context.Context, filter []query.Expression, limitAndSort query.LimitAndSort, queryName string) ([]Log, error)
+
+	// Push based delivery of new logs
+	Subscribe(ctx context.Context, filter Filter) (Subscription, error)
+
+	// Portable archives of finalized logs, to backfill other nodes without RPC calls
+	ExportLogs(ctx context.Context, w io.Writer, filterNames []string, fromBlock, toBlock int64) (ArchiveSummary, error)
+	ImportLogs(ctx context.Context, r io.Reader) (ArchiveSummary, error)
 }
 
 type LogPollerTest interface {
@@ -126,6 +134,9 @@ type logPoller struct {
 	cachedAddresses []common.Address
 	cachedEventSigs []common.Hash
 
+	subscriptionsMu sync.Mutex
+	subscriptions   []*subscription
+
 	replayStart    chan int64
 	replayComplete chan error
 	stopCh         services.StopChan
@@ -526,6 +537,7 @@ func (lp *logPoller) Close() error {
 		}
 		close(lp.stopCh)
 		lp.wg.Wait()
+		lp.closeSubscriptions()
 		return nil
 	})
 }
@@ -930,11 +942,13 @@ func (lp *logPoller) backfill(ctx context.Context, start, end int64) error {
 		}
 
 		lp.lggr.Debugw("Backfill found logs", "from", from, "to", to, "logs", len(gethLogs), "blocks", blocks)
-		err = lp.orm.InsertLogsWithBlock(ctx, convertLogs(gethLogs, blocks, lp.lggr, lp.ec.ConfiguredChainID()), endblock)
+		logs := convertLogs(gethLogs, blocks, lp.lggr, lp.ec.ConfiguredChainID())
+		err = lp.orm.InsertLogsWithBlock(ctx, logs, endblock)
 		if err != nil {
 			lp.lggr.Warnw("Unable to insert logs, retrying", "err", err, "from", from, "to", to)
 			return err
 		}
+		lp.publishLogs(logs)
 	}
 	return nil
 }
@@ -1005,6 +1019,7 @@ func (lp *logPoller) getCurrentBlockMaybeHandleReorg(ctx context.Context, curren
 			// We return an error here which will cause us to restart polling from lastBlockSaved + 1
 			return nil, err2
 		}
+		lp.publishReorg(blockAfterLCA.Number)
 		return blockAfterLCA, nil
 	}
 	// No reorg, return current block.
@@ -1106,15 +1121,13 @@ func (lp *logPoller) pollAndSaveLogs(ctx context.Context, currentBlockNumber int
 			BlockTimestamp:       currentBlock.Timestamp,
 			FinalizedBlockNumber: latestFinalizedBlockNumber,
 		}
-		err = lp.orm.InsertLogsWithBlock(
-			ctx,
-			convertLogs(logs, []LogPollerBlock{block}, lp.lggr, lp.ec.ConfiguredChainID()),
-			block,
-		)
+		converted := convertLogs(logs, []LogPollerBlock{block}, lp.lggr, lp.ec.ConfiguredChainID())
+		err = lp.orm.InsertLogsWithBlock(ctx, converted, block)
 		if err != nil {
 			lp.lggr.Warnw("Unable to save logs resuming from last saved block + 1", "err", err, "block", currentBlockNumber)
 			return nil
 		}
+		lp.publishLogs(converted)
 		// Update current block.
 		// Same reorg detection on unfinalized blocks.
 		currentBlockNumber++
@@ -1617,7 +1630,11 @@ func (lp *logPoller) IndexedLogsWithSigsExcluding(ctx context.Context, address c
 
 // DeleteLogsAndBlocksAfter - removes blocks and logs starting from the specified block
 func (lp *logPoller) DeleteLogsAndBlocksAfter(ctx context.Context, start int64) error {
-	return lp.orm.DeleteLogsAndBlocksAfter(ctx, start)
+	if err := lp.orm.DeleteLogsAndBlocksAfter(ctx, start); err != nil {
+		return err
+	}
+	lp.publishReorg(start)
+	return nil
 }
 
 func (lp *logPoller) FindLCA(ctx context.Context) (*LogPollerBlock, error) {
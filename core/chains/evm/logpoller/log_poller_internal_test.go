@@ -1,9 +1,11 @@
 package logpoller
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"math/big"
 	"strings"
 	"sync"
@@ -34,6 +36,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/evm/testutils"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/evm/types"
 	"github.com/smartcontractkit/chainlink/v2/evm/utils"
+	ubig "github.com/smartcontractkit/chainlink/v2/evm/utils/big"
 )
 
 var (
@@ -802,3 +805,201 @@ func BenchmarkFilter100_10(b *testing.B) {
 func BenchmarkFilter1000_100(b *testing.B) {
 	benchmarkFilter(b, 1000, 100, 100)
 }
+
+func Test_Subscriptions(t *testing.T) {
+	lp := NewLogPoller(nil, nil, logger.Test(t), nil, Opts{})
+	addr := testutils.NewAddress()
+	eventSig := EmitterABI.Events["Log1"].ID
+	topic := common.HexToHash("0x01")
+	newLog := func(blockNumber int64, address common.Address, topics ...common.Hash) Log {
+		l := Log{BlockNumber: blockNumber, Address: address, EventSig: eventSig, Topics: [][]byte{eventSig.Bytes()}}
+		for _, tp := range topics {
+			l.Topics = append(l.Topics, tp.Bytes())
+		}
+		return l
+	}
+
+	all := lp.addSubscription(Filter{Name: "all", Addresses: []common.Address{addr}, EventSigs: []common.Hash{eventSig}})
+	byTopic := lp.addSubscription(Filter{Name: "topic", Addresses: []common.Address{addr}, EventSigs: []common.Hash{eventSig}, Topic2: []common.Hash{topic}})
+
+	lp.publishLogs([]Log{
+		newLog(1, addr),
+		newLog(1, addr, topic),
+		newLog(1, testutils.NewAddress(), topic),
+	})
+	event := <-all.Events()
+	assert.Len(t, event.Logs, 2)
+	event = <-byTopic.Events()
+	require.Len(t, event.Logs, 1)
+	assert.Equal(t, topic, event.Logs[0].GetTopics()[1])
+
+	byTopic.Unsubscribe()
+	_, ok := <-byTopic.Events()
+	assert.False(t, ok)
+	assert.ErrorIs(t, byTopic.Err(), ErrUnsubscribed)
+
+	lp.publishReorg(1)
+	event = <-all.Events()
+	require.NotNil(t, event.ReorgedFromBlock)
+	assert.Equal(t, int64(1), *event.ReorgedFromBlock)
+
+	all.Unsubscribe()
+
+	t.Run("closes lagging subscriptions", func(t *testing.T) {
+		lagging := lp.addSubscription(Filter{Name: "lagging", Addresses: []common.Address{addr}, EventSigs: []common.Hash{eventSig}})
+		for i := 0; i <= subscriptionBufferSize; i++ {
+			lp.publishLogs([]Log{newLog(int64(i), addr)})
+		}
+		assert.ErrorIs(t, lagging.Err(), ErrSubscriptionLagging)
+		assert.Len(t, lagging.Events(), subscriptionBufferSize)
+		assert.NotContains(t, lp.subscriptions, lagging)
+	})
+
+	t.Run("closes subscriptions on shutdown", func(t *testing.T) {
+		sub := lp.addSubscription(Filter{Name: "shutdown", Addresses: []common.Address{addr}, EventSigs: []common.Hash{eventSig}})
+		lp.closeSubscriptions()
+		assert.ErrorIs(t, sub.Err(), ErrLogPollerClosed)
+	})
+}
+
+func Test_ExportImportLogs(t *testing.T) {
+	lggr := logger.Test(t)
+	chainID := testutils.NewRandomEVMChainID()
+	db := testutils.NewSqlxDB(t)
+	orm := NewORM(chainID, db, lggr)
+	ctx := testutils.Context(t)
+
+	ec := clienttest.NewClient(t)
+	ec.EXPECT().ConfiguredChainID().Return(chainID).Maybe()
+	lp := NewLogPoller(orm, ec, lggr, nil, Opts{BackfillBatchSize: 2, FinalityDepth: 1})
+
+	exported, other := testutils.NewAddress(), testutils.NewAddress()
+	eventSig := EmitterABI.Events["Log1"].ID
+	now := time.Now().UTC().Truncate(time.Second)
+	var logs []Log
+	for i := int64(1); i <= 5; i++ {
+		blockHash := common.BigToHash(big.NewInt(i))
+		require.NoError(t, orm.InsertBlock(ctx, blockHash, i, now, i-1))
+		for j, address := range []common.Address{exported, other} {
+			logs = append(logs, Log{
+				EvmChainId:     ubig.New(chainID),
+				LogIndex:       int64(j),
+				BlockHash:      blockHash,
+				BlockNumber:    i,
+				BlockTimestamp: now,
+				Topics:         [][]byte{eventSig.Bytes()},
+				EventSig:       eventSig,
+				Address:        address,
+				TxHash:         common.BigToHash(big.NewInt(i*10 + int64(j))),
+				Data:           []byte("hello"),
+			})
+		}
+	}
+	require.NoError(t, orm.InsertLogs(ctx, logs))
+	exportedFilter := Filter{Name: "exported", Addresses: []common.Address{exported}, EventSigs: []common.Hash{eventSig}}
+	require.NoError(t, lp.RegisterFilter(ctx, exportedFilter))
+
+	// eth_getBlockByNumber returns the hash and logs bloom of the block
+	headers := make(map[int64]*archiveBlockHeader)
+	for _, log := range logs {
+		header, ok := headers[log.BlockNumber]
+		if !ok {
+			header = &archiveBlockHeader{Hash: log.BlockHash}
+			headers[log.BlockNumber] = header
+		}
+		header.Bloom.Add(log.Address.Bytes())
+		for _, topic := range log.GetTopics() {
+			header.Bloom.Add(topic.Bytes())
+		}
+	}
+	var calls atomic.Int32
+	ec.EXPECT().BatchCallContext(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, reqs []rpc.BatchElem) error {
+		calls.Add(1)
+		for _, req := range reqs {
+			assert.Equal(t, "eth_getBlockByNumber", req.Method)
+			number, err := hexutil.DecodeBig(req.Args[0].(string))
+			require.NoError(t, err)
+			if header, ok := headers[number.Int64()]; ok {
+				*req.Result.(*archiveBlockHeader) = *header
+			}
+		}
+		return nil
+	}).Maybe()
+
+	var archive bytes.Buffer
+	summary, err := lp.ExportLogs(ctx, &archive, nil, 0, 0)
+	require.NoError(t, err)
+	// only blocks up to the latest finalized block 4 are exported
+	assert.Equal(t, ArchiveSummary{FromBlock: 0, ToBlock: 4, Filters: []string{"exported"}, Blocks: 4, Logs: 4}, summary)
+
+	_, err = lp.ExportLogs(ctx, io.Discard, []string{"unknown"}, 0, 0)
+	require.ErrorContains(t, err, `filter "unknown" is not registered`)
+
+	t.Run("imports the logs and verifies block hashes", func(t *testing.T) {
+		_, err = db.ExecContext(ctx, `DELETE FROM evm.logs WHERE evm_chain_id = $1`, ubig.New(chainID))
+		require.NoError(t, err)
+
+		calls.Store(0)
+		summary, err = lp.ImportLogs(ctx, bytes.NewReader(archive.Bytes()))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"exported"}, summary.Filters)
+		assert.Equal(t, 4, summary.Blocks)
+		assert.Equal(t, 4, summary.Logs)
+		// the headers of each import batch of 2 blocks are fetched with a single batch call
+		assert.Equal(t, int32(2), calls.Load())
+
+		imported, err := lp.LogsWithSigs(ctx, 0, 5, []common.Hash{eventSig}, exported)
+		require.NoError(t, err)
+		require.Len(t, imported, 4)
+		assert.Equal(t, logs[0].TxHash, imported[0].TxHash)
+		assert.Equal(t, logs[0].Data, imported[0].Data)
+		imported, err = lp.LogsWithSigs(ctx, 0, 5, []common.Hash{eventSig}, other)
+		require.NoError(t, err)
+		assert.Empty(t, imported)
+	})
+
+	t.Run("rejects archives of another chain", func(t *testing.T) {
+		otherEC := clienttest.NewClient(t)
+		otherEC.EXPECT().ConfiguredChainID().Return(testutils.NewRandomEVMChainID())
+		otherLP := NewLogPoller(orm, otherEC, lggr, nil, Opts{BackfillBatchSize: 2, FinalityDepth: 1})
+		_, err = otherLP.ImportLogs(ctx, bytes.NewReader(archive.Bytes()))
+		require.ErrorContains(t, err, "no filters are registered")
+
+		require.NoError(t, otherLP.RegisterFilter(ctx, exportedFilter))
+		_, err = otherLP.ImportLogs(ctx, bytes.NewReader(archive.Bytes()))
+		require.ErrorContains(t, err, "archive holds logs of chain")
+	})
+
+	t.Run("only imports logs of registered filters", func(t *testing.T) {
+		otherEC := clienttest.NewClient(t)
+		otherEC.EXPECT().ConfiguredChainID().Return(chainID)
+		otherLP := NewLogPoller(orm, otherEC, lggr, nil, Opts{BackfillBatchSize: 2, FinalityDepth: 1})
+		require.NoError(t, otherLP.RegisterFilter(ctx, Filter{Name: "other", Addresses: []common.Address{other}, EventSigs: []common.Hash{eventSig}}))
+
+		summary, err = otherLP.ImportLogs(ctx, bytes.NewReader(archive.Bytes()))
+		require.NoError(t, err)
+		assert.Empty(t, summary.Filters)
+		assert.Equal(t, 0, summary.Logs)
+		assert.NotContains(t, otherLP.GetFilters(), "exported")
+	})
+
+	t.Run("rejects logs not matching the chain", func(t *testing.T) {
+		header := *headers[2]
+		t.Cleanup(func() { headers[2] = &header })
+		headers[2] = &archiveBlockHeader{Hash: header.Hash}
+		headers[2].Bloom.Add(other.Bytes())
+		headers[2].Bloom.Add(eventSig.Bytes())
+
+		_, err = lp.ImportLogs(ctx, bytes.NewReader(archive.Bytes()))
+		require.ErrorContains(t, err, "log 0 of block 2 of the archive doesn't match the logs bloom of the block")
+	})
+
+	t.Run("rejects blocks not matching the canonical chain", func(t *testing.T) {
+		header := *headers[3]
+		t.Cleanup(func() { headers[3] = &header })
+		headers[3] = &archiveBlockHeader{Hash: common.HexToHash("0xbad"), Bloom: header.Bloom}
+
+		_, err = lp.ImportLogs(ctx, bytes.NewReader(archive.Bytes()))
+		require.ErrorContains(t, err, "block 3 of the archive has hash")
+	})
+}
@@ -7,6 +7,8 @@ import (
 
 	common "github.com/ethereum/go-ethereum/common"
 
+	io "io"
+
 	logpoller "github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
 
 	mock "github.com/stretchr/testify/mock"
@@ -123,6 +125,66 @@ func (_c *LogPoller_DeleteLogsAndBlocksAfter_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// ExportLogs provides a mock function with given fields: ctx, w, filterNames, fromBlock, toBlock
+func (_m *LogPoller) ExportLogs(ctx context.Context, w io.Writer, filterNames []string, fromBlock int64, toBlock int64) (logpoller.ArchiveSummary, error) {
+	ret := _m.Called(ctx, w, filterNames, fromBlock, toBlock)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportLogs")
+	}
+
+	var r0 logpoller.ArchiveSummary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, io.Writer, []string, int64, int64) (logpoller.ArchiveSummary, error)); ok {
+		return rf(ctx, w, filterNames, fromBlock, toBlock)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, io.Writer, []string, int64, int64) logpoller.ArchiveSummary); ok {
+		r0 = rf(ctx, w, filterNames, fromBlock, toBlock)
+	} else {
+		r0 = ret.Get(0).(logpoller.ArchiveSummary)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, io.Writer, []string, int64, int64) error); ok {
+		r1 = rf(ctx, w, filterNames, fromBlock, toBlock)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LogPoller_ExportLogs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportLogs'
+type LogPoller_ExportLogs_Call struct {
+	*mock.Call
+}
+
+// ExportLogs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - w io.Writer
+//   - filterNames []string
+//   - fromBlock int64
+//   - toBlock int64
+func (_e *LogPoller_Expecter) ExportLogs(ctx interface{}, w interface{}, filterNames interface{}, fromBlock interface{}, toBlock interface{}) *LogPoller_ExportLogs_Call {
+	return &LogPoller_ExportLogs_Call{Call: _e.mock.On("ExportLogs", ctx, w, filterNames, fromBlock, toBlock)}
+}
+
+func (_c *LogPoller_ExportLogs_Call) Run(run func(ctx context.Context, w io.Writer, filterNames []string, fromBlock int64, toBlock int64)) *LogPoller_ExportLogs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(io.Writer), args[2].([]string), args[3].(int64), args[4].(int64))
+	})
+	return _c
+}
+
+func (_c *LogPoller_ExportLogs_Call) Return(_a0 logpoller.ArchiveSummary, _a1 error) *LogPoller_ExportLogs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LogPoller_ExportLogs_Call) RunAndReturn(run func(context.Context, io.Writer, []string, int64, int64) (logpoller.ArchiveSummary, error)) *LogPoller_ExportLogs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FilteredLogs provides a mock function with given fields: ctx, filter, limitAndSort, queryName
 func (_m *LogPoller) FilteredLogs(ctx context.Context, filter []query.Expression, limitAndSort query.LimitAndSort, queryName string) ([]logpoller.Log, error) {
 	ret := _m.Called(ctx, filter, limitAndSort, queryName)
@@ -486,6 +548,63 @@ func (_c *LogPoller_Healthy_Call) RunAndReturn(run func() error) *LogPoller_Heal
 	return _c
 }
 
+// ImportLogs provides a mock function with given fields: ctx, r
+func (_m *LogPoller) ImportLogs(ctx context.Context, r io.Reader) (logpoller.ArchiveSummary, error) {
+	ret := _m.Called(ctx, r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportLogs")
+	}
+
+	var r0 logpoller.ArchiveSummary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, io.Reader) (logpoller.ArchiveSummary, error)); ok {
+		return rf(ctx, r)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, io.Reader) logpoller.ArchiveSummary); ok {
+		r0 = rf(ctx, r)
+	} else {
+		r0 = ret.Get(0).(logpoller.ArchiveSummary)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, io.Reader) error); ok {
+		r1 = rf(ctx, r)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LogPoller_ImportLogs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportLogs'
+type LogPoller_ImportLogs_Call struct {
+	*mock.Call
+}
+
+// ImportLogs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - r io.Reader
+func (_e *LogPoller_Expecter) ImportLogs(ctx interface{}, r interface{}) *LogPoller_ImportLogs_Call {
+	return &LogPoller_ImportLogs_Call{Call: _e.mock.On("ImportLogs", ctx, r)}
+}
+
+func (_c *LogPoller_ImportLogs_Call) Run(run func(ctx context.Context, r io.Reader)) *LogPoller_ImportLogs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(io.Reader))
+	})
+	return _c
+}
+
+func (_c *LogPoller_ImportLogs_Call) Return(_a0 logpoller.ArchiveSummary, _a1 error) *LogPoller_ImportLogs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LogPoller_ImportLogs_Call) RunAndReturn(run func(context.Context, io.Reader) (logpoller.ArchiveSummary, error)) *LogPoller_ImportLogs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // IndexedLogs provides a mock function with given fields: ctx, eventSig, address, topicIndex, topicValues, confs
 func (_m *LogPoller) IndexedLogs(ctx context.Context, eventSig common.Hash, address common.Address, topicIndex int, topicValues []common.Hash, confs types.Confirmations) ([]logpoller.Log, error) {
 	ret := _m.Called(ctx, eventSig, address, topicIndex, topicValues, confs)
@@ -1809,6 +1928,65 @@ func (_c *LogPoller_Start_Call) RunAndReturn(run func(context.Context) error) *L
 	return _c
 }
 
+// Subscribe provides a mock function with given fields: ctx, filter
+func (_m *LogPoller) Subscribe(ctx context.Context, filter logpoller.Filter) (logpoller.Subscription, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Subscribe")
+	}
+
+	var r0 logpoller.Subscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, logpoller.Filter) (logpoller.Subscription, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, logpoller.Filter) logpoller.Subscription); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(logpoller.Subscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, logpoller.Filter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LogPoller_Subscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Subscribe'
+type LogPoller_Subscribe_Call struct {
+	*mock.Call
+}
+
+// Subscribe is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter logpoller.Filter
+func (_e *LogPoller_Expecter) Subscribe(ctx interface{}, filter interface{}) *LogPoller_Subscribe_Call {
+	return &LogPoller_Subscribe_Call{Call: _e.mock.On("Subscribe", ctx, filter)}
+}
+
+func (_c *LogPoller_Subscribe_Call) Run(run func(ctx context.Context, filter logpoller.Filter)) *LogPoller_Subscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(logpoller.Filter))
+	})
+	return _c
+}
+
+func (_c *LogPoller_Subscribe_Call) Return(_a0 logpoller.Subscription, _a1 error) *LogPoller_Subscribe_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LogPoller_Subscribe_Call) RunAndReturn(run func(context.Context, logpoller.Filter) (logpoller.Subscription, error)) *LogPoller_Subscribe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UnregisterFilter provides a mock function with given fields: ctx, name
 func (_m *LogPoller) UnregisterFilter(ctx context.Context, name string) error {
 	ret := _m.Called(ctx, name)
@@ -0,0 +1,189 @@
+package logpoller
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	pkgerrors "github.com/pkg/errors"
+)
+
+// subscriptionBufferSize is the number of events a subscriber can fall behind by before its
+// subscription is closed with ErrSubscriptionLagging.
+const subscriptionBufferSize = 100
+
+var (
+	ErrSubscriptionLagging = pkgerrors.New("subscription closed, subscriber is not keeping up with new logs")
+	ErrUnsubscribed        = pkgerrors.New("unsubscribed")
+	ErrLogPollerClosed     = pkgerrors.New("subscription closed, log poller is shutting down")
+)
+
+// SubscriptionEvent is delivered to a Subscription whenever the LogPoller persists logs matching its
+// filter, or removes blocks because of a reorg.
+type SubscriptionEvent struct {
+	// Logs matching the filter of the subscription that were just saved, ordered by block and log index.
+	Logs []Log
+	// ReorgedFromBlock is set when every block and log from this block number onwards was removed
+	// because of a reorg. Logs previously received for those blocks are no longer canonical.
+	ReorgedFromBlock *int64
+}
+
+// Subscription delivers new logs as soon as they are saved by the LogPoller. Logs are delivered at
+// least once: replays and the backup poller redeliver the logs they save, so subscribers should
+// deduplicate by (BlockHash, LogIndex).
+type Subscription interface {
+	// Events returns the channel events are delivered on. It is closed when the subscription ends.
+	Events() <-chan SubscriptionEvent
+	// Err returns why the subscription ended, or nil while it is active.
+	Err() error
+	// Unsubscribe ends the subscription. The filter stays registered.
+	Unsubscribe()
+}
+
+type subscription struct {
+	filter Filter
+	ch     chan SubscriptionEvent
+	remove func(*subscription)
+
+	mu  sync.Mutex
+	err error
+}
+
+func (s *subscription) Events() <-chan SubscriptionEvent { return s.ch }
+
+func (s *subscription) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.err
+}
+
+func (s *subscription) Unsubscribe() {
+	s.remove(s)
+	s.close(ErrUnsubscribed)
+}
+
+// send delivers the event without blocking, and returns false if the subscription was closed
+// because its buffer is full.
+func (s *subscription) send(event SubscriptionEvent) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err != nil {
+		return true
+	}
+	select {
+	case s.ch <- event:
+		return true
+	default:
+		s.err = ErrSubscriptionLagging
+		close(s.ch)
+		return false
+	}
+}
+
+func (s *subscription) close(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err != nil {
+		return
+	}
+	s.err = err
+	close(s.ch)
+}
+
+// matches returns true if the log was emitted by one of the addresses of the filter, with one of
+// its event signatures and topic values.
+func (filter *Filter) matches(log Log) bool {
+	if !slices.Contains(filter.Addresses, log.Address) || !slices.Contains(filter.EventSigs, log.EventSig) {
+		return false
+	}
+	topics := log.GetTopics()
+	for i, values := range [][]common.Hash{filter.Topic2, filter.Topic3, filter.Topic4} {
+		if len(values) == 0 {
+			continue
+		}
+		if len(topics) <= i+1 || !slices.Contains(values, topics[i+1]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Subscribe registers the filter and returns a Subscription receiving the logs matching it as soon
+// as they are saved, along with reorg notifications. Unlike the log queries, the subscription
+// doesn't wait for confirmations: logs are delivered as soon as their block is polled.
+// Events are never blocking the LogPoller: a subscriber that falls too far behind has its
+// subscription closed with ErrSubscriptionLagging, and should resubscribe and query the logs it missed.
+func (lp *logPoller) Subscribe(ctx context.Context, filter Filter) (Subscription, error) {
+	if err := lp.RegisterFilter(ctx, filter); err != nil {
+		return nil, err
+	}
+	return lp.addSubscription(filter), nil
+}
+
+func (lp *logPoller) addSubscription(filter Filter) *subscription {
+	sub := &subscription{
+		filter: filter,
+		ch:     make(chan SubscriptionEvent, subscriptionBufferSize),
+		remove: lp.removeSubscription,
+	}
+	lp.subscriptionsMu.Lock()
+	defer lp.subscriptionsMu.Unlock()
+	lp.subscriptions = append(lp.subscriptions, sub)
+	return sub
+}
+
+func (lp *logPoller) removeSubscription(sub *subscription) {
+	lp.subscriptionsMu.Lock()
+	defer lp.subscriptionsMu.Unlock()
+	lp.subscriptions = slices.DeleteFunc(lp.subscriptions, func(s *subscription) bool { return s == sub })
+}
+
+// publishLogs delivers the saved logs to the subscriptions they match.
+func (lp *logPoller) publishLogs(logs []Log) {
+	if len(logs) == 0 {
+		return
+	}
+	lp.publish(func(sub *subscription) (SubscriptionEvent, bool) {
+		var matching []Log
+		for _, log := range logs {
+			if sub.filter.matches(log) {
+				matching = append(matching, log)
+			}
+		}
+		return SubscriptionEvent{Logs: matching}, len(matching) > 0
+	})
+}
+
+// publishReorg notifies every subscription that the blocks from the given one onwards were removed.
+func (lp *logPoller) publishReorg(fromBlock int64) {
+	lp.publish(func(*subscription) (SubscriptionEvent, bool) {
+		return SubscriptionEvent{ReorgedFromBlock: &fromBlock}, true
+	})
+}
+
+func (lp *logPoller) publish(eventFor func(*subscription) (SubscriptionEvent, bool)) {
+	lp.subscriptionsMu.Lock()
+	defer lp.subscriptionsMu.Unlock()
+	lp.subscriptions = slices.DeleteFunc(lp.subscriptions, func(sub *subscription) bool {
+		event, ok := eventFor(sub)
+		if !ok {
+			return false
+		}
+		if !sub.send(event) {
+			lp.lggr.Warnw("Closing lagging log subscription", "filter", sub.filter.Name)
+			return true
+		}
+		return false
+	})
+}
+
+// closeSubscriptions ends every subscription, on shutdown.
+func (lp *logPoller) closeSubscriptions() {
+	lp.subscriptionsMu.Lock()
+	defer lp.subscriptionsMu.Unlock()
+	for _, sub := range lp.subscriptions {
+		sub.close(ErrLogPollerClosed)
+	}
+	lp.subscriptions = nil
+}
@@ -0,0 +1,523 @@
+package storage
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	evmtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	clnull "github.com/smartcontractkit/chainlink-common/pkg/utils/null"
+	"github.com/smartcontractkit/chainlink-framework/chains/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink-framework/chains/txmgr/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txm/types"
+	"github.com/smartcontractkit/chainlink/v2/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/evm/gas"
+	ubig "github.com/smartcontractkit/chainlink/v2/evm/utils/big"
+)
+
+// DBStore is a Postgres backed TxStore. Unlike the InMemoryStoreManager, unstarted and
+// unconfirmed transactions along with their attempts survive a restart, and a nonce can't
+// be assigned to more than one in-flight or confirmed transaction of an address.
+type DBStore struct {
+	lggr    logger.Logger
+	ds      sqlutil.DataSource
+	chainID *big.Int
+}
+
+func NewDBStore(ds sqlutil.DataSource, lggr logger.Logger, chainID *big.Int) *DBStore {
+	return &DBStore{
+		lggr:    logger.Named(lggr, "DBStore"),
+		ds:      ds,
+		chainID: chainID,
+	}
+}
+
+type dbTransaction struct {
+	ID                 uint64             `db:"id"`
+	ChainID            ubig.Big           `db:"chain_id"`
+	IdempotencyKey     *string            `db:"idempotency_key"`
+	Nonce              *int64             `db:"nonce"`
+	FromAddress        common.Address     `db:"from_address"`
+	ToAddress          common.Address     `db:"to_address"`
+	Value              ubig.Big           `db:"value"`
+	Data               []byte             `db:"data"`
+	SpecifiedGasLimit  uint64             `db:"specified_gas_limit"`
+	CreatedAt          time.Time          `db:"created_at"`
+	InitialBroadcastAt *time.Time         `db:"initial_broadcast_at"`
+	LastBroadcastAt    *time.Time         `db:"last_broadcast_at"`
+	State              txmgrtypes.TxState `db:"state"`
+	IsPurgeable        bool               `db:"is_purgeable"`
+	AttemptCount       uint16             `db:"attempt_count"`
+	Meta               *sqlutil.JSON      `db:"meta"`
+	Subject            uuid.NullUUID      `db:"subject"`
+	PipelineTaskRunID  uuid.NullUUID      `db:"pipeline_task_run_id"`
+	MinConfirmations   clnull.Uint32      `db:"min_confirmations"`
+	SignalCallback     bool               `db:"signal_callback"`
+	CallbackCompleted  bool               `db:"callback_completed"`
+}
+
+func (d *dbTransaction) toTransaction() *types.Transaction {
+	tx := &types.Transaction{
+		ID:                 d.ID,
+		IdempotencyKey:     d.IdempotencyKey,
+		ChainID:            d.ChainID.ToInt(),
+		FromAddress:        d.FromAddress,
+		ToAddress:          d.ToAddress,
+		Value:              d.Value.ToInt(),
+		Data:               d.Data,
+		SpecifiedGasLimit:  d.SpecifiedGasLimit,
+		CreatedAt:          d.CreatedAt,
+		InitialBroadcastAt: d.InitialBroadcastAt,
+		LastBroadcastAt:    d.LastBroadcastAt,
+		State:              d.State,
+		IsPurgeable:        d.IsPurgeable,
+		AttemptCount:       d.AttemptCount,
+		Meta:               d.Meta,
+		Subject:            d.Subject,
+		PipelineTaskRunID:  d.PipelineTaskRunID,
+		MinConfirmations:   d.MinConfirmations,
+		SignalCallback:     d.SignalCallback,
+		CallbackCompleted:  d.CallbackCompleted,
+	}
+	if d.Nonce != nil {
+		nonce := uint64(*d.Nonce) //nolint:gosec // nonces are inserted from uint64
+		tx.Nonce = &nonce
+	}
+	return tx
+}
+
+type dbAttempt struct {
+	ID                uint64      `db:"id"`
+	TxID              uint64      `db:"tx_id"`
+	Hash              common.Hash `db:"hash"`
+	GasPrice          *assets.Wei `db:"gas_price"`
+	GasTipCap         *assets.Wei `db:"gas_tip_cap"`
+	GasFeeCap         *assets.Wei `db:"gas_fee_cap"`
+	GasLimit          uint64      `db:"gas_limit"`
+	Type              byte        `db:"type"`
+	SignedTransaction []byte      `db:"signed_transaction"`
+	CreatedAt         time.Time   `db:"created_at"`
+	BroadcastAt       *time.Time  `db:"broadcast_at"`
+}
+
+func (d *dbAttempt) toAttempt() (*types.Attempt, error) {
+	attempt := &types.Attempt{
+		ID:       d.ID,
+		TxID:     d.TxID,
+		Hash:     d.Hash,
+		GasLimit: d.GasLimit,
+		Type:     d.Type,
+		Fee: gas.EvmFee{
+			GasPrice:   d.GasPrice,
+			DynamicFee: gas.DynamicFee{GasTipCap: d.GasTipCap, GasFeeCap: d.GasFeeCap},
+		},
+		CreatedAt:   d.CreatedAt,
+		BroadcastAt: d.BroadcastAt,
+	}
+	if len(d.SignedTransaction) > 0 {
+		attempt.SignedTransaction = new(evmtypes.Transaction)
+		if err := attempt.SignedTransaction.UnmarshalBinary(d.SignedTransaction); err != nil {
+			return nil, fmt.Errorf("failed to decode signed transaction of attempt: %v: %w", d.Hash, err)
+		}
+	}
+	return attempt, nil
+}
+
+// Add resets the attempt count of the unconfirmed transactions of the addresses. As with the
+// InMemoryStoreManager, the attempt count only guards against retrying indefinitely until the
+// next restart.
+func (s *DBStore) Add(addresses ...common.Address) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	_, err := s.ds.ExecContext(ctx, `UPDATE evm.txm_v2_transactions SET attempt_count = 0
+		WHERE chain_id = $1 AND from_address = ANY($2) AND state = 'unconfirmed'`, ubig.New(s.chainID), pq.Array(addressesToBytes(addresses)))
+	if err != nil {
+		return fmt.Errorf("failed to reset attempt counts: %w", err)
+	}
+	return nil
+}
+
+func (s *DBStore) AbandonPendingTransactions(ctx context.Context, fromAddress common.Address) error {
+	_, err := s.ds.ExecContext(ctx, `UPDATE evm.txm_v2_transactions SET state = 'fatal_error'
+		WHERE chain_id = $1 AND from_address = $2 AND state IN ('unstarted', 'unconfirmed')`, ubig.New(s.chainID), fromAddress)
+	return err
+}
+
+func (s *DBStore) AppendAttemptToTransaction(ctx context.Context, txNonce uint64, fromAddress common.Address, attempt *types.Attempt) error {
+	var signedTx []byte
+	if attempt.SignedTransaction != nil {
+		var err error
+		if signedTx, err = attempt.SignedTransaction.MarshalBinary(); err != nil {
+			return fmt.Errorf("failed to encode signed transaction of attempt: %v: %w", attempt.Hash, err)
+		}
+	}
+
+	return sqlutil.TransactDataSource(ctx, s.ds, nil, func(ds sqlutil.DataSource) error {
+		tx, err := s.unconfirmedTransaction(ctx, ds, txNonce, fromAddress, true)
+		if err != nil {
+			return err
+		}
+		if tx == nil {
+			return fmt.Errorf("unconfirmed tx was not found for nonce: %d - txID: %v", txNonce, attempt.TxID)
+		}
+		if tx.ID != attempt.TxID {
+			return fmt.Errorf("unconfirmed tx with nonce exists but attempt points to a different txID. Found Tx: %v - txID: %v", tx.toTransaction(), attempt.TxID)
+		}
+
+		var inserted dbAttempt
+		err = ds.GetContext(ctx, &inserted, `INSERT INTO evm.txm_v2_attempts (tx_id, hash, gas_price, gas_tip_cap, gas_fee_cap, gas_limit, type, signed_transaction)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING *`,
+			attempt.TxID, attempt.Hash, attempt.Fee.GasPrice, attempt.Fee.GasTipCap, attempt.Fee.GasFeeCap, attempt.GasLimit, attempt.Type, signedTx)
+		if err != nil {
+			return fmt.Errorf("failed to insert attempt: %w", err)
+		}
+		if _, err = ds.ExecContext(ctx, `UPDATE evm.txm_v2_transactions SET attempt_count = attempt_count + 1 WHERE id = $1`, tx.ID); err != nil {
+			return err
+		}
+		attempt.ID = inserted.ID
+		attempt.CreatedAt = inserted.CreatedAt
+		return nil
+	})
+}
+
+func (s *DBStore) CountUnstartedTransactions(fromAddress common.Address) (int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	var count int
+	err := s.ds.GetContext(ctx, &count, `SELECT count(*) FROM evm.txm_v2_transactions WHERE chain_id = $1 AND from_address = $2 AND state = 'unstarted'`,
+		ubig.New(s.chainID), fromAddress)
+	return count, err
+}
+
+func (s *DBStore) CreateEmptyUnconfirmedTransaction(ctx context.Context, fromAddress common.Address, nonce uint64, gasLimit uint64) (*types.Transaction, error) {
+	var tx *types.Transaction
+	err := sqlutil.TransactDataSource(ctx, s.ds, nil, func(ds sqlutil.DataSource) error {
+		var existing dbTransaction
+		err := ds.GetContext(ctx, &existing, `SELECT * FROM evm.txm_v2_transactions
+			WHERE chain_id = $1 AND from_address = $2 AND nonce = $3 AND state IN ('unconfirmed', 'confirmed')`, ubig.New(s.chainID), fromAddress, nonce)
+		if err == nil {
+			return fmt.Errorf("an %s tx with the same nonce already exists: %v", existing.State, existing.toTransaction())
+		} else if !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+
+		var inserted dbTransaction
+		err = ds.GetContext(ctx, &inserted, `INSERT INTO evm.txm_v2_transactions (chain_id, nonce, from_address, to_address, value, data, specified_gas_limit, state)
+			VALUES ($1, $2, $3, $4, 0, '\x', $5, 'unconfirmed') RETURNING *`,
+			ubig.New(s.chainID), nonce, fromAddress, common.Address{}, gasLimit)
+		if err != nil {
+			return fmt.Errorf("failed to insert empty transaction: %w", err)
+		}
+		tx = inserted.toTransaction()
+		return nil
+	})
+	return tx, err
+}
+
+// CreateTransaction inserts an unstarted transaction. As with the InMemoryStore, the oldest
+// unstarted transactions of the address are dropped once it has more than maxQueuedTransactions.
+func (s *DBStore) CreateTransaction(ctx context.Context, txRequest *types.TxRequest) (*types.Transaction, error) {
+	value := txRequest.Value
+	if value == nil {
+		value = big.NewInt(0)
+	}
+	data := txRequest.Data
+	if data == nil {
+		data = []byte{}
+	}
+
+	var inserted dbTransaction
+	err := sqlutil.TransactDataSource(ctx, s.ds, nil, func(ds sqlutil.DataSource) error {
+		err := ds.GetContext(ctx, &inserted, `INSERT INTO evm.txm_v2_transactions (chain_id, idempotency_key, from_address, to_address, value, data, specified_gas_limit,
+			state, meta, pipeline_task_run_id, min_confirmations, signal_callback)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, 'unstarted', $8, $9, $10, $11) RETURNING *`,
+			ubig.New(s.chainID), txRequest.IdempotencyKey, txRequest.FromAddress, txRequest.ToAddress, ubig.New(value), data, txRequest.SpecifiedGasLimit,
+			txRequest.Meta, txRequest.PipelineTaskRunID, txRequest.MinConfirmations, txRequest.SignalCallback)
+		if err != nil {
+			return fmt.Errorf("failed to insert transaction: %w", err)
+		}
+
+		var droppedTxIDs []uint64
+		err = ds.SelectContext(ctx, &droppedTxIDs, `DELETE FROM evm.txm_v2_transactions WHERE id IN (
+			SELECT id FROM evm.txm_v2_transactions WHERE chain_id = $1 AND from_address = $2 AND state = 'unstarted' ORDER BY id DESC OFFSET $3
+		) RETURNING id`, ubig.New(s.chainID), txRequest.FromAddress, maxQueuedTransactions)
+		if err != nil {
+			return fmt.Errorf("failed to drop oldest unstarted transactions: %w", err)
+		}
+		if len(droppedTxIDs) > 0 {
+			slices.Sort(droppedTxIDs)
+			s.lggr.Warnw(fmt.Sprintf("Unstarted transactions queue for address: %v reached max limit of: %d. Dropping oldest transactions", txRequest.FromAddress, maxQueuedTransactions),
+				"txIDs", droppedTxIDs)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return inserted.toTransaction(), nil
+}
+
+func (s *DBStore) FetchUnconfirmedTransactionAtNonceWithCount(ctx context.Context, latestNonce uint64, fromAddress common.Address) (tx *types.Transaction, unconfirmedCount int, err error) {
+	err = sqlutil.TransactDataSource(ctx, s.ds, &sqlutil.TxOptions{TxOptions: sql.TxOptions{ReadOnly: true}}, func(ds sqlutil.DataSource) error {
+		err := ds.GetContext(ctx, &unconfirmedCount, `SELECT count(*) FROM evm.txm_v2_transactions WHERE chain_id = $1 AND from_address = $2 AND state = 'unconfirmed'`,
+			ubig.New(s.chainID), fromAddress)
+		if err != nil {
+			return err
+		}
+
+		dbTx, err := s.unconfirmedTransaction(ctx, ds, latestNonce, fromAddress, false)
+		if err != nil || dbTx == nil {
+			return err
+		}
+		txs, err := s.withAttempts(ctx, ds, []dbTransaction{*dbTx})
+		if err != nil {
+			return err
+		}
+		tx = txs[0]
+		return nil
+	})
+	return
+}
+
+func (s *DBStore) MarkConfirmedAndReorgedTransactions(ctx context.Context, latestNonce uint64, fromAddress common.Address) (confirmedTxs []*types.Transaction, unconfirmedTxIDs []uint64, err error) {
+	err = sqlutil.TransactDataSource(ctx, s.ds, nil, func(ds sqlutil.DataSource) error {
+		var confirmed []dbTransaction
+		err := ds.SelectContext(ctx, &confirmed, `UPDATE evm.txm_v2_transactions SET state = 'confirmed'
+			WHERE chain_id = $1 AND from_address = $2 AND state = 'unconfirmed' AND nonce < $3 RETURNING *`, ubig.New(s.chainID), fromAddress, latestNonce)
+		if err != nil {
+			return fmt.Errorf("failed to mark transactions confirmed: %w", err)
+		}
+		// Reorged transactions are marked as if they weren't broadcasted before
+		err = ds.SelectContext(ctx, &unconfirmedTxIDs, `UPDATE evm.txm_v2_transactions SET state = 'unconfirmed', last_broadcast_at = NULL
+			WHERE chain_id = $1 AND from_address = $2 AND state = 'confirmed' AND nonce >= $3 RETURNING id`, ubig.New(s.chainID), fromAddress, latestNonce)
+		if err != nil {
+			return fmt.Errorf("failed to mark reorged transactions unconfirmed: %w", err)
+		}
+
+		confirmedTxs, err = s.withAttempts(ctx, ds, confirmed)
+		if err != nil {
+			return err
+		}
+		return s.pruneTransactions(ctx, ds, fromAddress)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	slices.SortFunc(confirmedTxs, func(a, b *types.Transaction) int { return cmp.Compare(a.ID, b.ID) })
+	slices.Sort(unconfirmedTxIDs)
+	return confirmedTxs, unconfirmedTxIDs, nil
+}
+
+func (s *DBStore) MarkUnconfirmedTransactionPurgeable(ctx context.Context, nonce uint64, fromAddress common.Address) error {
+	res, err := s.ds.ExecContext(ctx, `UPDATE evm.txm_v2_transactions SET is_purgeable = TRUE
+		WHERE chain_id = $1 AND from_address = $2 AND state = 'unconfirmed' AND nonce = $3`, ubig.New(s.chainID), fromAddress, nonce)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return fmt.Errorf("unconfirmed tx with nonce: %d was not found", nonce)
+	}
+	return nil
+}
+
+func (s *DBStore) UpdateTransactionBroadcast(ctx context.Context, txID uint64, txNonce uint64, attemptHash common.Hash, fromAddress common.Address) error {
+	return sqlutil.TransactDataSource(ctx, s.ds, nil, func(ds sqlutil.DataSource) error {
+		tx, err := s.unconfirmedTransaction(ctx, ds, txNonce, fromAddress, true)
+		if err != nil {
+			return err
+		}
+		if tx == nil {
+			return fmt.Errorf("unconfirmed tx was not found for nonce: %d - txID: %v", txNonce, txID)
+		}
+
+		// Set the same time for both the tx and its attempt
+		now := time.Now()
+		res, err := ds.ExecContext(ctx, `UPDATE evm.txm_v2_attempts SET broadcast_at = $1 WHERE tx_id = $2 AND hash = $3`, now, tx.ID, attemptHash)
+		if err != nil {
+			return err
+		}
+		if n, err := res.RowsAffected(); err != nil {
+			return err
+		} else if n == 0 {
+			return fmt.Errorf("UpdateTransactionBroadcast failed to find attempt. attempt with hash: %v was not found", attemptHash)
+		}
+		_, err = ds.ExecContext(ctx, `UPDATE evm.txm_v2_transactions SET last_broadcast_at = $1, initial_broadcast_at = COALESCE(initial_broadcast_at, $1)
+			WHERE id = $2`, now, tx.ID)
+		return err
+	})
+}
+
+func (s *DBStore) UpdateUnstartedTransactionWithNonce(ctx context.Context, fromAddress common.Address, nonce uint64) (*types.Transaction, error) {
+	var tx *types.Transaction
+	err := sqlutil.TransactDataSource(ctx, s.ds, nil, func(ds sqlutil.DataSource) error {
+		var unstarted dbTransaction
+		err := ds.GetContext(ctx, &unstarted, `SELECT * FROM evm.txm_v2_transactions
+			WHERE chain_id = $1 AND from_address = $2 AND state = 'unstarted' ORDER BY id LIMIT 1 FOR UPDATE`, ubig.New(s.chainID), fromAddress)
+		if errors.Is(err, sql.ErrNoRows) {
+			s.lggr.Debugf("Unstarted transactions queue is empty for address: %v", fromAddress)
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		existing, err := s.unconfirmedTransaction(ctx, ds, nonce, fromAddress, false)
+		if err != nil {
+			return err
+		}
+		if existing != nil {
+			return fmt.Errorf("an unconfirmed tx with the same nonce already exists: %v", existing.toTransaction())
+		}
+
+		var updated dbTransaction
+		err = ds.GetContext(ctx, &updated, `UPDATE evm.txm_v2_transactions SET nonce = $1, state = 'unconfirmed' WHERE id = $2 RETURNING *`, nonce, unstarted.ID)
+		if err != nil {
+			return fmt.Errorf("failed to assign nonce %d to txID: %v: %w", nonce, unstarted.ID, err)
+		}
+		tx = updated.toTransaction()
+		return nil
+	})
+	return tx, err
+}
+
+func (s *DBStore) DeleteAttemptForUnconfirmedTx(ctx context.Context, transactionNonce uint64, attempt *types.Attempt, fromAddress common.Address) error {
+	return sqlutil.TransactDataSource(ctx, s.ds, nil, func(ds sqlutil.DataSource) error {
+		tx, err := s.unconfirmedTransaction(ctx, ds, transactionNonce, fromAddress, true)
+		if err != nil {
+			return err
+		}
+		if tx == nil {
+			return fmt.Errorf("unconfirmed tx was not found for nonce: %d - txID: %v", transactionNonce, attempt.TxID)
+		}
+
+		res, err := ds.ExecContext(ctx, `DELETE FROM evm.txm_v2_attempts WHERE tx_id = $1 AND hash = $2`, tx.ID, attempt.Hash)
+		if err != nil {
+			return err
+		}
+		if n, err := res.RowsAffected(); err != nil {
+			return err
+		} else if n == 0 {
+			return fmt.Errorf("attempt with hash: %v for txID: %v was not found", attempt.Hash, attempt.TxID)
+		}
+		return nil
+	})
+}
+
+func (s *DBStore) MarkTxFatal(ctx context.Context, tx *types.Transaction, fromAddress common.Address) error {
+	res, err := s.ds.ExecContext(ctx, `UPDATE evm.txm_v2_transactions SET state = 'fatal_error' WHERE chain_id = $1 AND from_address = $2 AND id = $3`,
+		ubig.New(s.chainID), fromAddress, tx.ID)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return fmt.Errorf("tx with txID: %v was not found", tx.ID)
+	}
+	tx.State = txmgr.TxFatalError
+	return nil
+}
+
+func (s *DBStore) FindTxWithIdempotencyKey(ctx context.Context, idempotencyKey string) (*types.Transaction, error) {
+	var dbTx dbTransaction
+	err := s.ds.GetContext(ctx, &dbTx, `SELECT * FROM evm.txm_v2_transactions WHERE chain_id = $1 AND idempotency_key = $2`, ubig.New(s.chainID), idempotencyKey)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	txs, err := s.withAttempts(ctx, s.ds, []dbTransaction{dbTx})
+	if err != nil {
+		return nil, err
+	}
+	return txs[0], nil
+}
+
+// pruneTransactions deletes the oldest confirmed and fatal transactions of the address, keeping
+// the most recent maxQueuedTransactions of each, like the InMemoryStore keeps its confirmed
+// transactions. Confirmed transactions are ordered by nonce, so only the ones furthest from a
+// reorg are deleted.
+func (s *DBStore) pruneTransactions(ctx context.Context, ds sqlutil.DataSource, fromAddress common.Address) error {
+	var prunedTxIDs []uint64
+	err := ds.SelectContext(ctx, &prunedTxIDs, `DELETE FROM evm.txm_v2_transactions WHERE id IN (
+		(SELECT id FROM evm.txm_v2_transactions WHERE chain_id = $1 AND from_address = $2 AND state = 'confirmed' ORDER BY nonce DESC OFFSET $3)
+		UNION ALL
+		(SELECT id FROM evm.txm_v2_transactions WHERE chain_id = $1 AND from_address = $2 AND state = 'fatal_error' ORDER BY id DESC OFFSET $3)
+	) RETURNING id`, ubig.New(s.chainID), fromAddress, maxQueuedTransactions)
+	if err != nil {
+		return fmt.Errorf("failed to prune transactions: %w", err)
+	}
+	if len(prunedTxIDs) > 0 {
+		slices.Sort(prunedTxIDs)
+		s.lggr.Debugf("Confirmed and fatal transactions for address: %v reached max limit of: %d. Pruned the oldest transactions. TxIDs: %v",
+			fromAddress, maxQueuedTransactions, prunedTxIDs)
+	}
+	return nil
+}
+
+// unconfirmedTransaction returns the unconfirmed transaction with the given nonce, or nil if
+// there is none.
+func (s *DBStore) unconfirmedTransaction(ctx context.Context, ds sqlutil.DataSource, nonce uint64, fromAddress common.Address, forUpdate bool) (*dbTransaction, error) {
+	q := `SELECT * FROM evm.txm_v2_transactions WHERE chain_id = $1 AND from_address = $2 AND state = 'unconfirmed' AND nonce = $3`
+	if forUpdate {
+		q += ` FOR UPDATE`
+	}
+	var tx dbTransaction
+	err := ds.GetContext(ctx, &tx, q, ubig.New(s.chainID), fromAddress, nonce)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return &tx, err
+}
+
+// withAttempts converts the rows to transactions along with their attempts.
+func (s *DBStore) withAttempts(ctx context.Context, ds sqlutil.DataSource, dbTxs []dbTransaction) ([]*types.Transaction, error) {
+	if len(dbTxs) == 0 {
+		return nil, nil
+	}
+	txs := make([]*types.Transaction, len(dbTxs))
+	byID := make(map[uint64]*types.Transaction, len(dbTxs))
+	ids := make([]int64, len(dbTxs))
+	for i := range dbTxs {
+		txs[i] = dbTxs[i].toTransaction()
+		byID[txs[i].ID] = txs[i]
+		ids[i] = int64(dbTxs[i].ID) //nolint:gosec // IDs are BIGSERIAL
+	}
+
+	var attempts []dbAttempt
+	if err := ds.SelectContext(ctx, &attempts, `SELECT * FROM evm.txm_v2_attempts WHERE tx_id = ANY($1) ORDER BY id`, pq.Array(ids)); err != nil {
+		return nil, fmt.Errorf("failed to load attempts: %w", err)
+	}
+	for i := range attempts {
+		attempt, err := attempts[i].toAttempt()
+		if err != nil {
+			return nil, err
+		}
+		tx := byID[attempt.TxID]
+		tx.Attempts = append(tx.Attempts, attempt)
+	}
+	return txs, nil
+}
+
+func addressesToBytes(addresses []common.Address) [][]byte {
+	b := make([][]byte, len(addresses))
+	for i, address := range addresses {
+		b[i] = address.Bytes()
+	}
+	return b
+}
@@ -0,0 +1,172 @@
+package storage
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	evmtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+	"github.com/smartcontractkit/chainlink-framework/chains/txmgr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/evm/gas"
+	"github.com/smartcontractkit/chainlink/v2/evm/testutils"
+)
+
+func TestDBStore_Lifecycle(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	s := NewDBStore(db, logger.Test(t), testutils.FixtureChainID)
+	fromAddress := testutils.NewAddress()
+
+	idempotencyKey := "key"
+	tx, err := s.CreateTransaction(ctx, &types.TxRequest{
+		IdempotencyKey:    &idempotencyKey,
+		FromAddress:       fromAddress,
+		ToAddress:         testutils.NewAddress(),
+		Value:             big.NewInt(10),
+		SpecifiedGasLimit: 21000,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, txmgr.TxUnstarted, tx.State)
+	assert.Nil(t, tx.Nonce)
+
+	count, err := s.CountUnstartedTransactions(fromAddress)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	t.Run("assigns a nonce to the oldest unstarted transaction", func(t *testing.T) {
+		tx, err = s.UpdateUnstartedTransactionWithNonce(ctx, fromAddress, 0)
+		require.NoError(t, err)
+		require.NotNil(t, tx)
+		assert.Equal(t, txmgr.TxUnconfirmed, tx.State)
+		assert.Equal(t, uint64(0), *tx.Nonce)
+
+		empty, err := s.UpdateUnstartedTransactionWithNonce(ctx, fromAddress, 1)
+		require.NoError(t, err)
+		assert.Nil(t, empty)
+	})
+
+	t.Run("refuses to reuse a nonce", func(t *testing.T) {
+		_, err := s.CreateEmptyUnconfirmedTransaction(ctx, fromAddress, 0, 21000)
+		require.ErrorContains(t, err, "with the same nonce already exists")
+	})
+
+	attempt := &types.Attempt{
+		TxID:     tx.ID,
+		Hash:     common.HexToHash("0x01"),
+		Fee:      gas.EvmFee{GasPrice: assets.NewWeiI(100)},
+		GasLimit: 21000,
+		Type:     evmtypes.LegacyTxType,
+		SignedTransaction: evmtypes.NewTx(&evmtypes.LegacyTx{
+			Nonce: 0, GasPrice: big.NewInt(100), Gas: 21000, To: &tx.ToAddress, Value: big.NewInt(10),
+		}),
+	}
+
+	t.Run("persists attempts and broadcasts", func(t *testing.T) {
+		require.NoError(t, s.AppendAttemptToTransaction(ctx, 0, fromAddress, attempt))
+		require.NoError(t, s.UpdateTransactionBroadcast(ctx, tx.ID, 0, attempt.Hash, fromAddress))
+
+		// a fresh store sees the same state, as it would after a restart
+		restarted := NewDBStore(db, logger.Test(t), testutils.FixtureChainID)
+		require.NoError(t, restarted.Add(fromAddress))
+		found, unconfirmedCount, err := restarted.FetchUnconfirmedTransactionAtNonceWithCount(ctx, 0, fromAddress)
+		require.NoError(t, err)
+		assert.Equal(t, 1, unconfirmedCount)
+		require.NotNil(t, found)
+		assert.Equal(t, uint16(0), found.AttemptCount)
+		assert.NotNil(t, found.InitialBroadcastAt)
+		require.Len(t, found.Attempts, 1)
+		assert.Equal(t, attempt.Hash, found.Attempts[0].Hash)
+		assert.Equal(t, attempt.Fee.GasPrice, found.Attempts[0].Fee.GasPrice)
+		assert.Equal(t, attempt.SignedTransaction.Hash(), found.Attempts[0].SignedTransaction.Hash())
+		assert.NotNil(t, found.Attempts[0].BroadcastAt)
+
+		byKey, err := restarted.FindTxWithIdempotencyKey(ctx, idempotencyKey)
+		require.NoError(t, err)
+		assert.Equal(t, tx.ID, byKey.ID)
+	})
+
+	t.Run("confirms and reorgs transactions", func(t *testing.T) {
+		confirmed, unconfirmedIDs, err := s.MarkConfirmedAndReorgedTransactions(ctx, 1, fromAddress)
+		require.NoError(t, err)
+		require.Len(t, confirmed, 1)
+		assert.Equal(t, tx.ID, confirmed[0].ID)
+		assert.Len(t, confirmed[0].Attempts, 1)
+		assert.Empty(t, unconfirmedIDs)
+
+		confirmed, unconfirmedIDs, err = s.MarkConfirmedAndReorgedTransactions(ctx, 0, fromAddress)
+		require.NoError(t, err)
+		assert.Empty(t, confirmed)
+		assert.Equal(t, []uint64{tx.ID}, unconfirmedIDs)
+	})
+
+	t.Run("deletes attempts and marks transactions fatal", func(t *testing.T) {
+		require.NoError(t, s.DeleteAttemptForUnconfirmedTx(ctx, 0, attempt, fromAddress))
+		require.ErrorContains(t, s.DeleteAttemptForUnconfirmedTx(ctx, 0, attempt, fromAddress), "was not found")
+
+		require.NoError(t, s.MarkUnconfirmedTransactionPurgeable(ctx, 0, fromAddress))
+		require.NoError(t, s.AbandonPendingTransactions(ctx, fromAddress))
+		_, unconfirmedCount, err := s.FetchUnconfirmedTransactionAtNonceWithCount(ctx, 0, fromAddress)
+		require.NoError(t, err)
+		assert.Equal(t, 0, unconfirmedCount)
+	})
+}
+
+func TestDBStore_CreateTransaction_DropsOldestUnstarted(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+	s := NewDBStore(pgtest.NewSqlxDB(t), logger.Test(t), testutils.FixtureChainID)
+	fromAddress := testutils.NewAddress()
+
+	var first *types.Transaction
+	for i := 0; i < maxQueuedTransactions+1; i++ {
+		tx, err := s.CreateTransaction(ctx, &types.TxRequest{FromAddress: fromAddress, ToAddress: testutils.NewAddress()})
+		require.NoError(t, err)
+		if i == 0 {
+			first = tx
+		}
+	}
+
+	count, err := s.CountUnstartedTransactions(fromAddress)
+	require.NoError(t, err)
+	assert.Equal(t, maxQueuedTransactions, count)
+
+	// the oldest transaction was dropped to make room for the new one
+	tx, err := s.UpdateUnstartedTransactionWithNonce(ctx, fromAddress, 0)
+	require.NoError(t, err)
+	assert.Equal(t, first.ID+1, tx.ID)
+}
+
+func TestDBStore_MarkConfirmedAndReorgedTransactions_PrunesOldestConfirmed(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+	s := NewDBStore(pgtest.NewSqlxDB(t), logger.Test(t), testutils.FixtureChainID)
+	fromAddress := testutils.NewAddress()
+
+	n := uint64(maxQueuedTransactions + 10)
+	for nonce := uint64(0); nonce < n; nonce++ {
+		_, err := s.CreateEmptyUnconfirmedTransaction(ctx, fromAddress, nonce, 21000)
+		require.NoError(t, err)
+	}
+	confirmed, _, err := s.MarkConfirmedAndReorgedTransactions(ctx, n, fromAddress)
+	require.NoError(t, err)
+	require.Len(t, confirmed, int(n))
+
+	// the oldest confirmed transactions were pruned, so reorging all of them only returns the ones kept
+	_, unconfirmedIDs, err := s.MarkConfirmedAndReorgedTransactions(ctx, 0, fromAddress)
+	require.NoError(t, err)
+	require.Len(t, unconfirmedIDs, maxQueuedTransactions)
+	assert.Equal(t, confirmed[10].ID, unconfirmedIDs[0])
+}
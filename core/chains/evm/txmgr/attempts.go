@@ -8,6 +8,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/holiman/uint256"
 	pkgerrors "github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
@@ -32,16 +33,26 @@ type evmTxAttemptBuilder struct {
 	feeConfig evmTxAttemptBuilderFeeConfig
 	keystore  TxAttemptSigner[common.Address]
 	gas.EvmFeeEstimator
+	// blobSidecars is optional, and used to find the sidecar of blob transactions without attempts yet, marked as such
+	// in their meta
+	blobSidecars blobSidecarStore
 }
 
 type evmTxAttemptBuilderFeeConfig interface {
 	EIP1559DynamicFees() bool
 	PriceMaxKey(common.Address) *assets.Wei
+	BlobPriceMax() *assets.Wei
 	LimitDefault() uint64
 }
 
 func NewEvmTxAttemptBuilder(chainID big.Int, feeConfig evmTxAttemptBuilderFeeConfig, keystore TxAttemptSigner[common.Address], estimator gas.EvmFeeEstimator) *evmTxAttemptBuilder {
-	return &evmTxAttemptBuilder{chainID, feeConfig, keystore, estimator}
+	return &evmTxAttemptBuilder{chainID: chainID, feeConfig: feeConfig, keystore: keystore, EvmFeeEstimator: estimator}
+}
+
+// WithBlobSidecars makes new attempts of transactions with a sidecar in the store blob transactions.
+func (c *evmTxAttemptBuilder) WithBlobSidecars(store blobSidecarStore) *evmTxAttemptBuilder {
+	c.blobSidecars = store
+	return c
 }
 
 // NewTxAttempt builds an new attempt using the configured fee estimator + using the EIP1559 config to determine tx type
@@ -51,6 +62,15 @@ func (c *evmTxAttemptBuilder) NewTxAttempt(ctx context.Context, etx Tx, lggr log
 	if c.feeConfig.EIP1559DynamicFees() {
 		txType = 0x2
 	}
+	if c.blobSidecars != nil && isBlobTx(etx) {
+		sidecar, err := c.blobSidecars.FindTxBlobSidecar(ctx, etx.ID)
+		if err != nil {
+			return attempt, fee, feeLimit, true, fmt.Errorf("failed to find blob sidecar: %w", err)
+		}
+		if sidecar != nil {
+			txType = blobTxType
+		}
+	}
 	return c.NewTxAttemptWithType(ctx, etx, lggr, txType, opts...)
 }
 
@@ -62,6 +82,16 @@ func (c *evmTxAttemptBuilder) NewTxAttemptWithType(ctx context.Context, etx Tx,
 	if err != nil {
 		return attempt, fee, feeLimit, true, pkgerrors.Wrap(err, "failed to get fee") // estimator errors are retryable
 	}
+	if txType == blobTxType {
+		// Blob transactions are dynamic fee transactions, so a legacy gas price is used as both tip and fee cap
+		if !fee.ValidDynamic() && fee.GasPrice != nil {
+			fee = gas.EvmFee{DynamicFee: gas.DynamicFee{GasTipCap: fee.GasPrice, GasFeeCap: fee.GasPrice}}
+		}
+		fee.BlobFeeCap, err = c.EvmFeeEstimator.GetBlobFee(ctx, c.feeConfig.BlobPriceMax())
+		if err != nil {
+			return attempt, fee, feeLimit, true, pkgerrors.Wrap(err, "failed to get blob fee") // estimator errors are retryable
+		}
+	}
 
 	attempt, retryable, err = c.NewCustomTxAttempt(ctx, etx, fee, feeLimit, txType, lggr)
 	return attempt, fee, feeLimit, retryable, err
@@ -76,11 +106,19 @@ func (c *evmTxAttemptBuilder) NewBumpTxAttempt(ctx context.Context, etx Tx, prev
 	if err != nil {
 		return attempt, bumpedFee, bumpedFeeLimit, true, pkgerrors.Wrap(err, "failed to bump fee") // estimator errors are retryable
 	}
+	bumpedFee, err = c.bumpBlobFee(ctx, etx, previousAttempt, bumpedFee)
+	if err != nil {
+		return attempt, bumpedFee, bumpedFeeLimit, true, pkgerrors.Wrap(err, "failed to bump blob fee") // estimator errors are retryable
+	}
 	// If transaction's previous attempt is marked for purge, ensure the new bumped attempt also sends empty payload, 0 value, and LimitDefault as fee limit
 	if previousAttempt.IsPurgeAttempt {
 		etx.EncodedPayload = []byte{}
 		etx.Value = *big.NewInt(0)
 		bumpedFeeLimit = c.feeConfig.LimitDefault()
+		// Keep the recipient of the purge attempt, which is the sender for transactions cancelled by the operator
+		if to, err := signedTxRecipient(previousAttempt.SignedRawTx); err == nil {
+			etx.ToAddress = to
+		}
 	}
 	attempt, retryable, err = c.NewCustomTxAttempt(ctx, etx, bumpedFee, bumpedFeeLimit, previousAttempt.TxType, lggr)
 	// If transaction's previous attempt is marked for purge, ensure the new bumped attempt is also marked for purge
@@ -100,6 +138,10 @@ func (c *evmTxAttemptBuilder) NewPurgeTxAttempt(ctx context.Context, etx Tx, lgg
 	if err != nil {
 		return attempt, fmt.Errorf("failed to bump previous fee to use for the purge attempt: %w", err)
 	}
+	bumpedFee, err = c.bumpBlobFee(ctx, etx, previousAttempt, bumpedFee)
+	if err != nil {
+		return attempt, fmt.Errorf("failed to bump previous blob fee to use for the purge attempt: %w", err)
+	}
 	// Set empty payload and 0 value for purge attempts
 	etx.EncodedPayload = []byte{}
 	etx.Value = *big.NewInt(0)
@@ -134,6 +176,23 @@ func (c *evmTxAttemptBuilder) NewCustomTxAttempt(ctx context.Context, etx Tx, fe
 			GasTipCap: fee.GasTipCap,
 		}, gasLimit)
 		return attempt, true, err
+	case blobTxType: // blob, EIP4844
+		if !fee.ValidDynamic() || fee.BlobFeeCap == nil {
+			err = pkgerrors.Errorf("Attempt %v is a type 3 transaction but estimator did not return dynamic and blob fees", attempt.ID)
+			logger.Sugared(lggr).AssumptionViolation(err.Error())
+			return attempt, false, err // not retryable
+		}
+		sidecar, err := c.txBlobSidecar(ctx, etx)
+		if err != nil {
+			return attempt, true, fmt.Errorf("failed to find blob sidecar: %w", err)
+		}
+		if sidecar == nil {
+			err = pkgerrors.Errorf("Attempt %v is a type 3 transaction but transaction %v has no blob sidecar", attempt.ID, etx.ID)
+			logger.Sugared(lggr).AssumptionViolation(err.Error())
+			return attempt, false, err // not retryable
+		}
+		attempt, err = c.newBlobAttempt(ctx, etx, fee, gasLimit, sidecar)
+		return attempt, true, err
 	default:
 		err = pkgerrors.Errorf("invariant violation: Attempt %v had unrecognised transaction type %v"+
 			"This is a bug! Please report to https://github.com/smartcontractkit/chainlink/issues", attempt.ID, attempt.TxType)
@@ -199,6 +258,61 @@ func (c *evmTxAttemptBuilder) newDynamicFeeAttempt(ctx context.Context, etx Tx,
 	return attempt, nil
 }
 
+func (c *evmTxAttemptBuilder) newBlobAttempt(ctx context.Context, etx Tx, fee gas.EvmFee, gasLimit uint64, sidecar *types.BlobTxSidecar) (attempt TxAttempt, err error) {
+	if err = validateDynamicFeeGas(c.feeConfig, fee.DynamicFee, etx); err != nil {
+		return attempt, pkgerrors.Wrap(err, "error validating gas")
+	}
+	if fee.BlobFeeCap.ToInt().Cmp(Max256BitUInt) >= 0 || etx.Value.Cmp(Max256BitUInt) >= 0 {
+		return attempt, pkgerrors.New("error validating gas: impossibly large blob fee cap or value")
+	}
+
+	tx := types.NewTx(&types.BlobTx{
+		ChainID:    uint256.MustFromBig(&c.chainID),
+		Nonce:      uint64(*etx.Sequence),
+		GasTipCap:  uint256.MustFromBig(fee.GasTipCap.ToInt()),
+		GasFeeCap:  uint256.MustFromBig(fee.GasFeeCap.ToInt()),
+		Gas:        gasLimit,
+		To:         etx.ToAddress,
+		Value:      uint256.MustFromBig(&etx.Value),
+		Data:       etx.EncodedPayload,
+		BlobFeeCap: uint256.MustFromBig(fee.BlobFeeCap.ToInt()),
+		BlobHashes: sidecar.BlobHashes(),
+		Sidecar:    sidecar,
+	})
+	attempt, err = c.newSignedAttempt(ctx, etx, tx)
+	if err != nil {
+		return attempt, err
+	}
+	attempt.TxFee = gas.EvmFee{
+		DynamicFee: gas.DynamicFee{GasFeeCap: fee.GasFeeCap, GasTipCap: fee.GasTipCap},
+		BlobFeeCap: fee.BlobFeeCap,
+	}
+	attempt.ChainSpecificFeeLimit = gasLimit
+	attempt.TxType = blobTxType
+	return attempt, nil
+}
+
+// bumpBlobFee completes the bumped fee of a blob transaction attempt. The blob pool only accepts replacements
+// with all their fees bumped by at least 100%, so the tip and fee caps are at least doubled, and the blob fee
+// cap is bumped by the estimator, up to the configured max blob fee.
+func (c *evmTxAttemptBuilder) bumpBlobFee(ctx context.Context, etx Tx, previousAttempt TxAttempt, bumpedFee gas.EvmFee) (gas.EvmFee, error) {
+	if previousAttempt.TxType != blobTxType {
+		return bumpedFee, nil
+	}
+	if !bumpedFee.ValidDynamic() || !previousAttempt.TxFee.ValidDynamic() {
+		return bumpedFee, pkgerrors.Errorf("blob transaction attempt %v has no dynamic fee", previousAttempt.ID)
+	}
+	maxPrice := c.feeConfig.PriceMaxKey(etx.FromAddress)
+	bumpedFee.GasTipCap = assets.WeiMax(bumpedFee.GasTipCap, previousAttempt.TxFee.GasTipCap.Mul(big.NewInt(2)))
+	bumpedFee.GasFeeCap = assets.WeiMax(bumpedFee.GasFeeCap, previousAttempt.TxFee.GasFeeCap.Mul(big.NewInt(2)))
+	if bumpedFee.GasFeeCap.Cmp(maxPrice) > 0 {
+		return bumpedFee, fmt.Errorf("%w: bumped fee cap of %s would exceed configured max gas price of %s", fees.ErrBumpFeeExceedsLimit, bumpedFee.GasFeeCap, maxPrice)
+	}
+	var err error
+	bumpedFee.BlobFeeCap, err = c.EvmFeeEstimator.BumpBlobFee(ctx, previousAttempt.TxFee.BlobFeeCap, c.feeConfig.BlobPriceMax())
+	return bumpedFee, err
+}
+
 var Max256BitUInt = big.NewInt(0).Exp(big.NewInt(2), big.NewInt(256), nil)
 
 type keySpecificEstimator interface {
@@ -351,3 +465,15 @@ func newEvmPriorAttempts(attempts []TxAttempt) (prior []gas.EvmPriorAttempt) {
 	}
 	return
 }
+
+// signedTxRecipient returns the recipient of the signed transaction.
+func signedTxRecipient(signedRawTx []byte) (common.Address, error) {
+	tx, err := GetGethSignedTx(signedRawTx)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if tx.To() == nil {
+		return common.Address{}, pkgerrors.New("transaction has no recipient")
+	}
+	return *tx.To(), nil
+}
@@ -36,6 +36,7 @@ type feeConfig struct {
 	tipCapMin          *assets.Wei
 	priceMin           *assets.Wei
 	priceMax           *assets.Wei
+	blobPriceMax       *assets.Wei
 	limitDefault       uint64
 }
 
@@ -51,6 +52,7 @@ func (g *feeConfig) EIP1559DynamicFees() bool                        { return g.
 func (g *feeConfig) TipCapMin() *assets.Wei                          { return g.tipCapMin }
 func (g *feeConfig) PriceMin() *assets.Wei                           { return g.priceMin }
 func (g *feeConfig) PriceMaxKey(addr gethcommon.Address) *assets.Wei { return g.priceMax }
+func (g *feeConfig) BlobPriceMax() *assets.Wei                       { return g.blobPriceMax }
 func (g *feeConfig) LimitDefault() uint64                            { return g.limitDefault }
 
 func TestTxm_SignTx(t *testing.T) {
@@ -360,3 +362,40 @@ func TestTxm_EvmTxAttemptBuilder_RetryableEstimatorError(t *testing.T) {
 		assert.True(t, retryable)
 	})
 }
+
+func TestTxm_BumpSelfTransferPurgeAttempt(t *testing.T) {
+	addr := NewEvmAddress()
+	kst := ksmocks.NewEth(t)
+	// The signed transaction is a self-transfer, which bumped purge attempts of cancelled transactions have to keep
+	tx := types.NewTx(&types.LegacyTx{To: &addr})
+	kst.On("SignTx", mock.Anything, addr, mock.Anything, big.NewInt(1)).Return(tx, nil)
+	gc := newFeeConfig()
+	gc.priceMax = assets.GWei(50)
+	gc.limitDefault = uint64(21_000)
+	est := gasmocks.NewEvmFeeEstimator(t)
+	bumpedLegacy := assets.GWei(30)
+	est.On("BumpFee", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(gas.EvmFee{GasPrice: bumpedLegacy}, uint64(10_000), nil)
+	cks := txmgr.NewEvmTxAttemptBuilder(*big.NewInt(1), gc, kst, est)
+	lggr := logger.Test(t)
+	ctx := tests.Context(t)
+
+	n := evmtypes.Nonce(0)
+	etx := txmgr.Tx{Sequence: &n, FromAddress: addr, ToAddress: NewEvmAddress(), EncodedPayload: []byte{1, 2, 3}, Value: *big.NewInt(42)}
+	prevAttempt, _, err := cks.NewCustomTxAttempt(ctx, etx, gas.EvmFee{GasPrice: bumpedLegacy.Sub(assets.GWei(1))}, 100, 0x0, lggr)
+	require.NoError(t, err)
+	etx.TxAttempts = append(etx.TxAttempts, prevAttempt)
+
+	cancelled := etx
+	cancelled.ToAddress = addr
+	purgeAttempt, err := cks.NewPurgeTxAttempt(ctx, cancelled, lggr)
+	require.NoError(t, err)
+	require.True(t, purgeAttempt.IsPurgeAttempt)
+	require.Equal(t, addr, purgeAttempt.Tx.ToAddress)
+
+	etx.TxAttempts = append(etx.TxAttempts, purgeAttempt)
+	bumpAttempt, _, _, _, err := cks.NewBumpTxAttempt(ctx, etx, purgeAttempt, etx.TxAttempts, lggr)
+	require.NoError(t, err)
+	require.True(t, bumpAttempt.IsPurgeAttempt)
+	require.Equal(t, addr, bumpAttempt.Tx.ToAddress)
+	require.Equal(t, []byte{}, bumpAttempt.Tx.EncodedPayload)
+}
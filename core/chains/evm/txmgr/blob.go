@@ -0,0 +1,147 @@
+package txmgr
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// blobTxType is the EIP-4844 transaction type, carrying blobs in a sidecar.
+const blobTxType = 0x3
+
+// maxBlobsPerTx is the most blobs a single transaction can carry, bounded by the blob gas of a block.
+const maxBlobsPerTx = params.MaxBlobGasPerBlock / params.BlobTxBlobGasPerBlob
+
+// blobTxMeta is the field added to the meta of blob transactions, so that their sidecar is only looked up for them.
+type blobTxMeta struct {
+	BlobTx bool `json:",omitempty"`
+}
+
+// isBlobTx returns whether the transaction was created as a blob transaction, with its sidecar in the store.
+func isBlobTx(etx Tx) bool {
+	if etx.Meta == nil {
+		return false
+	}
+	var meta blobTxMeta
+	return json.Unmarshal(*etx.Meta, &meta) == nil && meta.BlobTx
+}
+
+type blobSidecarStore interface {
+	FindTxBlobSidecar(ctx context.Context, etxID int64) (*types.BlobTxSidecar, error)
+}
+
+type blobTxStore interface {
+	CheckTxQueueCapacity(ctx context.Context, fromAddress common.Address, maxQueuedTransactions uint64, chainID *big.Int) (err error)
+	FindTxWithIdempotencyKey(ctx context.Context, idempotencyKey string, chainID *big.Int) (tx *Tx, err error)
+	CreateBlobTransaction(ctx context.Context, txRequest TxRequest, sidecar *types.BlobTxSidecar, chainID *big.Int) (tx Tx, err error)
+}
+
+// BlobTxManager is implemented by the transaction managers that can send EIP-4844 blob transactions.
+type BlobTxManager interface {
+	// CreateBlobTransaction queues a blob transaction carrying the sidecar.
+	CreateBlobTransaction(ctx context.Context, txRequest TxRequest, sidecar *types.BlobTxSidecar) (Tx, error)
+}
+
+var _ BlobTxManager = &evmTxm{}
+
+func (t *evmTxm) CreateBlobTransaction(ctx context.Context, txRequest TxRequest, sidecar *types.BlobTxSidecar) (tx Tx, err error) {
+	ok := t.IfStarted(func() {
+		tx, err = t.blobs.CreateBlobTransaction(ctx, txRequest, sidecar)
+	})
+	if !ok {
+		return tx, errTxmNotStarted
+	}
+	return tx, err
+}
+
+type blobTxTrigger interface {
+	Trigger(addr common.Address)
+}
+
+// BlobTxCreator queues EIP-4844 blob transactions with the Txm, for its BlobTxManager. The sidecar is stored alongside the
+// transaction, and every attempt of the transaction is a type 3 transaction carrying it, with its
+// blob fee estimated and bumped by the fee estimator.
+//
+// Forwarders are not supported, as blob transactions must be sent directly to their recipient.
+type BlobTxCreator struct {
+	txm       blobTxTrigger
+	txStore   blobTxStore
+	chainID   *big.Int
+	maxQueued uint64
+}
+
+func NewBlobTxCreator(txm blobTxTrigger, txStore blobTxStore, chainID *big.Int, maxQueued uint64) *BlobTxCreator {
+	return &BlobTxCreator{txm: txm, txStore: txStore, chainID: chainID, maxQueued: maxQueued}
+}
+
+// CreateBlobTransaction validates the sidecar, queues the transaction, and triggers its broadcast.
+func (c *BlobTxCreator) CreateBlobTransaction(ctx context.Context, txRequest TxRequest, sidecar *types.BlobTxSidecar) (tx Tx, err error) {
+	if txRequest.IdempotencyKey != nil {
+		existingTx, err := c.txStore.FindTxWithIdempotencyKey(ctx, *txRequest.IdempotencyKey, c.chainID)
+		if err != nil {
+			return tx, fmt.Errorf("failed to search for transaction with IdempotencyKey: %w", err)
+		}
+		if existingTx != nil {
+			return *existingTx, nil
+		}
+	}
+	if err = validateBlobSidecar(sidecar); err != nil {
+		return tx, fmt.Errorf("invalid blob sidecar: %w", err)
+	}
+	if err = c.txStore.CheckTxQueueCapacity(ctx, txRequest.FromAddress, c.maxQueued, c.chainID); err != nil {
+		return tx, fmt.Errorf("BlobTxCreator#CreateBlobTransaction: %w", err)
+	}
+	tx, err = c.txStore.CreateBlobTransaction(ctx, txRequest, sidecar, c.chainID)
+	if err != nil {
+		return tx, err
+	}
+	c.txm.Trigger(txRequest.FromAddress)
+	return tx, nil
+}
+
+// validateBlobSidecar checks that the sidecar carries a valid proof for each of its blobs.
+func validateBlobSidecar(sidecar *types.BlobTxSidecar) error {
+	if sidecar == nil {
+		return errors.New("sidecar is missing")
+	}
+	if len(sidecar.Blobs) == 0 {
+		return errors.New("sidecar has no blobs")
+	}
+	if len(sidecar.Blobs) > maxBlobsPerTx {
+		return fmt.Errorf("sidecar has %d blobs, more than the maximum of %d", len(sidecar.Blobs), maxBlobsPerTx)
+	}
+	if len(sidecar.Commitments) != len(sidecar.Blobs) || len(sidecar.Proofs) != len(sidecar.Blobs) {
+		return fmt.Errorf("sidecar has %d blobs, but %d commitments and %d proofs", len(sidecar.Blobs), len(sidecar.Commitments), len(sidecar.Proofs))
+	}
+	for i := range sidecar.Blobs {
+		if err := kzg4844.VerifyBlobProof(&sidecar.Blobs[i], sidecar.Commitments[i], sidecar.Proofs[i]); err != nil {
+			return fmt.Errorf("invalid proof for blob %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// txBlobSidecar returns the sidecar carried by the attempts of the transaction, looking it up in the
+// store if none of the attempts is a blob transaction. It returns nil if the transaction doesn't carry blobs.
+func (c *evmTxAttemptBuilder) txBlobSidecar(ctx context.Context, etx Tx) (*types.BlobTxSidecar, error) {
+	for _, a := range etx.TxAttempts {
+		if a.TxType != blobTxType {
+			continue
+		}
+		tx, err := GetGethSignedTx(a.SignedRawTx)
+		if err == nil && tx.BlobTxSidecar() != nil {
+			return tx.BlobTxSidecar(), nil
+		}
+	}
+	if c.blobSidecars == nil || !isBlobTx(etx) {
+		return nil, nil
+	}
+	return c.blobSidecars.FindTxBlobSidecar(ctx, etx.ID)
+}
@@ -0,0 +1,198 @@
+package txmgr_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-framework/chains/fees"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr/mocks"
+	"github.com/smartcontractkit/chainlink/v2/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/evm/gas"
+	gasmocks "github.com/smartcontractkit/chainlink/v2/evm/gas/mocks"
+	ksmocks "github.com/smartcontractkit/chainlink/v2/evm/keystore/mocks"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/evm/types"
+)
+
+// newBlobSidecar returns a valid sidecar carrying an empty blob.
+func newBlobSidecar(t *testing.T) *types.BlobTxSidecar {
+	var blob kzg4844.Blob
+	commitment, err := kzg4844.BlobToCommitment(&blob)
+	require.NoError(t, err)
+	proof, err := kzg4844.ComputeBlobProof(&blob, commitment)
+	require.NoError(t, err)
+	return &types.BlobTxSidecar{Blobs: []kzg4844.Blob{blob}, Commitments: []kzg4844.Commitment{commitment}, Proofs: []kzg4844.Proof{proof}}
+}
+
+type blobTxTrigger struct{ triggered []common.Address }
+
+func (b *blobTxTrigger) Trigger(addr common.Address) { b.triggered = append(b.triggered, addr) }
+
+func TestBlobTxCreator(t *testing.T) {
+	t.Parallel()
+
+	chainID := big.NewInt(1)
+	from := NewEvmAddress()
+	txRequest := txmgr.TxRequest{FromAddress: from, ToAddress: NewEvmAddress(), FeeLimit: 100_000}
+
+	t.Run("creates blob transaction", func(t *testing.T) {
+		sidecar := newBlobSidecar(t)
+		txStore := mocks.NewEvmTxStore(t)
+		txStore.On("CheckTxQueueCapacity", mock.Anything, from, uint64(10), chainID).Return(nil).Once()
+		txStore.On("CreateBlobTransaction", mock.Anything, txRequest, sidecar, chainID).Return(txmgr.Tx{ID: 1}, nil).Once()
+		trigger := &blobTxTrigger{}
+
+		tx, err := txmgr.NewBlobTxCreator(trigger, txStore, chainID, 10).CreateBlobTransaction(tests.Context(t), txRequest, sidecar)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), tx.ID)
+		assert.Equal(t, []common.Address{from}, trigger.triggered)
+	})
+
+	t.Run("returns existing transaction with idempotency key", func(t *testing.T) {
+		key := "key"
+		request := txRequest
+		request.IdempotencyKey = &key
+		txStore := mocks.NewEvmTxStore(t)
+		txStore.On("FindTxWithIdempotencyKey", mock.Anything, key, chainID).Return(&txmgr.Tx{ID: 2}, nil).Once()
+
+		tx, err := txmgr.NewBlobTxCreator(&blobTxTrigger{}, txStore, chainID, 10).CreateBlobTransaction(tests.Context(t), request, newBlobSidecar(t))
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), tx.ID)
+	})
+
+	t.Run("rejects invalid sidecars", func(t *testing.T) {
+		creator := txmgr.NewBlobTxCreator(&blobTxTrigger{}, mocks.NewEvmTxStore(t), chainID, 10)
+
+		_, err := creator.CreateBlobTransaction(tests.Context(t), txRequest, nil)
+		require.ErrorContains(t, err, "sidecar is missing")
+
+		_, err = creator.CreateBlobTransaction(tests.Context(t), txRequest, &types.BlobTxSidecar{})
+		require.ErrorContains(t, err, "sidecar has no blobs")
+
+		sidecar := newBlobSidecar(t)
+		sidecar.Proofs = nil
+		_, err = creator.CreateBlobTransaction(tests.Context(t), txRequest, sidecar)
+		require.ErrorContains(t, err, "sidecar has 1 blobs, but 1 commitments and 0 proofs")
+
+		sidecar = newBlobSidecar(t)
+		sidecar.Blobs[0][31] = 1
+		_, err = creator.CreateBlobTransaction(tests.Context(t), txRequest, sidecar)
+		require.ErrorContains(t, err, "invalid proof for blob 0")
+	})
+}
+
+func TestTxm_NewBlobAttempt(t *testing.T) {
+	addr := NewEvmAddress()
+	kst := ksmocks.NewEth(t)
+	kst.On("SignTx", mock.Anything, addr, mock.Anything, big.NewInt(1)).Return(
+		func(_ context.Context, _ common.Address, tx *types.Transaction, _ *big.Int) *types.Transaction {
+			return tx
+		}, nil)
+	gc := newFeeConfig()
+	gc.priceMax = assets.GWei(50)
+	gc.blobPriceMax = assets.GWei(5)
+	est := gasmocks.NewEvmFeeEstimator(t)
+	fee := gas.EvmFee{DynamicFee: gas.DynamicFee{GasTipCap: assets.GWei(1), GasFeeCap: assets.GWei(10)}}
+	est.On("GetFee", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(fee, uint64(100_000), nil)
+	est.On("GetBlobFee", mock.Anything, gc.blobPriceMax).Return(assets.NewWeiI(5), nil).Maybe()
+	lggr := logger.Test(t)
+	ctx := tests.Context(t)
+	sidecar := newBlobSidecar(t)
+
+	n := evmtypes.Nonce(0)
+	newTx := func() txmgr.Tx {
+		return txmgr.Tx{ID: 1, Sequence: &n, FromAddress: addr, ToAddress: NewEvmAddress(), EncodedPayload: []byte{1, 2, 3}}
+	}
+
+	newBlobTx := func() txmgr.Tx {
+		etx := newTx()
+		meta := sqlutil.JSON(`{"BlobTx": true}`)
+		etx.Meta = &meta
+		return etx
+	}
+
+	t.Run("creates dynamic fee attempt for transactions without sidecar", func(t *testing.T) {
+		// the sidecar is only looked up for blob transactions
+		txStore := mocks.NewEvmTxStore(t)
+		cks := txmgr.NewEvmTxAttemptBuilder(*big.NewInt(1), &feeConfig{eip1559DynamicFees: true, priceMax: gc.priceMax}, kst, est).WithBlobSidecars(txStore)
+
+		a, _, _, _, err := cks.NewTxAttempt(ctx, newTx(), lggr)
+		require.NoError(t, err)
+		assert.Equal(t, 0x2, a.TxType)
+		assert.Nil(t, a.TxFee.BlobFeeCap)
+	})
+
+	t.Run("creates and bumps blob attempts", func(t *testing.T) {
+		txStore := mocks.NewEvmTxStore(t)
+		txStore.On("FindTxBlobSidecar", mock.Anything, int64(1)).Return(sidecar, nil)
+		cks := txmgr.NewEvmTxAttemptBuilder(*big.NewInt(1), gc, kst, est).WithBlobSidecars(txStore)
+
+		etx := newBlobTx()
+		a, _, _, _, err := cks.NewTxAttempt(ctx, etx, lggr)
+		require.NoError(t, err)
+		assert.Equal(t, 0x3, a.TxType)
+		assert.Equal(t, fee.GasTipCap, a.TxFee.GasTipCap)
+		assert.Equal(t, fee.GasFeeCap, a.TxFee.GasFeeCap)
+		assert.Equal(t, assets.NewWeiI(5), a.TxFee.BlobFeeCap)
+
+		signed, err := txmgr.GetGethSignedTx(a.SignedRawTx)
+		require.NoError(t, err)
+		assert.Equal(t, uint8(types.BlobTxType), signed.Type())
+		assert.Equal(t, sidecar.BlobHashes(), signed.BlobHashes())
+		assert.Equal(t, big.NewInt(5), signed.BlobGasFeeCap())
+		require.NotNil(t, signed.BlobTxSidecar())
+		assert.Equal(t, sidecar.Commitments, signed.BlobTxSidecar().Commitments)
+
+		// The blob pool only accepts replacements with all their fees doubled
+		est.On("BumpFee", mock.Anything, a.TxFee, mock.Anything, mock.Anything, mock.Anything).Return(gas.EvmFee{DynamicFee: gas.DynamicFee{GasTipCap: assets.NewWeiI(1_100_000_000), GasFeeCap: assets.GWei(11)}}, uint64(100_000), nil).Once()
+		est.On("BumpBlobFee", mock.Anything, assets.NewWeiI(5), gc.blobPriceMax).Return(assets.NewWeiI(10), nil).Once()
+		etx.TxAttempts = []txmgr.TxAttempt{a}
+		bumped, _, _, _, err := cks.NewBumpTxAttempt(ctx, etx, a, etx.TxAttempts, lggr)
+		require.NoError(t, err)
+		assert.Equal(t, 0x3, bumped.TxType)
+		assert.Equal(t, assets.GWei(2), bumped.TxFee.GasTipCap)
+		assert.Equal(t, assets.GWei(20), bumped.TxFee.GasFeeCap)
+		assert.Equal(t, assets.NewWeiI(10), bumped.TxFee.BlobFeeCap)
+
+		signed, err = txmgr.GetGethSignedTx(bumped.SignedRawTx)
+		require.NoError(t, err)
+		assert.Equal(t, sidecar.BlobHashes(), signed.BlobHashes())
+
+		// The fee can't be doubled past the max gas price
+		highFee := gas.EvmFee{DynamicFee: gas.DynamicFee{GasTipCap: assets.GWei(3), GasFeeCap: assets.GWei(30)}, BlobFeeCap: assets.NewWeiI(10)}
+		high, _, err := cks.NewCustomTxAttempt(ctx, etx, highFee, 100_000, 0x3, lggr)
+		require.NoError(t, err)
+		est.On("BumpFee", mock.Anything, high.TxFee, mock.Anything, mock.Anything, mock.Anything).Return(gas.EvmFee{DynamicFee: gas.DynamicFee{GasTipCap: assets.GWei(4), GasFeeCap: assets.GWei(33)}}, uint64(100_000), nil).Once()
+		_, _, _, _, err = cks.NewBumpTxAttempt(ctx, etx, high, etx.TxAttempts, lggr)
+		require.ErrorIs(t, err, fees.ErrBumpFeeExceedsLimit)
+	})
+
+	t.Run("fails without sidecar", func(t *testing.T) {
+		cks := txmgr.NewEvmTxAttemptBuilder(*big.NewInt(1), gc, kst, est)
+
+		_, retryable, err := cks.NewCustomTxAttempt(ctx, newTx(), gas.EvmFee{DynamicFee: fee.DynamicFee, BlobFeeCap: assets.NewWeiI(5)}, 100_000, 0x3, lggr)
+		require.ErrorContains(t, err, "has no blob sidecar")
+		assert.False(t, retryable)
+	})
+
+	t.Run("fails without blob fee", func(t *testing.T) {
+		cks := txmgr.NewEvmTxAttemptBuilder(*big.NewInt(1), gc, kst, est)
+
+		_, retryable, err := cks.NewCustomTxAttempt(ctx, newTx(), fee, 100_000, 0x3, lggr)
+		require.Error(t, err)
+		assert.False(t, retryable)
+	})
+}
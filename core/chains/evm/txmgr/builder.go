@@ -2,6 +2,7 @@ package txmgr
 
 import (
 	"context"
+	"errors"
 	"math/big"
 	"time"
 
@@ -56,9 +57,9 @@ func NewTxm(
 		lggr.Info("EvmForwarderManager: Disabled")
 	}
 	checker := &CheckerFactory{Client: client}
-	// create tx attempt builder
-	txAttemptBuilder := NewEvmTxAttemptBuilder(*client.ConfiguredChainID(), fCfg, keyStore, estimator)
 	txStore := NewTxStore(ds, lggr)
+	// create tx attempt builder
+	txAttemptBuilder := NewEvmTxAttemptBuilder(*client.ConfiguredChainID(), fCfg, keyStore, estimator).WithBlobSidecars(txStore)
 	txmCfg := NewEvmTxmConfig(chainConfig)             // wrap Evm specific config
 	feeCfg := NewEvmTxmFeeConfig(fCfg)                 // wrap Evm specific config
 	txmClient := NewEvmTxmClient(client, clientErrors) // wrap Evm specific client
@@ -66,16 +67,38 @@ func NewTxm(
 	evmBroadcaster := NewEvmBroadcaster(txStore, txmClient, txmCfg, feeCfg, txConfig, listenerConfig, keyStore, txAttemptBuilder, lggr, checker, chainConfig.NonceAutoSync(), chainConfig.ChainType())
 	evmTracker := NewEvmTracker(txStore, keyStore, chainID, lggr)
 	stuckTxDetector := NewStuckTxDetector(lggr, client.ConfiguredChainID(), chainConfig.ChainType(), fCfg.PriceMax(), txConfig.AutoPurge(), estimator, txStore, client)
-	evmConfirmer := NewEvmConfirmer(txStore, txmClient, feeCfg, txConfig, dbConfig, keyStore, txAttemptBuilder, lggr, stuckTxDetector, headTracker)
+	// the Confirmer applies the replacements requested by the node operator
+	replacer := newTxReplacer(lggr, chainID, txStore)
+	evmConfirmer := NewEvmConfirmer(replacer.wrapTxStore(txStore), txmClient, feeCfg, txConfig, dbConfig, keyStore, txAttemptBuilder, lggr, replacer.wrapStuckTxDetector(stuckTxDetector), headTracker)
 	evmFinalizer := NewEvmFinalizer(lggr, client.ConfiguredChainID(), chainConfig.RPCDefaultBatchSize(), txConfig.ForwardersEnabled(), txStore, txmClient, headTracker)
 	var evmResender *Resender
 	if txConfig.ResendAfterThreshold() > 0 {
 		evmResender = NewEvmResender(lggr, txStore, txmClient, evmTracker, keyStore, txmgr.DefaultResenderPollInterval, chainConfig, txConfig)
 	}
-	txm = NewEvmTxm(chainID, txmCfg, txConfig, keyStore, lggr, checker, fwdMgr, txAttemptBuilder, txStore, evmBroadcaster, evmConfirmer, evmResender, evmTracker, evmFinalizer, txmv2wrapper)
+	baseTxm := NewEvmTxm(chainID, txmCfg, txConfig, keyStore, lggr, checker, fwdMgr, txAttemptBuilder, txStore, evmBroadcaster, evmConfirmer, evmResender, evmTracker, evmFinalizer, txmv2wrapper)
+	txm = &evmTxm{
+		Txm:       baseTxm,
+		replacer:  replacer,
+		blobs:     NewBlobTxCreator(baseTxm, txStore, chainID, txConfig.MaxQueued()),
+		transfers: txStore,
+		chainID:   chainID,
+	}
 	return txm, nil
 }
 
+var errTxmNotStarted = errors.New("transaction manager is not started")
+
+// evmTxm is the Txm of an EVM chain, extended with the EVM specific features exposed through its TxManager: the
+// replacement of transactions on request of the node operator, blob transactions, and the lookup of unconfirmed
+// transfers.
+type evmTxm struct {
+	*Txm
+	replacer  *txReplacer
+	blobs     *BlobTxCreator
+	transfers transferTxStore
+	chainID   *big.Int
+}
+
 // NewEvmTxm creates a new concrete EvmTxm
 func NewEvmTxm(
 	chainId *big.Int,
@@ -129,7 +152,7 @@ func NewTxmV2(
 	}
 
 	attemptBuilder := txm.NewAttemptBuilder(chainID, fCfg.PriceMaxKey, estimator, keyStore)
-	inMemoryStoreManager := storage.NewInMemoryStoreManager(lggr, chainID)
+	txStore := storage.NewDBStore(ds, lggr, chainID)
 	config := txm.Config{
 		EIP1559:   fCfg.EIP1559DynamicFees(),
 		BlockTime: *txmV2Config.BlockTime(),
@@ -143,8 +166,8 @@ func NewTxmV2(
 	} else {
 		c = clientwrappers.NewChainClient(client)
 	}
-	t := txm.NewTxm(lggr, chainID, c, attemptBuilder, inMemoryStoreManager, stuckTxDetector, config, keyStore)
-	return txm.NewTxmOrchestrator(lggr, chainID, t, inMemoryStoreManager, fwdMgr, keyStore, attemptBuilder), nil
+	t := txm.NewTxm(lggr, chainID, c, attemptBuilder, txStore, stuckTxDetector, config, keyStore)
+	return txm.NewTxmOrchestrator(lggr, chainID, t, txStore, fwdMgr, keyStore, attemptBuilder), nil
 }
 
 // NewEvmResender creates a new concrete EvmResender
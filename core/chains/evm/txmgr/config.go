@@ -32,6 +32,7 @@ type FeeConfig interface {
 	PriceMax() *assets.Wei
 	PriceMin() *assets.Wei
 	PriceMaxKey(gethcommon.Address) *assets.Wei
+	BlobPriceMax() *assets.Wei
 }
 
 type DatabaseConfig interface {
@@ -11,6 +11,8 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/google/uuid"
 	"github.com/jackc/pgconn"
 	"github.com/jmoiron/sqlx"
@@ -51,6 +53,13 @@ type EvmTxStore interface {
 	FindTxesByIDs(ctx context.Context, etxIDs []int64, chainID *big.Int) (etxs []*Tx, err error)
 	SaveFetchedReceipts(ctx context.Context, r []*types.Receipt) (err error)
 	UpdateTxStatesToFinalizedUsingTxHashes(ctx context.Context, txHashes []common.Hash, chainID *big.Int) error
+	CreateBlobTransaction(ctx context.Context, txRequest TxRequest, sidecar *gethtypes.BlobTxSidecar, chainID *big.Int) (tx Tx, err error)
+	FindTxBlobSidecar(ctx context.Context, etxID int64) (*gethtypes.BlobTxSidecar, error)
+	HasUnconfirmedTransfer(ctx context.Context, fromAddress, toAddress common.Address, chainID *big.Int) (bool, error)
+	SaveTxReplacementRequest(ctx context.Context, etxID int64, cancel bool) error
+	FindTxCancelRequest(ctx context.Context, fromAddress common.Address, chainID *big.Int) (etxID int64, err error)
+	FindTxIDsCancelRequested(ctx context.Context, etxIDs []int64) ([]int64, error)
+	DeleteTxSpeedUpRequests(ctx context.Context, fromAddress common.Address, chainID *big.Int) (etxIDs []int64, err error)
 }
 
 // TxStoreWebApi encapsulates the methods that are not used by the txmgr and only used by the various web controllers, readers, or evm specific components
@@ -291,6 +300,7 @@ type DbEthTxAttempt struct {
 	TxType                  int
 	GasTipCap               *assets.Wei
 	GasFeeCap               *assets.Wei
+	BlobFeeCap              *assets.Wei
 	IsPurgeAttempt          bool
 }
 
@@ -306,6 +316,7 @@ func (db *DbEthTxAttempt) FromTxAttempt(attempt *TxAttempt) {
 	db.TxType = attempt.TxType
 	db.GasTipCap = attempt.TxFee.GasTipCap
 	db.GasFeeCap = attempt.TxFee.GasFeeCap
+	db.BlobFeeCap = attempt.TxFee.BlobFeeCap
 	db.IsPurgeAttempt = attempt.IsPurgeAttempt
 
 	// handle state naming difference between generic + EVM
@@ -336,6 +347,7 @@ func (db DbEthTxAttempt) ToTxAttempt(attempt *TxAttempt) {
 	attempt.TxFee = gas.EvmFee{
 		GasPrice:   db.GasPrice,
 		DynamicFee: gas.DynamicFee{GasTipCap: db.GasTipCap, GasFeeCap: db.GasFeeCap},
+		BlobFeeCap: db.BlobFeeCap,
 	}
 	attempt.IsPurgeAttempt = db.IsPurgeAttempt
 }
@@ -361,8 +373,8 @@ func NewTxStore(
 }
 
 const insertIntoEthTxAttemptsQuery = `
-INSERT INTO evm.tx_attempts (eth_tx_id, gas_price, signed_raw_tx, hash, broadcast_before_block_num, state, created_at, chain_specific_gas_limit, tx_type, gas_tip_cap, gas_fee_cap, is_purge_attempt)
-VALUES (:eth_tx_id, :gas_price, :signed_raw_tx, :hash, :broadcast_before_block_num, :state, NOW(), :chain_specific_gas_limit, :tx_type, :gas_tip_cap, :gas_fee_cap, :is_purge_attempt)
+INSERT INTO evm.tx_attempts (eth_tx_id, gas_price, signed_raw_tx, hash, broadcast_before_block_num, state, created_at, chain_specific_gas_limit, tx_type, gas_tip_cap, gas_fee_cap, blob_fee_cap, is_purge_attempt)
+VALUES (:eth_tx_id, :gas_price, :signed_raw_tx, :hash, :broadcast_before_block_num, :state, NOW(), :chain_specific_gas_limit, :tx_type, :gas_tip_cap, :gas_fee_cap, :blob_fee_cap, :is_purge_attempt)
 RETURNING *;
 `
 
@@ -1579,6 +1591,65 @@ func (o *evmTxStore) HasInProgressTransaction(ctx context.Context, account commo
 	return exists, pkgerrors.Wrap(err, "hasInProgressTransaction failed")
 }
 
+// HasUnconfirmedTransfer returns whether a native token transfer, i.e. a transaction with a value and no payload,
+// from fromAddress to toAddress is unstarted, in progress or unconfirmed.
+func (o *evmTxStore) HasUnconfirmedTransfer(ctx context.Context, fromAddress, toAddress common.Address, chainID *big.Int) (exists bool, err error) {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	err = o.q.GetContext(ctx, &exists, `SELECT EXISTS(SELECT 1 FROM evm.txes WHERE state IN ('unstarted', 'in_progress', 'unconfirmed')
+		AND from_address = $1 AND to_address = $2 AND value > 0 AND encoded_payload = ''::bytea AND evm_chain_id = $3)`,
+		fromAddress, toAddress, chainID.String())
+	return exists, pkgerrors.Wrap(err, "HasUnconfirmedTransfer failed")
+}
+
+// SaveTxReplacementRequest saves the request of the node operator to cancel or speed up the transaction. A cancel
+// request supersedes a speed up one.
+func (o *evmTxStore) SaveTxReplacementRequest(ctx context.Context, etxID int64, cancel bool) error {
+	var cancelCtx context.CancelFunc
+	ctx, cancelCtx = o.stopCh.Ctx(ctx)
+	defer cancelCtx()
+	_, err := o.q.ExecContext(ctx, `INSERT INTO evm.tx_replacement_requests (eth_tx_id, cancel) VALUES ($1, $2)
+		ON CONFLICT (eth_tx_id) DO UPDATE SET cancel = evm.tx_replacement_requests.cancel OR EXCLUDED.cancel`, etxID, cancel)
+	return pkgerrors.Wrap(err, "SaveTxReplacementRequest failed")
+}
+
+// FindTxCancelRequest returns the unconfirmed transaction of the address requested to be cancelled that has no purge
+// attempt yet, or sql.ErrNoRows if there is none.
+func (o *evmTxStore) FindTxCancelRequest(ctx context.Context, fromAddress common.Address, chainID *big.Int) (etxID int64, err error) {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	err = o.q.GetContext(ctx, &etxID, `SELECT evm.txes.id FROM evm.tx_replacement_requests
+		JOIN evm.txes ON evm.txes.id = evm.tx_replacement_requests.eth_tx_id
+		WHERE evm.tx_replacement_requests.cancel AND evm.txes.state = 'unconfirmed'
+		AND evm.txes.from_address = $1 AND evm.txes.evm_chain_id = $2
+		AND NOT EXISTS (SELECT 1 FROM evm.tx_attempts WHERE evm.tx_attempts.eth_tx_id = evm.txes.id AND evm.tx_attempts.is_purge_attempt)
+		ORDER BY evm.txes.nonce ASC LIMIT 1`, fromAddress, chainID.String())
+	return etxID, err
+}
+
+// FindTxIDsCancelRequested returns the transactions among etxIDs that were requested to be cancelled.
+func (o *evmTxStore) FindTxIDsCancelRequested(ctx context.Context, etxIDs []int64) (cancelled []int64, err error) {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	err = o.q.SelectContext(ctx, &cancelled, `SELECT eth_tx_id FROM evm.tx_replacement_requests WHERE cancel AND eth_tx_id = ANY($1)`, pq.Array(etxIDs))
+	return cancelled, pkgerrors.Wrap(err, "FindTxIDsCancelRequested failed")
+}
+
+// DeleteTxSpeedUpRequests deletes the speed up requests of the transactions of the address, and returns them.
+func (o *evmTxStore) DeleteTxSpeedUpRequests(ctx context.Context, fromAddress common.Address, chainID *big.Int) (etxIDs []int64, err error) {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	err = o.q.SelectContext(ctx, &etxIDs, `DELETE FROM evm.tx_replacement_requests USING evm.txes
+		WHERE evm.txes.id = evm.tx_replacement_requests.eth_tx_id AND NOT evm.tx_replacement_requests.cancel
+		AND evm.txes.from_address = $1 AND evm.txes.evm_chain_id = $2
+		RETURNING evm.tx_replacement_requests.eth_tx_id`, fromAddress, chainID.String())
+	return etxIDs, pkgerrors.Wrap(err, "DeleteTxSpeedUpRequests failed")
+}
+
 func (o *evmTxStore) countTransactionsWithState(ctx context.Context, fromAddress common.Address, state txmgrtypes.TxState, chainID *big.Int) (count uint32, err error) {
 	var cancel context.CancelFunc
 	ctx, cancel = o.stopCh.Ctx(ctx)
@@ -2061,3 +2132,51 @@ func (o *evmTxStore) FindTxesByIDs(ctx context.Context, etxIDs []int64, chainID
 	dbEthTxsToEvmEthTxPtrs(dbEtxs, etxs)
 	return
 }
+
+// CreateBlobTransaction inserts a new blob transaction, and the sidecar its attempts carry.
+func (o *evmTxStore) CreateBlobTransaction(ctx context.Context, txRequest TxRequest, sidecar *gethtypes.BlobTxSidecar, chainID *big.Int) (tx Tx, err error) {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	encoded, err := rlp.EncodeToBytes(sidecar)
+	if err != nil {
+		return tx, fmt.Errorf("CreateBlobTransaction failed to encode sidecar: %w", err)
+	}
+	err = o.Transact(ctx, false, func(orm *evmTxStore) error {
+		tx, err = orm.CreateTransaction(ctx, txRequest, chainID)
+		if err != nil {
+			return err
+		}
+		// the meta marks blob transactions, so that their sidecar is only looked up for them
+		var meta sqlutil.JSON
+		err = orm.q.GetContext(ctx, &meta, `UPDATE evm.txes
+			SET meta = CASE WHEN jsonb_typeof(meta) = 'object' THEN meta ELSE '{}'::jsonb END || '{"BlobTx": true}'::jsonb
+			WHERE id = $1 RETURNING meta`, tx.ID)
+		if err != nil {
+			return pkgerrors.Wrap(err, "CreateBlobTransaction failed to mark transaction meta")
+		}
+		tx.Meta = &meta
+		_, err = orm.q.ExecContext(ctx, `INSERT INTO evm.tx_blob_sidecars (eth_tx_id, sidecar) VALUES ($1, $2) ON CONFLICT (eth_tx_id) DO NOTHING`, tx.ID, encoded)
+		return pkgerrors.Wrap(err, "CreateBlobTransaction failed to insert sidecar")
+	})
+	return tx, err
+}
+
+// FindTxBlobSidecar returns the sidecar of a blob transaction, or nil if the transaction doesn't carry blobs.
+func (o *evmTxStore) FindTxBlobSidecar(ctx context.Context, etxID int64) (*gethtypes.BlobTxSidecar, error) {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	var encoded []byte
+	err := o.q.GetContext(ctx, &encoded, `SELECT sidecar FROM evm.tx_blob_sidecars WHERE eth_tx_id = $1`, etxID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("FindTxBlobSidecar failed to load sidecar: %w", err)
+	}
+	sidecar := new(gethtypes.BlobTxSidecar)
+	if err = rlp.DecodeBytes(encoded, sidecar); err != nil {
+		return nil, fmt.Errorf("FindTxBlobSidecar failed to decode sidecar: %w", err)
+	}
+	return sidecar, nil
+}
@@ -1465,6 +1465,100 @@ func TestORM_HasInProgressTransaction(t *testing.T) {
 	})
 }
 
+func TestORM_HasUnconfirmedTransfer(t *testing.T) {
+	t.Parallel()
+
+	db := testutils.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
+	toAddress := testutils.NewAddress()
+	otherAddress := testutils.NewAddress()
+	chainID := testutils.FixtureChainID
+
+	exists, err := txStore.HasUnconfirmedTransfer(tests.Context(t), fromAddress, toAddress, chainID)
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	// a contract call isn't a transfer
+	_, err = txStore.CreateTransaction(tests.Context(t), txmgr.TxRequest{
+		FromAddress:    fromAddress,
+		ToAddress:      toAddress,
+		EncodedPayload: []byte{1, 2, 3},
+		Value:          *big.NewInt(1),
+		FeeLimit:       21000,
+	}, chainID)
+	require.NoError(t, err)
+	exists, err = txStore.HasUnconfirmedTransfer(tests.Context(t), fromAddress, toAddress, chainID)
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	_, err = txStore.CreateTransaction(tests.Context(t), txmgr.TxRequest{
+		FromAddress:    fromAddress,
+		ToAddress:      toAddress,
+		EncodedPayload: []byte{},
+		Value:          *big.NewInt(1),
+		FeeLimit:       21000,
+	}, chainID)
+	require.NoError(t, err)
+	exists, err = txStore.HasUnconfirmedTransfer(tests.Context(t), fromAddress, toAddress, chainID)
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	exists, err = txStore.HasUnconfirmedTransfer(tests.Context(t), fromAddress, otherAddress, chainID)
+	require.NoError(t, err)
+	require.False(t, exists)
+}
+
+func TestORM_TxReplacementRequests(t *testing.T) {
+	t.Parallel()
+
+	db := testutils.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
+	_, otherAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
+	chainID := testutils.FixtureChainID
+	ctx := tests.Context(t)
+
+	etx1 := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 1, fromAddress)
+	etx2 := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 2, fromAddress)
+	purged := mustInsertUnconfirmedEthTxWithBroadcastPurgeAttempt(t, txStore, 0, fromAddress)
+	other := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 1, otherAddress)
+
+	_, err := txStore.FindTxCancelRequest(ctx, fromAddress, chainID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	require.NoError(t, txStore.SaveTxReplacementRequest(ctx, etx1.ID, false))
+	require.NoError(t, txStore.SaveTxReplacementRequest(ctx, etx2.ID, false))
+	require.NoError(t, txStore.SaveTxReplacementRequest(ctx, other.ID, false))
+	// a cancel request supersedes a speed up one, and not the other way around
+	require.NoError(t, txStore.SaveTxReplacementRequest(ctx, etx2.ID, true))
+	require.NoError(t, txStore.SaveTxReplacementRequest(ctx, etx2.ID, false))
+	// transactions with a purge attempt are no longer to be cancelled
+	require.NoError(t, txStore.SaveTxReplacementRequest(ctx, purged.ID, true))
+
+	etxID, err := txStore.FindTxCancelRequest(ctx, fromAddress, chainID)
+	require.NoError(t, err)
+	require.Equal(t, etx2.ID, etxID)
+	_, err = txStore.FindTxCancelRequest(ctx, otherAddress, chainID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	cancelled, err := txStore.FindTxIDsCancelRequested(ctx, []int64{etx1.ID, etx2.ID, purged.ID, other.ID})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []int64{etx2.ID, purged.ID}, cancelled)
+
+	etxIDs, err := txStore.DeleteTxSpeedUpRequests(ctx, fromAddress, chainID)
+	require.NoError(t, err)
+	require.Equal(t, []int64{etx1.ID}, etxIDs)
+	etxIDs, err = txStore.DeleteTxSpeedUpRequests(ctx, fromAddress, chainID)
+	require.NoError(t, err)
+	require.Empty(t, etxIDs)
+	etxIDs, err = txStore.DeleteTxSpeedUpRequests(ctx, otherAddress, chainID)
+	require.NoError(t, err)
+	require.Equal(t, []int64{other.ID}, etxIDs)
+}
+
 func TestORM_CountUnconfirmedTransactions(t *testing.T) {
 	t.Parallel()
 
@@ -1719,6 +1813,46 @@ func TestORM_CreateTransaction(t *testing.T) {
 	})
 }
 
+func TestORM_CreateBlobTransaction(t *testing.T) {
+	t.Parallel()
+
+	db := testutils.NewSqlxDB(t)
+	txStore := newTxStore(t, db)
+	kst := cltest.NewKeyStore(t, db)
+	_, fromAddress := cltest.MustInsertRandomKey(t, kst.Eth())
+	ctx := tests.Context(t)
+
+	sidecar := newBlobSidecar(t)
+	etx, err := txStore.CreateBlobTransaction(ctx, txmgr.TxRequest{
+		FromAddress:    fromAddress,
+		ToAddress:      testutils.NewAddress(),
+		EncodedPayload: []byte{1, 2, 3},
+		FeeLimit:       21000,
+		Strategy:       txmgrcommon.NewSendEveryStrategy(),
+	}, sidecar, testutils.FixtureChainID)
+	require.NoError(t, err)
+	assert.Equal(t, txmgrcommon.TxUnstarted, etx.State)
+	cltest.AssertCount(t, db, "evm.tx_blob_sidecars", 1)
+	require.NotNil(t, etx.Meta)
+	assert.JSONEq(t, `{"BlobTx": true}`, string(*etx.Meta))
+	loaded, err := txStore.FindTxWithAttempts(ctx, etx.ID)
+	require.NoError(t, err)
+	assert.Equal(t, etx.Meta, loaded.Meta)
+
+	found, err := txStore.FindTxBlobSidecar(ctx, etx.ID)
+	require.NoError(t, err)
+	require.NotNil(t, found)
+	assert.Equal(t, sidecar.BlobHashes(), found.BlobHashes())
+	assert.Equal(t, sidecar.Proofs, found.Proofs)
+
+	t.Run("returns nil for transactions without sidecar", func(t *testing.T) {
+		other := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID)
+		found, err := txStore.FindTxBlobSidecar(ctx, other.ID)
+		require.NoError(t, err)
+		assert.Nil(t, found)
+	})
+}
+
 func TestORM_PruneUnstartedTxQueue(t *testing.T) {
 	t.Parallel()
 
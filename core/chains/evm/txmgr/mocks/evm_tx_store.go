@@ -8,6 +8,8 @@ import (
 
 	common "github.com/ethereum/go-ethereum/common"
 
+	coretypes "github.com/ethereum/go-ethereum/core/types"
+
 	evmtypes "github.com/smartcontractkit/chainlink/v2/evm/types"
 
 	gas "github.com/smartcontractkit/chainlink/v2/evm/gas"
@@ -339,6 +341,65 @@ func (_c *EvmTxStore_CountUnstartedTransactions_Call) RunAndReturn(run func(cont
 	return _c
 }
 
+// CreateBlobTransaction provides a mock function with given fields: ctx, txRequest, sidecar, chainID
+func (_m *EvmTxStore) CreateBlobTransaction(ctx context.Context, txRequest types.TxRequest[common.Address, common.Hash], sidecar *coretypes.BlobTxSidecar, chainID *big.Int) (types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error) {
+	ret := _m.Called(ctx, txRequest, sidecar, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateBlobTransaction")
+	}
+
+	var r0 types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.TxRequest[common.Address, common.Hash], *coretypes.BlobTxSidecar, *big.Int) (types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error)); ok {
+		return rf(ctx, txRequest, sidecar, chainID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, types.TxRequest[common.Address, common.Hash], *coretypes.BlobTxSidecar, *big.Int) types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]); ok {
+		r0 = rf(ctx, txRequest, sidecar, chainID)
+	} else {
+		r0 = ret.Get(0).(types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee])
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, types.TxRequest[common.Address, common.Hash], *coretypes.BlobTxSidecar, *big.Int) error); ok {
+		r1 = rf(ctx, txRequest, sidecar, chainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EvmTxStore_CreateBlobTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateBlobTransaction'
+type EvmTxStore_CreateBlobTransaction_Call struct {
+	*mock.Call
+}
+
+// CreateBlobTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - txRequest types.TxRequest[common.Address,common.Hash]
+//   - sidecar *coretypes.BlobTxSidecar
+//   - chainID *big.Int
+func (_e *EvmTxStore_Expecter) CreateBlobTransaction(ctx interface{}, txRequest interface{}, sidecar interface{}, chainID interface{}) *EvmTxStore_CreateBlobTransaction_Call {
+	return &EvmTxStore_CreateBlobTransaction_Call{Call: _e.mock.On("CreateBlobTransaction", ctx, txRequest, sidecar, chainID)}
+}
+
+func (_c *EvmTxStore_CreateBlobTransaction_Call) Run(run func(ctx context.Context, txRequest types.TxRequest[common.Address, common.Hash], sidecar *coretypes.BlobTxSidecar, chainID *big.Int)) *EvmTxStore_CreateBlobTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(types.TxRequest[common.Address, common.Hash]), args[2].(*coretypes.BlobTxSidecar), args[3].(*big.Int))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_CreateBlobTransaction_Call) Return(tx types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], err error) *EvmTxStore_CreateBlobTransaction_Call {
+	_c.Call.Return(tx, err)
+	return _c
+}
+
+func (_c *EvmTxStore_CreateBlobTransaction_Call) RunAndReturn(run func(context.Context, types.TxRequest[common.Address, common.Hash], *coretypes.BlobTxSidecar, *big.Int) (types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error)) *EvmTxStore_CreateBlobTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateTransaction provides a mock function with given fields: ctx, txRequest, chainID
 func (_m *EvmTxStore) CreateTransaction(ctx context.Context, txRequest types.TxRequest[common.Address, common.Hash], chainID *big.Int) (types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error) {
 	ret := _m.Called(ctx, txRequest, chainID)
@@ -491,6 +552,66 @@ func (_c *EvmTxStore_DeleteReceiptByTxHash_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// DeleteTxSpeedUpRequests provides a mock function with given fields: ctx, fromAddress, chainID
+func (_m *EvmTxStore) DeleteTxSpeedUpRequests(ctx context.Context, fromAddress common.Address, chainID *big.Int) ([]int64, error) {
+	ret := _m.Called(ctx, fromAddress, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTxSpeedUpRequests")
+	}
+
+	var r0 []int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, *big.Int) ([]int64, error)); ok {
+		return rf(ctx, fromAddress, chainID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, *big.Int) []int64); ok {
+		r0 = rf(ctx, fromAddress, chainID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, common.Address, *big.Int) error); ok {
+		r1 = rf(ctx, fromAddress, chainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EvmTxStore_DeleteTxSpeedUpRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTxSpeedUpRequests'
+type EvmTxStore_DeleteTxSpeedUpRequests_Call struct {
+	*mock.Call
+}
+
+// DeleteTxSpeedUpRequests is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fromAddress common.Address
+//   - chainID *big.Int
+func (_e *EvmTxStore_Expecter) DeleteTxSpeedUpRequests(ctx interface{}, fromAddress interface{}, chainID interface{}) *EvmTxStore_DeleteTxSpeedUpRequests_Call {
+	return &EvmTxStore_DeleteTxSpeedUpRequests_Call{Call: _e.mock.On("DeleteTxSpeedUpRequests", ctx, fromAddress, chainID)}
+}
+
+func (_c *EvmTxStore_DeleteTxSpeedUpRequests_Call) Run(run func(ctx context.Context, fromAddress common.Address, chainID *big.Int)) *EvmTxStore_DeleteTxSpeedUpRequests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(common.Address), args[2].(*big.Int))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_DeleteTxSpeedUpRequests_Call) Return(_a0 []int64, _a1 error) *EvmTxStore_DeleteTxSpeedUpRequests_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EvmTxStore_DeleteTxSpeedUpRequests_Call) RunAndReturn(run func(context.Context, common.Address, *big.Int) ([]int64, error)) *EvmTxStore_DeleteTxSpeedUpRequests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindAttemptsRequiringReceiptFetch provides a mock function with given fields: ctx, chainID
 func (_m *EvmTxStore) FindAttemptsRequiringReceiptFetch(ctx context.Context, chainID *big.Int) ([]types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error) {
 	ret := _m.Called(ctx, chainID)
@@ -1151,6 +1272,65 @@ func (_c *EvmTxStore_FindTxAttemptsRequiringResend_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// FindTxBlobSidecar provides a mock function with given fields: ctx, etxID
+func (_m *EvmTxStore) FindTxBlobSidecar(ctx context.Context, etxID int64) (*coretypes.BlobTxSidecar, error) {
+	ret := _m.Called(ctx, etxID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindTxBlobSidecar")
+	}
+
+	var r0 *coretypes.BlobTxSidecar
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*coretypes.BlobTxSidecar, error)); ok {
+		return rf(ctx, etxID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *coretypes.BlobTxSidecar); ok {
+		r0 = rf(ctx, etxID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.BlobTxSidecar)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, etxID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EvmTxStore_FindTxBlobSidecar_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTxBlobSidecar'
+type EvmTxStore_FindTxBlobSidecar_Call struct {
+	*mock.Call
+}
+
+// FindTxBlobSidecar is a helper method to define mock.On call
+//   - ctx context.Context
+//   - etxID int64
+func (_e *EvmTxStore_Expecter) FindTxBlobSidecar(ctx interface{}, etxID interface{}) *EvmTxStore_FindTxBlobSidecar_Call {
+	return &EvmTxStore_FindTxBlobSidecar_Call{Call: _e.mock.On("FindTxBlobSidecar", ctx, etxID)}
+}
+
+func (_c *EvmTxStore_FindTxBlobSidecar_Call) Run(run func(ctx context.Context, etxID int64)) *EvmTxStore_FindTxBlobSidecar_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_FindTxBlobSidecar_Call) Return(_a0 *coretypes.BlobTxSidecar, _a1 error) *EvmTxStore_FindTxBlobSidecar_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EvmTxStore_FindTxBlobSidecar_Call) RunAndReturn(run func(context.Context, int64) (*coretypes.BlobTxSidecar, error)) *EvmTxStore_FindTxBlobSidecar_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindTxByHash provides a mock function with given fields: ctx, hash
 func (_m *EvmTxStore) FindTxByHash(ctx context.Context, hash common.Hash) (*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error) {
 	ret := _m.Called(ctx, hash)
@@ -1210,6 +1390,123 @@ func (_c *EvmTxStore_FindTxByHash_Call) RunAndReturn(run func(context.Context, c
 	return _c
 }
 
+// FindTxCancelRequest provides a mock function with given fields: ctx, fromAddress, chainID
+func (_m *EvmTxStore) FindTxCancelRequest(ctx context.Context, fromAddress common.Address, chainID *big.Int) (int64, error) {
+	ret := _m.Called(ctx, fromAddress, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindTxCancelRequest")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, *big.Int) (int64, error)); ok {
+		return rf(ctx, fromAddress, chainID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, *big.Int) int64); ok {
+		r0 = rf(ctx, fromAddress, chainID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, common.Address, *big.Int) error); ok {
+		r1 = rf(ctx, fromAddress, chainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EvmTxStore_FindTxCancelRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTxCancelRequest'
+type EvmTxStore_FindTxCancelRequest_Call struct {
+	*mock.Call
+}
+
+// FindTxCancelRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fromAddress common.Address
+//   - chainID *big.Int
+func (_e *EvmTxStore_Expecter) FindTxCancelRequest(ctx interface{}, fromAddress interface{}, chainID interface{}) *EvmTxStore_FindTxCancelRequest_Call {
+	return &EvmTxStore_FindTxCancelRequest_Call{Call: _e.mock.On("FindTxCancelRequest", ctx, fromAddress, chainID)}
+}
+
+func (_c *EvmTxStore_FindTxCancelRequest_Call) Run(run func(ctx context.Context, fromAddress common.Address, chainID *big.Int)) *EvmTxStore_FindTxCancelRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(common.Address), args[2].(*big.Int))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_FindTxCancelRequest_Call) Return(_a0 int64, _a1 error) *EvmTxStore_FindTxCancelRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EvmTxStore_FindTxCancelRequest_Call) RunAndReturn(run func(context.Context, common.Address, *big.Int) (int64, error)) *EvmTxStore_FindTxCancelRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindTxIDsCancelRequested provides a mock function with given fields: ctx, etxIDs
+func (_m *EvmTxStore) FindTxIDsCancelRequested(ctx context.Context, etxIDs []int64) ([]int64, error) {
+	ret := _m.Called(ctx, etxIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindTxIDsCancelRequested")
+	}
+
+	var r0 []int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) ([]int64, error)); ok {
+		return rf(ctx, etxIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) []int64); ok {
+		r0 = rf(ctx, etxIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int64) error); ok {
+		r1 = rf(ctx, etxIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EvmTxStore_FindTxIDsCancelRequested_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTxIDsCancelRequested'
+type EvmTxStore_FindTxIDsCancelRequested_Call struct {
+	*mock.Call
+}
+
+// FindTxIDsCancelRequested is a helper method to define mock.On call
+//   - ctx context.Context
+//   - etxIDs []int64
+func (_e *EvmTxStore_Expecter) FindTxIDsCancelRequested(ctx interface{}, etxIDs interface{}) *EvmTxStore_FindTxIDsCancelRequested_Call {
+	return &EvmTxStore_FindTxIDsCancelRequested_Call{Call: _e.mock.On("FindTxIDsCancelRequested", ctx, etxIDs)}
+}
+
+func (_c *EvmTxStore_FindTxIDsCancelRequested_Call) Run(run func(ctx context.Context, etxIDs []int64)) *EvmTxStore_FindTxIDsCancelRequested_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int64))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_FindTxIDsCancelRequested_Call) Return(_a0 []int64, _a1 error) *EvmTxStore_FindTxIDsCancelRequested_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EvmTxStore_FindTxIDsCancelRequested_Call) RunAndReturn(run func(context.Context, []int64) ([]int64, error)) *EvmTxStore_FindTxIDsCancelRequested_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindTxWithAttempts provides a mock function with given fields: ctx, etxID
 func (_m *EvmTxStore) FindTxWithAttempts(ctx context.Context, etxID int64) (types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error) {
 	ret := _m.Called(ctx, etxID)
@@ -2235,6 +2532,65 @@ func (_c *EvmTxStore_HasInProgressTransaction_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// HasUnconfirmedTransfer provides a mock function with given fields: ctx, fromAddress, toAddress, chainID
+func (_m *EvmTxStore) HasUnconfirmedTransfer(ctx context.Context, fromAddress common.Address, toAddress common.Address, chainID *big.Int) (bool, error) {
+	ret := _m.Called(ctx, fromAddress, toAddress, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HasUnconfirmedTransfer")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, common.Address, *big.Int) (bool, error)); ok {
+		return rf(ctx, fromAddress, toAddress, chainID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, common.Address, *big.Int) bool); ok {
+		r0 = rf(ctx, fromAddress, toAddress, chainID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, common.Address, common.Address, *big.Int) error); ok {
+		r1 = rf(ctx, fromAddress, toAddress, chainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EvmTxStore_HasUnconfirmedTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasUnconfirmedTransfer'
+type EvmTxStore_HasUnconfirmedTransfer_Call struct {
+	*mock.Call
+}
+
+// HasUnconfirmedTransfer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fromAddress common.Address
+//   - toAddress common.Address
+//   - chainID *big.Int
+func (_e *EvmTxStore_Expecter) HasUnconfirmedTransfer(ctx interface{}, fromAddress interface{}, toAddress interface{}, chainID interface{}) *EvmTxStore_HasUnconfirmedTransfer_Call {
+	return &EvmTxStore_HasUnconfirmedTransfer_Call{Call: _e.mock.On("HasUnconfirmedTransfer", ctx, fromAddress, toAddress, chainID)}
+}
+
+func (_c *EvmTxStore_HasUnconfirmedTransfer_Call) Run(run func(ctx context.Context, fromAddress common.Address, toAddress common.Address, chainID *big.Int)) *EvmTxStore_HasUnconfirmedTransfer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(common.Address), args[2].(common.Address), args[3].(*big.Int))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_HasUnconfirmedTransfer_Call) Return(_a0 bool, _a1 error) *EvmTxStore_HasUnconfirmedTransfer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EvmTxStore_HasUnconfirmedTransfer_Call) RunAndReturn(run func(context.Context, common.Address, common.Address, *big.Int) (bool, error)) *EvmTxStore_HasUnconfirmedTransfer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // LoadTxAttempts provides a mock function with given fields: ctx, etx
 func (_m *EvmTxStore) LoadTxAttempts(ctx context.Context, etx *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]) error {
 	ret := _m.Called(ctx, etx)
@@ -2726,6 +3082,54 @@ func (_c *EvmTxStore_SaveSentAttempt_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// SaveTxReplacementRequest provides a mock function with given fields: ctx, etxID, cancel
+func (_m *EvmTxStore) SaveTxReplacementRequest(ctx context.Context, etxID int64, cancel bool) error {
+	ret := _m.Called(ctx, etxID, cancel)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveTxReplacementRequest")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, bool) error); ok {
+		r0 = rf(ctx, etxID, cancel)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EvmTxStore_SaveTxReplacementRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveTxReplacementRequest'
+type EvmTxStore_SaveTxReplacementRequest_Call struct {
+	*mock.Call
+}
+
+// SaveTxReplacementRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - etxID int64
+//   - cancel bool
+func (_e *EvmTxStore_Expecter) SaveTxReplacementRequest(ctx interface{}, etxID interface{}, cancel interface{}) *EvmTxStore_SaveTxReplacementRequest_Call {
+	return &EvmTxStore_SaveTxReplacementRequest_Call{Call: _e.mock.On("SaveTxReplacementRequest", ctx, etxID, cancel)}
+}
+
+func (_c *EvmTxStore_SaveTxReplacementRequest_Call) Run(run func(ctx context.Context, etxID int64, cancel bool)) *EvmTxStore_SaveTxReplacementRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_SaveTxReplacementRequest_Call) Return(_a0 error) *EvmTxStore_SaveTxReplacementRequest_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *EvmTxStore_SaveTxReplacementRequest_Call) RunAndReturn(run func(context.Context, int64, bool) error) *EvmTxStore_SaveTxReplacementRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetBroadcastBeforeBlockNum provides a mock function with given fields: ctx, blockNum, chainID
 func (_m *EvmTxStore) SetBroadcastBeforeBlockNum(ctx context.Context, blockNum int64, chainID *big.Int) error {
 	ret := _m.Called(ctx, blockNum, chainID)
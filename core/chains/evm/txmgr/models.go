@@ -52,6 +52,15 @@ const (
 	// chain.
 	TransmitCheckerTypeSimulate = txmgrtypes.TransmitCheckerType("simulate")
 
+	// TransmitCheckerTypeSimulateBeforeSend is a checker that simulates each attempt of the transaction
+	// at the pending block before it is broadcast, and fails the transaction with its decoded revert
+	// reason if it would revert. Only Error(string) and Panic(uint256) reverts are decoded.
+	TransmitCheckerTypeSimulateBeforeSend = txmgrtypes.TransmitCheckerType("simulate_before_send")
+
+	// TransmitCheckerTypeSimulateBeforeSendOCR2Aggregator is a TransmitCheckerTypeSimulateBeforeSend
+	// checker for transactions to an OCR2 aggregator, which also decodes the custom errors of its ABI.
+	TransmitCheckerTypeSimulateBeforeSendOCR2Aggregator = txmgrtypes.TransmitCheckerType("simulate_before_send_ocr2_aggregator")
+
 	// TransmitCheckerTypeVRFV1 is a checker that will not submit VRF V1 fulfillment requests that
 	// have already been fulfilled. This could happen if the request was fulfilled by another node.
 	TransmitCheckerTypeVRFV1 = txmgrtypes.TransmitCheckerType("vrf_v1")
@@ -0,0 +1,254 @@
+package txmgr
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	txmgrcommon "github.com/smartcontractkit/chainlink-framework/chains/txmgr"
+)
+
+var (
+	// ErrTxNotReplaceable is returned when replacing a transaction that isn't waiting to be confirmed.
+	ErrTxNotReplaceable = errors.New("only unconfirmed transactions can be replaced")
+	// ErrTxNotFound is returned when replacing a transaction that doesn't exist on the chain.
+	ErrTxNotFound = errors.New("transaction not found")
+)
+
+// TxCancelledByOperatorMsg is the error of the transactions cancelled on request of the node operator.
+const TxCancelledByOperatorMsg = "transaction cancelled by operator"
+
+// TxReplacer is implemented by the transaction managers that can replace the unconfirmed transactions of their chain
+// on request of the node operator.
+type TxReplacer interface {
+	// CancelTransaction replaces the transaction with a 0-value self-transfer at the same nonce.
+	CancelTransaction(ctx context.Context, etxID int64) (Tx, error)
+	// SpeedUpTransaction replaces the transaction with a copy with a bumped fee at the same nonce.
+	SpeedUpTransaction(ctx context.Context, etxID int64) (Tx, error)
+}
+
+var _ TxReplacer = &evmTxm{}
+
+func (t *evmTxm) CancelTransaction(ctx context.Context, etxID int64) (etx Tx, err error) {
+	return t.request(ctx, etxID, true)
+}
+
+func (t *evmTxm) SpeedUpTransaction(ctx context.Context, etxID int64) (etx Tx, err error) {
+	return t.request(ctx, etxID, false)
+}
+
+func (t *evmTxm) request(ctx context.Context, etxID int64, cancel bool) (etx Tx, err error) {
+	ok := t.IfStarted(func() {
+		etx, err = t.replacer.request(ctx, etxID, cancel)
+	})
+	if !ok {
+		return etx, errTxmNotStarted
+	}
+	return etx, err
+}
+
+type replacerTxStore interface {
+	FindTxWithAttempts(ctx context.Context, etxID int64) (etx Tx, err error)
+	SaveTxReplacementRequest(ctx context.Context, etxID int64, cancel bool) error
+	FindTxCancelRequest(ctx context.Context, fromAddress common.Address, chainID *big.Int) (etxID int64, err error)
+	FindTxIDsCancelRequested(ctx context.Context, etxIDs []int64) ([]int64, error)
+	DeleteTxSpeedUpRequests(ctx context.Context, fromAddress common.Address, chainID *big.Int) (etxIDs []int64, err error)
+}
+
+// txReplacer saves the requests of the node operator to replace stuck transactions, either with a copy of the
+// transaction with a bumped fee, or with a 0-value self-transfer cancelling it.
+//
+// The requests are applied by the Confirmer on its next head, so that the replacement attempts are created, broadcast
+// and tracked by its sequential head processing, just like its own gas bumps:
+//   - the transactions to cancel are reported as terminally stuck by its StuckTxDetector, so that it replaces them
+//     with a purge attempt sent to their sender, and marks them as fatally errored with TxCancelledByOperatorMsg once
+//     it is confirmed;
+//   - the transactions to speed up are reported as requiring a gas bump by its TxStore.
+//
+// The requests are saved in the DB, so that they survive a restart of the node.
+type txReplacer struct {
+	lggr    logger.Logger
+	chainID *big.Int
+	txStore replacerTxStore
+}
+
+func newTxReplacer(lggr logger.Logger, chainID *big.Int, txStore replacerTxStore) *txReplacer {
+	return &txReplacer{
+		lggr:    logger.Named(lggr, "TxReplacer"),
+		chainID: chainID,
+		txStore: txStore,
+	}
+}
+
+// request saves the replacement of the transaction, to be applied by the Confirmer on its next head.
+func (r *txReplacer) request(ctx context.Context, etxID int64, cancel bool) (Tx, error) {
+	etx, err := r.txStore.FindTxWithAttempts(ctx, etxID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return etx, fmt.Errorf("%w: %d", ErrTxNotFound, etxID)
+	} else if err != nil {
+		return etx, err
+	}
+	if etx.ChainID == nil || etx.ChainID.Cmp(r.chainID) != 0 {
+		return etx, fmt.Errorf("%w: transaction %d is not on chain %s", ErrTxNotFound, etx.ID, r.chainID)
+	}
+	if err = replaceable(etx); err != nil {
+		return etx, err
+	}
+
+	if cancel {
+		// A single transaction per address can be reported as stuck at a time
+		id, err := r.txStore.FindTxCancelRequest(ctx, etx.FromAddress, r.chainID)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return etx, fmt.Errorf("failed to find cancel request: %w", err)
+		}
+		if err == nil && id != etx.ID {
+			return etx, fmt.Errorf("%w: transaction %d of the same address is already being cancelled", ErrTxNotReplaceable, id)
+		}
+	}
+	if err = r.txStore.SaveTxReplacementRequest(ctx, etx.ID, cancel); err != nil {
+		return etx, fmt.Errorf("failed to save replacement request: %w", err)
+	}
+	etx.GetLogger(r.lggr).Infow("Transaction replacement requested", "cancel", cancel)
+	return etx, nil
+}
+
+func replaceable(etx Tx) error {
+	if etx.State != txmgrcommon.TxUnconfirmed || etx.Sequence == nil || len(etx.TxAttempts) == 0 {
+		return fmt.Errorf("%w: transaction %d is %s", ErrTxNotReplaceable, etx.ID, etx.State)
+	}
+	if etx.HasPurgeAttempt() {
+		return fmt.Errorf("%w: transaction %d is already being cancelled", ErrTxNotReplaceable, etx.ID)
+	}
+	return nil
+}
+
+// findCancel returns the transaction of the address to cancel, if any. The request is kept once applied, so that the
+// transaction is marked as cancelled by the operator once its purge attempt is confirmed.
+func (r *txReplacer) findCancel(ctx context.Context, address common.Address) (*Tx, error) {
+	id, err := r.txStore.FindTxCancelRequest(ctx, address, r.chainID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return r.load(ctx, id)
+}
+
+// takeSpeedUps returns the transactions of the address to speed up, and deletes their requests.
+func (r *txReplacer) takeSpeedUps(ctx context.Context, address common.Address) ([]*Tx, error) {
+	ids, err := r.txStore.DeleteTxSpeedUpRequests(ctx, address, r.chainID)
+	if err != nil {
+		return nil, err
+	}
+	var etxs []*Tx
+	for _, id := range ids {
+		etx, err := r.load(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if etx != nil {
+			etxs = append(etxs, etx)
+		}
+	}
+	return etxs, nil
+}
+
+// load returns the transaction if it can still be replaced.
+func (r *txReplacer) load(ctx context.Context, id int64) (*Tx, error) {
+	etx, err := r.txStore.FindTxWithAttempts(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err = replaceable(etx); err != nil {
+		etx.GetLogger(r.lggr).Warnw("Dropping transaction replacement request", "err", err)
+		return nil, nil
+	}
+	return &etx, nil
+}
+
+// wrapStuckTxDetector reports the transactions to cancel as terminally stuck, in addition to the ones detected by the
+// wrapped detector.
+func (r *txReplacer) wrapStuckTxDetector(detector StuckTxDetector) StuckTxDetector {
+	return &replacingStuckTxDetector{StuckTxDetector: detector, r: r}
+}
+
+// wrapTxStore reports the transactions to speed up as requiring a gas bump, in addition to the ones found by the
+// wrapped store, and marks the purged transactions that were cancelled by the operator with TxCancelledByOperatorMsg.
+func (r *txReplacer) wrapTxStore(txStore TxStore) TxStore {
+	return &replacingTxStore{TxStore: txStore, r: r}
+}
+
+type replacingStuckTxDetector struct {
+	StuckTxDetector
+	r *txReplacer
+}
+
+func (d *replacingStuckTxDetector) DetectStuckTransactions(ctx context.Context, enabledAddresses []common.Address, blockNum int64) ([]Tx, error) {
+	stuckTxs, err := d.StuckTxDetector.DetectStuckTransactions(ctx, enabledAddresses, blockNum)
+	if err != nil {
+		return nil, err
+	}
+	for _, address := range enabledAddresses {
+		if slices.ContainsFunc(stuckTxs, func(tx Tx) bool { return tx.FromAddress == address }) {
+			continue
+		}
+		etx, err := d.r.findCancel(ctx, address)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load transaction to cancel: %w", err)
+		}
+		if etx == nil {
+			continue
+		}
+		// The purge attempt is a self-transfer
+		etx.ToAddress = etx.FromAddress
+		stuckTxs = append(stuckTxs, *etx)
+	}
+	return stuckTxs, nil
+}
+
+type replacingTxStore struct {
+	TxStore
+	r *txReplacer
+}
+
+func (s *replacingTxStore) FindTxsRequiringGasBump(ctx context.Context, address common.Address, blockNum, gasBumpThreshold, depth int64, chainID *big.Int) ([]*Tx, error) {
+	etxs, err := s.TxStore.FindTxsRequiringGasBump(ctx, address, blockNum, gasBumpThreshold, depth, chainID)
+	if err != nil {
+		return nil, err
+	}
+	speedUps, err := s.r.takeSpeedUps(ctx, address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load transactions to speed up: %w", err)
+	}
+	for _, etx := range speedUps {
+		if !slices.ContainsFunc(etxs, func(tx *Tx) bool { return tx.ID == etx.ID }) {
+			etxs = append(etxs, etx)
+		}
+	}
+	return etxs, nil
+}
+
+// UpdateTxFatalError is called by the Confirmer with the transactions whose purge attempt is confirmed.
+func (s *replacingTxStore) UpdateTxFatalError(ctx context.Context, etxIDs []int64, errMsg string) error {
+	if len(etxIDs) == 0 {
+		return s.TxStore.UpdateTxFatalError(ctx, etxIDs, errMsg)
+	}
+	cancelled, err := s.r.txStore.FindTxIDsCancelRequested(ctx, etxIDs)
+	if err != nil {
+		return fmt.Errorf("failed to find cancelled transactions: %w", err)
+	}
+	stuck := slices.DeleteFunc(slices.Clone(etxIDs), func(id int64) bool { return slices.Contains(cancelled, id) })
+	if len(cancelled) > 0 {
+		if err = s.TxStore.UpdateTxFatalError(ctx, cancelled, TxCancelledByOperatorMsg); err != nil {
+			return err
+		}
+	}
+	return s.TxStore.UpdateTxFatalError(ctx, stuck, errMsg)
+}
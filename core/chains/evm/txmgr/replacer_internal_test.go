@@ -0,0 +1,233 @@
+package txmgr
+
+import (
+	"context"
+	"database/sql"
+	"math/big"
+	"slices"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+	txmgrcommon "github.com/smartcontractkit/chainlink-framework/chains/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink-framework/chains/txmgr/types"
+
+	evmtypes "github.com/smartcontractkit/chainlink/v2/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/evm/utils"
+)
+
+type fakeReplacerTxStore struct {
+	TxStore
+	txs         map[int64]Tx
+	gasBumps    []*Tx
+	requests    map[int64]bool
+	fatalErrors map[int64]string
+}
+
+func (s *fakeReplacerTxStore) FindTxWithAttempts(_ context.Context, etxID int64) (Tx, error) {
+	etx, ok := s.txs[etxID]
+	if !ok {
+		return Tx{}, sql.ErrNoRows
+	}
+	return etx, nil
+}
+
+func (s *fakeReplacerTxStore) SaveTxReplacementRequest(_ context.Context, etxID int64, cancel bool) error {
+	s.requests[etxID] = s.requests[etxID] || cancel
+	return nil
+}
+
+func (s *fakeReplacerTxStore) FindTxCancelRequest(_ context.Context, fromAddress common.Address, _ *big.Int) (int64, error) {
+	var found *Tx
+	for id, cancel := range s.requests {
+		etx := s.txs[id]
+		if !cancel || etx.FromAddress != fromAddress || etx.State != txmgrcommon.TxUnconfirmed || etx.HasPurgeAttempt() {
+			continue
+		}
+		if found == nil || *etx.Sequence < *found.Sequence {
+			found = &etx
+		}
+	}
+	if found == nil {
+		return 0, sql.ErrNoRows
+	}
+	return found.ID, nil
+}
+
+func (s *fakeReplacerTxStore) FindTxIDsCancelRequested(_ context.Context, etxIDs []int64) (cancelled []int64, err error) {
+	for _, id := range etxIDs {
+		if s.requests[id] {
+			cancelled = append(cancelled, id)
+		}
+	}
+	return cancelled, nil
+}
+
+func (s *fakeReplacerTxStore) DeleteTxSpeedUpRequests(_ context.Context, fromAddress common.Address, _ *big.Int) (etxIDs []int64, err error) {
+	for id, cancel := range s.requests {
+		if !cancel && s.txs[id].FromAddress == fromAddress {
+			etxIDs = append(etxIDs, id)
+			delete(s.requests, id)
+		}
+	}
+	slices.Sort(etxIDs)
+	return etxIDs, nil
+}
+
+func (s *fakeReplacerTxStore) UpdateTxFatalError(_ context.Context, etxIDs []int64, errMsg string) error {
+	for _, id := range etxIDs {
+		s.fatalErrors[id] = errMsg
+	}
+	return nil
+}
+
+// purge adds a purge attempt to the transaction, as the Confirmer does for the stuck transactions.
+func (s *fakeReplacerTxStore) purge(etxID int64) {
+	etx := s.txs[etxID]
+	etx.TxAttempts = append(etx.TxAttempts, TxAttempt{ID: etxID * 10, IsPurgeAttempt: true})
+	s.txs[etxID] = etx
+}
+
+func (s *fakeReplacerTxStore) FindTxsRequiringGasBump(context.Context, common.Address, int64, int64, int64, *big.Int) ([]*Tx, error) {
+	return s.gasBumps, nil
+}
+
+type fakeStuckTxDetector struct {
+	StuckTxDetector
+	stuckTxs []Tx
+}
+
+func (d *fakeStuckTxDetector) DetectStuckTransactions(context.Context, []common.Address, int64) ([]Tx, error) {
+	return d.stuckTxs, nil
+}
+
+func TestTxReplacer(t *testing.T) {
+	ctx := tests.Context(t)
+	chainID := big.NewInt(1)
+	from, other := utils.RandomAddress(), utils.RandomAddress()
+
+	newTx := func(id int64, from common.Address, state txmgrtypes.TxState) Tx {
+		n := evmtypes.Nonce(id)
+		return Tx{ID: id, ChainID: chainID, Sequence: &n, State: state, FromAddress: from, ToAddress: utils.RandomAddress(), TxAttempts: []TxAttempt{{ID: id}}}
+	}
+	newReplacer := func() (*txReplacer, *fakeReplacerTxStore) {
+		txStore := &fakeReplacerTxStore{txs: map[int64]Tx{
+			1: newTx(1, from, txmgrcommon.TxUnconfirmed),
+			2: newTx(2, from, txmgrcommon.TxUnconfirmed),
+			3: newTx(3, from, txmgrcommon.TxConfirmed),
+			4: newTx(4, other, txmgrcommon.TxUnconfirmed),
+		}, requests: map[int64]bool{}, fatalErrors: map[int64]string{}}
+		return newTxReplacer(logger.Test(t), chainID, txStore), txStore
+	}
+
+	t.Run("rejects transactions that are not found or not unconfirmed", func(t *testing.T) {
+		r, _ := newReplacer()
+		_, err := r.request(ctx, 42, true)
+		require.ErrorIs(t, err, ErrTxNotFound)
+		_, err = r.request(ctx, 3, false)
+		require.ErrorIs(t, err, ErrTxNotReplaceable)
+	})
+
+	t.Run("rejects transactions of another chain", func(t *testing.T) {
+		r, txStore := newReplacer()
+		etx := txStore.txs[1]
+		etx.ChainID = big.NewInt(2)
+		txStore.txs[1] = etx
+		_, err := r.request(ctx, 1, true)
+		require.ErrorIs(t, err, ErrTxNotFound)
+	})
+
+	t.Run("reports cancelled transactions as stuck self-transfers until they are purged", func(t *testing.T) {
+		r, txStore := newReplacer()
+		_, err := r.request(ctx, 1, true)
+		require.NoError(t, err)
+		_, err = r.request(ctx, 2, true)
+		require.ErrorIs(t, err, ErrTxNotReplaceable, "a single transaction per address can be cancelled at a time")
+
+		// the requests are saved, so that they are applied after a restart
+		r = newTxReplacer(logger.Test(t), chainID, txStore)
+		detector := r.wrapStuckTxDetector(&fakeStuckTxDetector{})
+		stuckTxs, err := detector.DetectStuckTransactions(ctx, []common.Address{from, other}, 10)
+		require.NoError(t, err)
+		require.Len(t, stuckTxs, 1)
+		require.Equal(t, int64(1), stuckTxs[0].ID)
+		require.Equal(t, from, stuckTxs[0].ToAddress)
+
+		txStore.purge(1)
+		stuckTxs, err = detector.DetectStuckTransactions(ctx, []common.Address{from, other}, 11)
+		require.NoError(t, err)
+		require.Empty(t, stuckTxs)
+	})
+
+	t.Run("marks purged transactions cancelled by the operator", func(t *testing.T) {
+		r, txStore := newReplacer()
+		_, err := r.request(ctx, 1, true)
+		require.NoError(t, err)
+		_, err = r.request(ctx, 4, false)
+		require.NoError(t, err)
+
+		require.NoError(t, r.wrapTxStore(txStore).UpdateTxFatalError(ctx, []int64{1, 4}, "transaction terminally stuck"))
+		require.Equal(t, map[int64]string{1: TxCancelledByOperatorMsg, 4: "transaction terminally stuck"}, txStore.fatalErrors)
+	})
+
+	t.Run("keeps the cancellation until the address has no other stuck transaction", func(t *testing.T) {
+		r, txStore := newReplacer()
+		_, err := r.request(ctx, 1, true)
+		require.NoError(t, err)
+
+		detector := r.wrapStuckTxDetector(&fakeStuckTxDetector{stuckTxs: []Tx{txStore.txs[2]}})
+		stuckTxs, err := detector.DetectStuckTransactions(ctx, []common.Address{from}, 10)
+		require.NoError(t, err)
+		require.Len(t, stuckTxs, 1)
+		require.Equal(t, int64(2), stuckTxs[0].ID)
+
+		detector = r.wrapStuckTxDetector(&fakeStuckTxDetector{})
+		stuckTxs, err = detector.DetectStuckTransactions(ctx, []common.Address{from}, 11)
+		require.NoError(t, err)
+		require.Len(t, stuckTxs, 1)
+		require.Equal(t, int64(1), stuckTxs[0].ID)
+	})
+
+	t.Run("drops cancellations of transactions confirmed in the meantime", func(t *testing.T) {
+		r, txStore := newReplacer()
+		_, err := r.request(ctx, 1, true)
+		require.NoError(t, err)
+		etx := txStore.txs[1]
+		etx.State = txmgrcommon.TxConfirmed
+		txStore.txs[1] = etx
+
+		stuckTxs, err := r.wrapStuckTxDetector(&fakeStuckTxDetector{}).DetectStuckTransactions(ctx, []common.Address{from}, 10)
+		require.NoError(t, err)
+		require.Empty(t, stuckTxs)
+	})
+
+	t.Run("reports sped up transactions as requiring a gas bump once", func(t *testing.T) {
+		r, txStore := newReplacer()
+		_, err := r.request(ctx, 2, false)
+		require.NoError(t, err)
+		_, err = r.request(ctx, 4, false)
+		require.NoError(t, err)
+
+		bumped := txStore.txs[1]
+		txStore.gasBumps = []*Tx{&bumped}
+		wrapped := r.wrapTxStore(txStore)
+		etxs, err := wrapped.FindTxsRequiringGasBump(ctx, from, 10, 3, 0, chainID)
+		require.NoError(t, err)
+		require.Len(t, etxs, 2)
+		require.Equal(t, int64(1), etxs[0].ID)
+		require.Equal(t, int64(2), etxs[1].ID)
+
+		etxs, err = wrapped.FindTxsRequiringGasBump(ctx, from, 11, 3, 0, chainID)
+		require.NoError(t, err)
+		require.Len(t, etxs, 1)
+
+		txStore.gasBumps = nil
+		etxs, err = wrapped.FindTxsRequiringGasBump(ctx, other, 11, 3, 0, chainID)
+		require.NoError(t, err)
+		require.Len(t, etxs, 1)
+		require.Equal(t, int64(4), etxs[0].ID)
+	})
+}
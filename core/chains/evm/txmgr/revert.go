@@ -0,0 +1,71 @@
+package txmgr
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	evmclient "github.com/smartcontractkit/chainlink/v2/evm/client"
+)
+
+// DecodeRevert returns the reason of a revert. Error(string) and Panic(uint256) reverts are always
+// decoded, while custom errors are decoded with the ABI of the contract, if any. It returns false if
+// the data can't be decoded.
+func DecodeRevert(contractABI *abi.ABI, data []byte) (string, bool) {
+	if len(data) < 4 {
+		return "", false
+	}
+	if reason, err := abi.UnpackRevert(data); err == nil {
+		return reason, true
+	}
+	if contractABI == nil {
+		return "", false
+	}
+	e, err := contractABI.ErrorByID([4]byte(data[:4]))
+	if err != nil {
+		return "", false
+	}
+	values, err := e.Inputs.Unpack(data[4:])
+	if err != nil {
+		return "", false
+	}
+	args := make([]string, len(values))
+	for i, v := range values {
+		args[i] = fmt.Sprintf("%v", v)
+	}
+	return fmt.Sprintf("%s(%s)", e.Name, strings.Join(args, ", ")), true
+}
+
+// isExecutionRevert returns whether the RPC error is the transaction reverting during its execution,
+// as opposed to the call failing for another reason, e.g. a rate limit or an unsupported method.
+func isExecutionRevert(jErr *evmclient.JsonError) bool {
+	// geth and most clones return code 3 along with the revert data
+	if jErr.Code == 3 {
+		return true
+	}
+	if _, ok := revertData(jErr.Data); ok {
+		return true
+	}
+	msg := strings.ToLower(jErr.Message)
+	return strings.Contains(msg, "revert") || strings.Contains(msg, "vm execution error")
+}
+
+// revertData extracts the revert data from the data field of an RPC error. Most RPCs return it as
+// a hex string, optionally prefixed, e.g. "Reverted 0x...".
+func revertData(data interface{}) ([]byte, bool) {
+	s, ok := data.(string)
+	if !ok {
+		return nil, false
+	}
+	i := strings.Index(s, "0x")
+	if i < 0 {
+		return nil, false
+	}
+	b, err := hexutil.Decode(s[i:])
+	if err != nil || len(b) == 0 {
+		return nil, false
+	}
+	return b, true
+}
@@ -103,6 +103,10 @@ func (g *TestGasEstimatorConfig) FeeHistory() evmconfig.FeeHistory {
 	return &TestFeeHistoryConfig{}
 }
 
+func (g *TestGasEstimatorConfig) Composite() evmconfig.Composite {
+	return &TestCompositeConfig{}
+}
+
 func (g *TestGasEstimatorConfig) EIP1559DynamicFees() bool   { return false }
 func (g *TestGasEstimatorConfig) LimitDefault() uint64       { return 42 }
 func (g *TestGasEstimatorConfig) BumpPercent() uint16        { return 42 }
@@ -118,6 +122,7 @@ func (g *TestGasEstimatorConfig) BumpTxDepth() uint32        { return 42 }
 func (g *TestGasEstimatorConfig) LimitTransfer() uint64      { return 42 }
 func (g *TestGasEstimatorConfig) PriceMax() *assets.Wei      { return assets.NewWeiI(42) }
 func (g *TestGasEstimatorConfig) PriceMin() *assets.Wei      { return assets.NewWeiI(42) }
+func (g *TestGasEstimatorConfig) BlobPriceMax() *assets.Wei  { return assets.NewWeiI(42) }
 func (g *TestGasEstimatorConfig) Mode() string               { return "FixedPrice" }
 func (g *TestGasEstimatorConfig) EstimateLimit() bool        { return false }
 func (g *TestGasEstimatorConfig) LimitJobType() evmconfig.LimitJobType {
@@ -157,6 +162,10 @@ type TestFeeHistoryConfig struct {
 
 func (b *TestFeeHistoryConfig) CacheTimeout() time.Duration { return 0 * time.Second }
 
+type TestCompositeConfig struct {
+	evmconfig.Composite
+}
+
 type transactionsConfig struct {
 	evmconfig.Transactions
 	e         *TestEvmConfig
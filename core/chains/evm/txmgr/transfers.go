@@ -0,0 +1,32 @@
+package txmgr
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type transferTxStore interface {
+	HasUnconfirmedTransfer(ctx context.Context, fromAddress, toAddress common.Address, chainID *big.Int) (bool, error)
+}
+
+// TransferFinder is implemented by the transaction managers that can tell whether a native token transfer between two
+// addresses is still waiting to be confirmed, whatever its idempotency key.
+type TransferFinder interface {
+	// HasUnconfirmedTransfer returns whether a transfer from fromAddress to toAddress is unstarted, in progress or
+	// unconfirmed.
+	HasUnconfirmedTransfer(ctx context.Context, fromAddress, toAddress common.Address) (bool, error)
+}
+
+var _ TransferFinder = &evmTxm{}
+
+func (t *evmTxm) HasUnconfirmedTransfer(ctx context.Context, fromAddress, toAddress common.Address) (exists bool, err error) {
+	ok := t.IfStarted(func() {
+		exists, err = t.transfers.HasUnconfirmedTransfer(ctx, fromAddress, toAddress, t.chainID)
+	})
+	if !ok {
+		return false, errTxmNotStarted
+	}
+	return exists, err
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -16,6 +17,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/bytes"
 	"github.com/smartcontractkit/chainlink-framework/chains/txmgr"
 	txmgrtypes "github.com/smartcontractkit/chainlink-framework/chains/txmgr/types"
+	"github.com/smartcontractkit/libocr/gethwrappers2/ocr2aggregator"
 
 	v1 "github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/solidity_vrf_coordinator_interface"
 	v2 "github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/vrf_coordinator_v2"
@@ -49,7 +51,15 @@ type CheckerFactory struct {
 func (c *CheckerFactory) BuildChecker(spec TransmitCheckerSpec) (TransmitChecker, error) {
 	switch spec.CheckerType {
 	case TransmitCheckerTypeSimulate:
-		return &SimulateChecker{c.Client}, nil
+		return &SimulateChecker{Client: c.Client}, nil
+	case TransmitCheckerTypeSimulateBeforeSend:
+		return &SimulateChecker{Client: c.Client, Pending: true, DecodeReverts: true}, nil
+	case TransmitCheckerTypeSimulateBeforeSendOCR2Aggregator:
+		revertABI, err := ocr2aggregator.OCR2AggregatorMetaData.GetAbi()
+		if err != nil {
+			return nil, pkgerrors.Wrap(err, "failed to parse OCR2 aggregator ABI")
+		}
+		return &SimulateChecker{Client: c.Client, Pending: true, DecodeReverts: true, RevertABI: revertABI}, nil
 	case TransmitCheckerTypeVRFV1:
 		if spec.VRFCoordinatorAddress == nil {
 			return nil, pkgerrors.Errorf("malformed checker, expected non-nil VRFCoordinatorAddress, got: %v", spec)
@@ -119,6 +129,13 @@ func (noChecker) Check(
 // SimulateChecker simulates transactions, producing an error if they revert on chain.
 type SimulateChecker struct {
 	Client evmclient.Client
+	// Pending simulates the transaction at the pending block instead of the latest one, so that it
+	// accounts for the transactions sent before it.
+	Pending bool
+	// DecodeReverts decodes the revert reason of the transaction, with RevertABI for custom errors.
+	DecodeReverts bool
+	// RevertABI is the ABI of the target contract, used to decode its custom errors.
+	RevertABI *abi.ABI
 }
 
 // Check satisfies the TransmitChecker interface.
@@ -143,13 +160,22 @@ func (s *SimulateChecker) Check(
 		"data":                 hexutil.Bytes(tx.EncodedPayload),
 	}
 	var b hexutil.Bytes
-	// always run simulation on "latest" block
-	err := s.Client.CallContext(ctx, &b, "eth_call", callArg, evmclient.ToBlockNumArg(nil))
+	blockNumArg := evmclient.ToBlockNumArg(nil)
+	if s.Pending {
+		blockNumArg = "pending"
+	}
+	err := s.Client.CallContext(ctx, &b, "eth_call", callArg, blockNumArg)
 	if err != nil {
-		if jErr := evmclient.ExtractRPCErrorOrNil(err); jErr != nil {
+		if jErr := evmclient.ExtractRPCErrorOrNil(err); jErr != nil && isExecutionRevert(jErr) {
+			reason := jErr.String()
+			if data, ok := revertData(jErr.Data); ok && s.DecodeReverts {
+				if decoded, ok := DecodeRevert(s.RevertABI, data); ok {
+					reason = decoded
+				}
+			}
 			l.Criticalw("Transaction reverted during simulation",
-				"ethTxAttemptID", a.ID, "txHash", a.Hash, "err", err, "rpcErr", jErr.String(), "returnValue", b.String())
-			return pkgerrors.Errorf("transaction reverted during simulation: %s", jErr.String())
+				"ethTxAttemptID", a.ID, "txHash", a.Hash, "err", err, "rpcErr", jErr.String(), "reason", reason, "returnValue", b.String())
+			return pkgerrors.Errorf("transaction reverted during simulation: %s", reason)
 		}
 		l.Warnw("Transaction simulation failed, will attempt to send anyway",
 			"ethTxAttemptID", a.ID, "txHash", a.Hash, "err", err, "returnValue", b.String())
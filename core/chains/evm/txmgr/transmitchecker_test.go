@@ -5,12 +5,16 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/rpc"
 	pkgerrors "github.com/pkg/errors"
 	"github.com/stretchr/testify/mock"
@@ -22,6 +26,7 @@ import (
 
 	txmgrcommon "github.com/smartcontractkit/chainlink-framework/chains/txmgr"
 	txmgrtypes "github.com/smartcontractkit/chainlink-framework/chains/txmgr/types"
+	"github.com/smartcontractkit/libocr/gethwrappers2/ocr2aggregator"
 
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	v1 "github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/solidity_vrf_coordinator_interface"
@@ -95,6 +100,24 @@ func TestFactory(t *testing.T) {
 		require.Equal(t, &txmgr.SimulateChecker{Client: client}, c)
 	})
 
+	t.Run("simulate before send checker", func(t *testing.T) {
+		c, err := factory.BuildChecker(txmgr.TransmitCheckerSpec{
+			CheckerType: txmgr.TransmitCheckerTypeSimulateBeforeSend,
+		})
+		require.NoError(t, err)
+		require.Equal(t, &txmgr.SimulateChecker{Client: client, Pending: true, DecodeReverts: true}, c)
+	})
+
+	t.Run("simulate before send OCR2 aggregator checker", func(t *testing.T) {
+		c, err := factory.BuildChecker(txmgr.TransmitCheckerSpec{
+			CheckerType: txmgr.TransmitCheckerTypeSimulateBeforeSendOCR2Aggregator,
+		})
+		require.NoError(t, err)
+		revertABI, err := ocr2aggregator.OCR2AggregatorMetaData.GetAbi()
+		require.NoError(t, err)
+		require.Equal(t, &txmgr.SimulateChecker{Client: client, Pending: true, DecodeReverts: true, RevertABI: revertABI}, c)
+	})
+
 	t.Run("invalid checker type", func(t *testing.T) {
 		_, err := factory.BuildChecker(txmgr.TransmitCheckerSpec{
 			CheckerType: "invalid",
@@ -159,6 +182,19 @@ func TestTransmitCheckers(t *testing.T) {
 			require.EqualError(t, err, expErrMsg)
 		})
 
+		t.Run("rpc error", func(t *testing.T) {
+			jerr := evmclient.JsonError{
+				Code:    -32005,
+				Message: "limit exceeded",
+			}
+			client.On("CallContext", mock.Anything,
+				mock.AnythingOfType("*hexutil.Bytes"), "eth_call",
+				mock.Anything, "latest").Return(&jerr).Once()
+
+			// RPC errors other than a revert don't mean the transaction would revert
+			require.NoError(t, checker.Check(ctx, log, tx, attempt))
+		})
+
 		t.Run("non revert error", func(t *testing.T) {
 			client.On("CallContext", mock.Anything,
 				mock.AnythingOfType("*hexutil.Bytes"), "eth_call",
@@ -172,6 +208,84 @@ func TestTransmitCheckers(t *testing.T) {
 		})
 	})
 
+	t.Run("simulate before send", func(t *testing.T) {
+		checker := txmgr.SimulateChecker{Client: client, Pending: true, DecodeReverts: true}
+
+		tx := txmgr.Tx{
+			FromAddress:    common.HexToAddress("0xfe0629509E6CB8dfa7a99214ae58Ceb465d5b5A9"),
+			ToAddress:      testutils.NewAddress(),
+			EncodedPayload: []byte{42, 0, 0},
+			Value:          big.Int(assets.NewEthValue(642)),
+			FeeLimit:       1e9,
+			CreatedAt:      time.Unix(0, 0),
+			State:          txmgrcommon.TxUnstarted,
+		}
+		attempt := txmgr.TxAttempt{
+			Tx:        tx,
+			Hash:      common.Hash{},
+			CreatedAt: tx.CreatedAt,
+			State:     txmgrtypes.TxAttemptInProgress,
+		}
+
+		t.Run("success", func(t *testing.T) {
+			client.On("CallContext", mock.Anything,
+				mock.AnythingOfType("*hexutil.Bytes"), "eth_call",
+				mock.Anything, "pending").Return(nil).Once()
+
+			require.NoError(t, checker.Check(ctx, log, tx, attempt))
+		})
+
+		t.Run("revert with reason", func(t *testing.T) {
+			jerr := evmclient.JsonError{
+				Code:    3,
+				Message: "execution reverted",
+				Data:    hexutil.Encode(revertErrorData(t, "oh no")),
+			}
+			client.On("CallContext", mock.Anything,
+				mock.AnythingOfType("*hexutil.Bytes"), "eth_call",
+				mock.Anything, "pending").Return(&jerr).Once()
+
+			err := checker.Check(ctx, log, tx, attempt)
+			require.EqualError(t, err, "transaction reverted during simulation: oh no")
+		})
+
+		t.Run("revert with custom error of the contract", func(t *testing.T) {
+			a := parseCustomErrorABI(t)
+			checker := txmgr.SimulateChecker{Client: client, Pending: true, DecodeReverts: true, RevertABI: &a}
+
+			data, err := a.Errors["InsufficientBalance"].Inputs.Pack(big.NewInt(1), big.NewInt(2))
+			require.NoError(t, err)
+			jerr := evmclient.JsonError{
+				Code:    -32015,
+				Message: "VM execution error.",
+				Data:    "Reverted " + hexutil.Encode(append(a.Errors["InsufficientBalance"].ID.Bytes()[:4], data...)),
+			}
+			client.On("CallContext", mock.Anything,
+				mock.AnythingOfType("*hexutil.Bytes"), "eth_call",
+				mock.Anything, "pending").Return(&jerr).Once()
+
+			err = checker.Check(ctx, log, tx, attempt)
+			require.EqualError(t, err, "transaction reverted during simulation: InsufficientBalance(1, 2)")
+		})
+
+		t.Run("revert with custom error of another contract", func(t *testing.T) {
+			a := parseCustomErrorABI(t)
+			data, err := a.Errors["Unauthorized"].Inputs.Pack(tx.FromAddress)
+			require.NoError(t, err)
+			jerr := evmclient.JsonError{
+				Code:    3,
+				Message: "execution reverted",
+				Data:    hexutil.Encode(append(a.Errors["Unauthorized"].ID.Bytes()[:4], data...)),
+			}
+			client.On("CallContext", mock.Anything,
+				mock.AnythingOfType("*hexutil.Bytes"), "eth_call",
+				mock.Anything, "pending").Return(&jerr).Once()
+
+			err = checker.Check(ctx, log, tx, attempt)
+			require.ErrorContains(t, err, "transaction reverted during simulation: json-rpc error { Code = 3, Message = 'execution reverted'")
+		})
+	})
+
 	t.Run("VRF V1", func(t *testing.T) {
 		testDefaultSubID := uint64(2)
 		testDefaultMaxLink := "1000000000000000000"
@@ -372,3 +486,51 @@ func TestTransmitCheckers(t *testing.T) {
 		})
 	})
 }
+
+func TestDecodeRevert(t *testing.T) {
+	a := parseCustomErrorABI(t)
+
+	t.Run("error string", func(t *testing.T) {
+		reason, ok := txmgr.DecodeRevert(nil, revertErrorData(t, "oh no"))
+		require.True(t, ok)
+		require.Equal(t, "oh no", reason)
+	})
+
+	t.Run("custom error", func(t *testing.T) {
+		data, err := a.Errors["Unauthorized"].Inputs.Pack(common.HexToAddress("0xfe0629509E6CB8dfa7a99214ae58Ceb465d5b5A9"))
+		require.NoError(t, err)
+		data = append(a.Errors["Unauthorized"].ID.Bytes()[:4], data...)
+
+		reason, ok := txmgr.DecodeRevert(&a, data)
+		require.True(t, ok)
+		require.Equal(t, "Unauthorized(0xfe0629509E6CB8dfa7a99214ae58Ceb465d5b5A9)", reason)
+
+		_, ok = txmgr.DecodeRevert(nil, data)
+		require.False(t, ok)
+	})
+
+	t.Run("unknown error", func(t *testing.T) {
+		_, ok := txmgr.DecodeRevert(&a, []byte{1, 2, 3, 4, 5})
+		require.False(t, ok)
+		_, ok = txmgr.DecodeRevert(&a, []byte{1, 2})
+		require.False(t, ok)
+	})
+}
+
+func parseCustomErrorABI(t *testing.T) abi.ABI {
+	a, err := abi.JSON(strings.NewReader(`[
+		{"type":"error","name":"InsufficientBalance","inputs":[{"name":"have","type":"uint256"},{"name":"want","type":"uint256"}]},
+		{"type":"error","name":"Unauthorized","inputs":[{"name":"sender","type":"address"}]}
+	]`))
+	require.NoError(t, err)
+	return a
+}
+
+// revertErrorData returns the data of a revert with the Error(string) reason.
+func revertErrorData(t *testing.T, reason string) []byte {
+	stringType, err := abi.NewType("string", "", nil)
+	require.NoError(t, err)
+	data, err := abi.Arguments{{Type: stringType}}.Pack(reason)
+	require.NoError(t, err)
+	return append(crypto.Keccak256([]byte("Error(string)"))[:4], data...)
+}
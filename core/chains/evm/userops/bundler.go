@@ -0,0 +1,120 @@
+package userops
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// GasEstimate is the gas of a user operation, as estimated by the bundler.
+type GasEstimate struct {
+	PreVerificationGas            *hexutil.Big `json:"preVerificationGas"`
+	VerificationGasLimit          *hexutil.Big `json:"verificationGasLimit"`
+	CallGasLimit                  *hexutil.Big `json:"callGasLimit"`
+	PaymasterVerificationGasLimit *hexutil.Big `json:"paymasterVerificationGasLimit,omitempty"`
+	PaymasterPostOpGasLimit       *hexutil.Big `json:"paymasterPostOpGasLimit,omitempty"`
+}
+
+// Receipt is the receipt of a user operation included on chain.
+type Receipt struct {
+	UserOpHash    common.Hash  `json:"userOpHash"`
+	Success       bool         `json:"success"`
+	Reason        string       `json:"reason"`
+	ActualGasCost *hexutil.Big `json:"actualGasCost"`
+	Receipt       struct {
+		TransactionHash common.Hash  `json:"transactionHash"`
+		BlockNumber     *hexutil.Big `json:"blockNumber"`
+	} `json:"receipt"`
+}
+
+// PaymasterData are the paymaster fields of a user operation, as returned by an ERC-7677 paymaster service.
+type PaymasterData struct {
+	Paymaster                     *common.Address `json:"paymaster"`
+	PaymasterData                 hexutil.Bytes   `json:"paymasterData"`
+	PaymasterVerificationGasLimit *hexutil.Big    `json:"paymasterVerificationGasLimit,omitempty"`
+	PaymasterPostOpGasLimit       *hexutil.Big    `json:"paymasterPostOpGasLimit,omitempty"`
+}
+
+// Bundler is the client of an ERC-4337 bundler.
+type Bundler interface {
+	// SendUserOperation submits the user operation to the bundler mempool, and returns its hash.
+	SendUserOperation(ctx context.Context, op *UserOperation, entryPoint common.Address) (common.Hash, error)
+	// EstimateUserOperationGas estimates the gas of the user operation, which must have a dummy signature.
+	EstimateUserOperationGas(ctx context.Context, op *UserOperation, entryPoint common.Address) (*GasEstimate, error)
+	// GetUserOperationReceipt returns the receipt of the user operation, or nil if it isn't included yet.
+	GetUserOperationReceipt(ctx context.Context, hash common.Hash) (*Receipt, error)
+}
+
+// Paymaster is the client of an ERC-7677 paymaster service.
+type Paymaster interface {
+	// GetPaymasterStubData returns paymaster fields with which the gas of the user operation can be estimated.
+	GetPaymasterStubData(ctx context.Context, op *UserOperation, entryPoint common.Address, chainID *big.Int) (*PaymasterData, error)
+	// GetPaymasterData returns the paymaster fields sponsoring the user operation, once its gas is estimated.
+	GetPaymasterData(ctx context.Context, op *UserOperation, entryPoint common.Address, chainID *big.Int) (*PaymasterData, error)
+}
+
+type rpcClient struct {
+	c *rpc.Client
+}
+
+var _ Bundler = (*rpcClient)(nil)
+var _ Paymaster = (*rpcClient)(nil)
+
+// NewBundlerClient returns a client of the ERC-4337 bundler HTTP RPC at the url.
+func NewBundlerClient(url string) (Bundler, error) {
+	c, err := rpc.DialHTTP(url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial bundler: %w", err)
+	}
+	return &rpcClient{c: c}, nil
+}
+
+// NewPaymasterClient returns a client of the ERC-7677 paymaster service HTTP RPC at the url.
+func NewPaymasterClient(url string) (Paymaster, error) {
+	c, err := rpc.DialHTTP(url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial paymaster: %w", err)
+	}
+	return &rpcClient{c: c}, nil
+}
+
+func (r *rpcClient) SendUserOperation(ctx context.Context, op *UserOperation, entryPoint common.Address) (hash common.Hash, err error) {
+	err = r.c.CallContext(ctx, &hash, "eth_sendUserOperation", op, entryPoint)
+	return
+}
+
+func (r *rpcClient) EstimateUserOperationGas(ctx context.Context, op *UserOperation, entryPoint common.Address) (*GasEstimate, error) {
+	var estimate GasEstimate
+	if err := r.c.CallContext(ctx, &estimate, "eth_estimateUserOperationGas", op, entryPoint); err != nil {
+		return nil, err
+	}
+	return &estimate, nil
+}
+
+func (r *rpcClient) GetUserOperationReceipt(ctx context.Context, hash common.Hash) (*Receipt, error) {
+	var receipt *Receipt
+	if err := r.c.CallContext(ctx, &receipt, "eth_getUserOperationReceipt", hash); err != nil {
+		return nil, err
+	}
+	return receipt, nil
+}
+
+func (r *rpcClient) GetPaymasterStubData(ctx context.Context, op *UserOperation, entryPoint common.Address, chainID *big.Int) (*PaymasterData, error) {
+	var data PaymasterData
+	if err := r.c.CallContext(ctx, &data, "pm_getPaymasterStubData", op, entryPoint, (*hexutil.Big)(chainID), struct{}{}); err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
+
+func (r *rpcClient) GetPaymasterData(ctx context.Context, op *UserOperation, entryPoint common.Address, chainID *big.Int) (*PaymasterData, error) {
+	var data PaymasterData
+	if err := r.c.CallContext(ctx, &data, "pm_getPaymasterData", op, entryPoint, (*hexutil.Big)(chainID), struct{}{}); err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
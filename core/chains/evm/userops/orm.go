@@ -0,0 +1,117 @@
+package userops
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/lib/pq"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+
+	ubig "github.com/smartcontractkit/chainlink/v2/evm/utils/big"
+)
+
+// UserOperationState is the state of a transaction request sent as a user operation.
+type UserOperationState string
+
+const (
+	// UserOperationUnstarted user operations are queued, and not sent to the bundler yet.
+	UserOperationUnstarted UserOperationState = "unstarted"
+	// UserOperationPending user operations are sent to the bundler, and wait to be included.
+	UserOperationPending UserOperationState = "pending"
+	// UserOperationIncluded user operations are included on chain, and succeeded.
+	UserOperationIncluded UserOperationState = "included"
+	// UserOperationFailed user operations were rejected by the bundler, or reverted on chain.
+	UserOperationFailed UserOperationState = "failed"
+)
+
+// UserOperationTx is a transaction request sent as a user operation. Its sender, nonce and hash are set once it is
+// sent to the bundler, and its hash changes whenever it is resubmitted with bumped fees.
+type UserOperationTx struct {
+	ID                   int64
+	EVMChainID           ubig.Big
+	IdempotencyKey       string
+	State                UserOperationState
+	EntryPoint           common.Address
+	Owner                common.Address
+	ToAddress            common.Address
+	Value                ubig.Big
+	Data                 []byte
+	FeeLimit             uint64
+	Sender               *common.Address
+	Nonce                *ubig.Big
+	Hash                 *common.Hash
+	PreviousHashes       pq.ByteaArray
+	MaxFeePerGas         *ubig.Big
+	MaxPriorityFeePerGas *ubig.Big
+	BlockNumber          *int64
+	Error                *string
+	BroadcastAt          *time.Time
+	CreatedAt            time.Time
+}
+
+// hashes returns the hashes the user operation was sent with, the current one last.
+func (op *UserOperationTx) hashes() []common.Hash {
+	hashes := make([]common.Hash, 0, len(op.PreviousHashes)+1)
+	for _, h := range op.PreviousHashes {
+		hashes = append(hashes, common.BytesToHash(h))
+	}
+	if op.Hash != nil {
+		hashes = append(hashes, *op.Hash)
+	}
+	return hashes
+}
+
+type ORM interface {
+	InsertUserOperation(ctx context.Context, op *UserOperationTx) error
+	UpdateUserOperation(ctx context.Context, op *UserOperationTx) error
+	FindUserOperationByIdempotencyKey(ctx context.Context, chainID *big.Int, idempotencyKey string) (*UserOperationTx, error)
+	FindUserOperationsByState(ctx context.Context, chainID *big.Int, state UserOperationState) ([]*UserOperationTx, error)
+}
+
+type DSORM struct {
+	ds sqlutil.DataSource
+}
+
+var _ ORM = &DSORM{}
+
+func NewORM(ds sqlutil.DataSource) *DSORM {
+	return &DSORM{ds: ds}
+}
+
+// InsertUserOperation queues the unstarted user operation, and sets its ID and creation time.
+func (o *DSORM) InsertUserOperation(ctx context.Context, op *UserOperationTx) error {
+	query := `INSERT INTO evm.user_operations (evm_chain_id, idempotency_key, state, entry_point, owner, to_address, value, data, fee_limit)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) RETURNING id, created_at`
+	return o.ds.QueryRowxContext(ctx, query, op.EVMChainID, op.IdempotencyKey, op.State, op.EntryPoint, op.Owner, op.ToAddress, op.Value, op.Data, op.FeeLimit).
+		Scan(&op.ID, &op.CreatedAt)
+}
+
+// UpdateUserOperation saves the state of the user operation, and the fields set when it is sent.
+func (o *DSORM) UpdateUserOperation(ctx context.Context, op *UserOperationTx) error {
+	query := `UPDATE evm.user_operations SET state = $2, sender = $3, nonce = $4, hash = $5, previous_hashes = $6, max_fee_per_gas = $7,
+max_priority_fee_per_gas = $8, block_number = $9, error = $10, broadcast_at = $11 WHERE id = $1`
+	_, err := o.ds.ExecContext(ctx, query, op.ID, op.State, op.Sender, op.Nonce, op.Hash, op.PreviousHashes, op.MaxFeePerGas,
+		op.MaxPriorityFeePerGas, op.BlockNumber, op.Error, op.BroadcastAt)
+	return err
+}
+
+// FindUserOperationByIdempotencyKey returns the user operation queued for the idempotency key, or nil if there is none.
+func (o *DSORM) FindUserOperationByIdempotencyKey(ctx context.Context, chainID *big.Int, idempotencyKey string) (*UserOperationTx, error) {
+	var op UserOperationTx
+	err := o.ds.GetContext(ctx, &op, `SELECT * FROM evm.user_operations WHERE evm_chain_id = $1 AND idempotency_key = $2`, ubig.New(chainID), idempotencyKey)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return &op, err
+}
+
+// FindUserOperationsByState returns the user operations in the state, in the order they were queued.
+func (o *DSORM) FindUserOperationsByState(ctx context.Context, chainID *big.Int, state UserOperationState) (ops []*UserOperationTx, err error) {
+	err = o.ds.SelectContext(ctx, &ops, `SELECT * FROM evm.user_operations WHERE evm_chain_id = $1 AND state = $2 ORDER BY id`, ubig.New(chainID), state)
+	return
+}
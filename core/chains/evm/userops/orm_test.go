@@ -0,0 +1,74 @@
+package userops
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/evm/testutils"
+	"github.com/smartcontractkit/chainlink/v2/evm/utils/big"
+)
+
+func TestORM_UserOperations(t *testing.T) {
+	t.Parallel()
+	orm := NewORM(testutils.NewSqlxDB(t))
+	chainID := testutils.FixtureChainID
+	ctx := testutils.Context(t)
+	key := "job-run-1"
+
+	found, err := orm.FindUserOperationByIdempotencyKey(ctx, chainID, key)
+	require.NoError(t, err)
+	assert.Nil(t, found)
+
+	op := &UserOperationTx{
+		EVMChainID:     *big.New(chainID),
+		IdempotencyKey: key,
+		State:          UserOperationUnstarted,
+		EntryPoint:     testutils.NewAddress(),
+		Owner:          testutils.NewAddress(),
+		ToAddress:      testutils.NewAddress(),
+		Value:          *big.NewI(0),
+		Data:           []byte{1, 2, 3},
+		FeeLimit:       100_000,
+	}
+	require.NoError(t, orm.InsertUserOperation(ctx, op))
+	assert.NotZero(t, op.ID)
+	assert.False(t, op.CreatedAt.IsZero())
+
+	unstarted, err := orm.FindUserOperationsByState(ctx, chainID, UserOperationUnstarted)
+	require.NoError(t, err)
+	require.Len(t, unstarted, 1)
+	assert.Equal(t, op.ID, unstarted[0].ID)
+	assert.Equal(t, op.Data, unstarted[0].Data)
+	assert.Nil(t, unstarted[0].Nonce)
+
+	// Idempotency keys are unique per chain
+	duplicate := *op
+	require.Error(t, orm.InsertUserOperation(ctx, &duplicate))
+
+	sender, hash, previous, broadcastAt := testutils.NewAddress(), testutils.NewHash(), testutils.NewHash(), time.Now()
+	op.State = UserOperationPending
+	op.Sender = &sender
+	op.Nonce = big.NewI(3)
+	op.Hash = &hash
+	op.PreviousHashes = [][]byte{previous.Bytes()}
+	op.MaxFeePerGas = big.NewI(10)
+	op.MaxPriorityFeePerGas = big.NewI(1)
+	op.BroadcastAt = &broadcastAt
+	require.NoError(t, orm.UpdateUserOperation(ctx, op))
+
+	found, err = orm.FindUserOperationByIdempotencyKey(ctx, chainID, key)
+	require.NoError(t, err)
+	require.NotNil(t, found)
+	assert.Equal(t, UserOperationPending, found.State)
+	assert.Equal(t, &sender, found.Sender)
+	assert.Equal(t, op.Nonce, found.Nonce)
+	assert.Equal(t, []common.Hash{previous, hash}, found.hashes())
+
+	unstarted, err = orm.FindUserOperationsByState(ctx, chainID, UserOperationUnstarted)
+	require.NoError(t, err)
+	assert.Empty(t, unstarted)
+}
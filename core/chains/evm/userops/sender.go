@@ -0,0 +1,693 @@
+package userops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/google/uuid"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/evm/gas"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/evm/types"
+	ubig "github.com/smartcontractkit/chainlink/v2/evm/utils/big"
+)
+
+// accountSalt is the salt of the smart accounts created for the node keys, so that each key owns a single account.
+var accountSalt = big.NewInt(0)
+
+// dummySignature is a well-formed ECDSA signature, used to simulate the validation of user operations while
+// their gas is estimated.
+var dummySignature = hexutil.MustDecode("0xfffffffffffffffffffffffffffffff0000000000000000000000000000000007aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa1c")
+
+var (
+	factoryABI = evmtypes.MustGetABI(`[
+		{"type":"function","name":"getAddress","stateMutability":"view","inputs":[{"name":"owner","type":"address"},{"name":"salt","type":"uint256"}],"outputs":[{"name":"","type":"address"}]},
+		{"type":"function","name":"createAccount","stateMutability":"nonpayable","inputs":[{"name":"owner","type":"address"},{"name":"salt","type":"uint256"}],"outputs":[{"name":"","type":"address"}]}
+	]`)
+	accountABI = evmtypes.MustGetABI(`[
+		{"type":"function","name":"execute","stateMutability":"nonpayable","inputs":[{"name":"dest","type":"address"},{"name":"value","type":"uint256"},{"name":"func","type":"bytes"}],"outputs":[]}
+	]`)
+	entryPointABI = evmtypes.MustGetABI(`[
+		{"type":"function","name":"getNonce","stateMutability":"view","inputs":[{"name":"sender","type":"address"},{"name":"key","type":"uint192"}],"outputs":[{"name":"nonce","type":"uint256"}]}
+	]`)
+)
+
+const (
+	// pollInterval is how often the queued and pending user operations are processed.
+	pollInterval = 5 * time.Second
+	// maxPendingPerAccount is the most user operations of an account waiting to be included at a time, as bundlers
+	// only accept a few user operations of an unstaked sender in their mempool.
+	maxPendingPerAccount = 4
+	// feeBumpPercent is the least bundlers require the fees of a user operation to increase to replace it.
+	feeBumpPercent = 10
+	// receiptTimeout is how long a pending user operation whose nonce is used waits for a receipt of one of its hashes,
+	// before it is marked as failed.
+	receiptTimeout = 10 * time.Minute
+)
+
+var (
+	// ErrUserOperationNotFound is returned when no user operation was queued for an idempotency key.
+	ErrUserOperationNotFound = errors.New("user operation not found")
+
+	errUserOperationRejected = errors.New("user operation rejected")
+)
+
+type senderClient interface {
+	CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error)
+	CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error)
+}
+
+type messageSigner interface {
+	SignMessage(ctx context.Context, address common.Address, message []byte) ([]byte, error)
+}
+
+type senderFeeConfig interface {
+	PriceMaxKey(common.Address) *assets.Wei
+}
+
+type finalizedBlockHeadTracker interface {
+	LatestAndFinalizedBlock(ctx context.Context) (latest, finalized *evmtypes.Head, err error)
+}
+
+// Sender sends transaction requests as user operations, from the smart accounts owned by the keys the requests
+// are sent from. The accounts are created by the account factory with the first user operation they send.
+//
+// The requests are queued, and sent by a single loop which assigns the nonces of the accounts. User operations
+// which aren't included within the resend threshold are resubmitted at the same nonce with bumped fees, so
+// that a user operation dropped by the bundler never leaves a gap in the nonces of its account.
+type Sender struct {
+	services.StateMachine
+	lggr        logger.SugaredLogger
+	chainID     *big.Int
+	entryPoint  common.Address
+	factory     common.Address
+	client      senderClient
+	keystore    messageSigner
+	estimator   gas.EvmFeeEstimator
+	feeConfig   senderFeeConfig
+	headTracker finalizedBlockHeadTracker
+	bundler     Bundler
+	paymaster   Paymaster
+	orm         ORM
+	resendAfter time.Duration
+
+	accountsMu sync.Mutex
+	accounts   map[common.Address]common.Address // smart account of each owner
+
+	nonceUsedAt map[int64]time.Time // when the nonce of pending user operations without a receipt was found used
+
+	trigger chan struct{}
+	stopCh  services.StopChan
+	wg      sync.WaitGroup
+}
+
+// NewSender returns a Sender of user operations through the bundler. The paymaster is optional, and sponsors
+// the gas of the user operations if set. Pending user operations are resubmitted after resendAfter, unless it is 0.
+func NewSender(
+	lggr logger.Logger,
+	chainID *big.Int,
+	entryPoint, factory common.Address,
+	client senderClient,
+	keystore messageSigner,
+	estimator gas.EvmFeeEstimator,
+	feeConfig senderFeeConfig,
+	headTracker finalizedBlockHeadTracker,
+	bundler Bundler,
+	paymaster Paymaster,
+	orm ORM,
+	resendAfter time.Duration,
+) *Sender {
+	return &Sender{
+		lggr:        logger.Sugared(lggr.Named("UserOpSender")),
+		chainID:     chainID,
+		entryPoint:  entryPoint,
+		factory:     factory,
+		client:      client,
+		keystore:    keystore,
+		estimator:   estimator,
+		feeConfig:   feeConfig,
+		headTracker: headTracker,
+		bundler:     bundler,
+		paymaster:   paymaster,
+		orm:         orm,
+		resendAfter: resendAfter,
+		accounts:    make(map[common.Address]common.Address),
+		nonceUsedAt: make(map[int64]time.Time),
+		trigger:     make(chan struct{}, 1),
+	}
+}
+
+func (s *Sender) Start(context.Context) error {
+	return s.StartOnce("UserOpSender", func() error {
+		s.stopCh = make(chan struct{})
+		s.wg.Add(1)
+		go s.runLoop()
+		return nil
+	})
+}
+
+func (s *Sender) Close() error {
+	return s.StopOnce("UserOpSender", func() error {
+		close(s.stopCh)
+		s.wg.Wait()
+		return nil
+	})
+}
+
+func (s *Sender) Name() string {
+	return s.lggr.Name()
+}
+
+func (s *Sender) HealthReport() map[string]error {
+	return map[string]error{s.Name(): s.Healthy()}
+}
+
+func (s *Sender) runLoop() {
+	defer s.wg.Done()
+	ctx, cancel := s.stopCh.NewCtx()
+	defer cancel()
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-s.trigger:
+		}
+		if err := s.processQueue(ctx); err != nil {
+			s.lggr.Errorw("Failed to process user operations", "err", err)
+			s.SvcErrBuffer.Append(err)
+		}
+	}
+}
+
+// Enqueue queues the transaction request, to be sent as a user operation of the smart account owned by its from
+// address. An idempotency key is generated if the request has none. If a user operation was already queued for
+// the idempotency key of the request, it is returned instead. The forwarder address of the request is ignored, as
+// the smart account calls the to address itself.
+func (s *Sender) Enqueue(ctx context.Context, txRequest txmgr.TxRequest) (*UserOperationTx, error) {
+	idempotencyKey := uuid.NewString()
+	if txRequest.IdempotencyKey != nil {
+		idempotencyKey = *txRequest.IdempotencyKey
+		existing, err := s.orm.FindUserOperationByIdempotencyKey(ctx, s.chainID, idempotencyKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to search for user operation with idempotency key %s: %w", idempotencyKey, err)
+		}
+		if existing != nil {
+			s.lggr.Infow("Found a user operation with the same idempotency key, returning it", "idempotencyKey", idempotencyKey, "id", existing.ID)
+			return existing, nil
+		}
+	}
+	op := &UserOperationTx{
+		EVMChainID:     *ubig.New(s.chainID),
+		IdempotencyKey: idempotencyKey,
+		State:          UserOperationUnstarted,
+		EntryPoint:     s.entryPoint,
+		Owner:          txRequest.FromAddress,
+		ToAddress:      txRequest.ToAddress,
+		Value:          *ubig.New(&txRequest.Value),
+		Data:           txRequest.EncodedPayload,
+		FeeLimit:       txRequest.FeeLimit,
+	}
+	if err := s.orm.InsertUserOperation(ctx, op); err != nil {
+		return nil, fmt.Errorf("failed to queue user operation: %w", err)
+	}
+	select {
+	case s.trigger <- struct{}{}:
+	default:
+	}
+	return op, nil
+}
+
+// Status returns the status of the user operation queued for the idempotency key, or ErrUserOperationNotFound.
+func (s *Sender) Status(ctx context.Context, idempotencyKey string) (commontypes.TransactionStatus, error) {
+	op, err := s.orm.FindUserOperationByIdempotencyKey(ctx, s.chainID, idempotencyKey)
+	if err != nil {
+		return commontypes.Unknown, fmt.Errorf("failed to find user operation: %w", err)
+	}
+	if op == nil {
+		return commontypes.Unknown, ErrUserOperationNotFound
+	}
+	switch op.State {
+	case UserOperationUnstarted, UserOperationPending:
+		return commontypes.Pending, nil
+	case UserOperationFailed:
+		var reason string
+		if op.Error != nil {
+			reason = *op.Error
+		}
+		return commontypes.Failed, fmt.Errorf("user operation %d failed: %s", op.ID, reason)
+	case UserOperationIncluded:
+		_, finalized, err := s.headTracker.LatestAndFinalizedBlock(ctx)
+		if err != nil {
+			return commontypes.Unknown, fmt.Errorf("failed to get finalized block: %w", err)
+		}
+		if finalized != nil && op.BlockNumber != nil && *op.BlockNumber <= finalized.Number {
+			return commontypes.Finalized, nil
+		}
+		return commontypes.Unconfirmed, nil
+	default:
+		return commontypes.Unknown, fmt.Errorf("user operation %d has unknown state %s", op.ID, op.State)
+	}
+}
+
+// accountNonces are the nonces of a smart account, as used on chain and by its pending user operations.
+type accountNonces struct {
+	onChain *big.Int // next nonce on chain
+	next    *big.Int // next nonce not used on chain nor by a pending user operation
+	pending int      // pending user operations whose nonce isn't used on chain yet
+}
+
+// processQueue checks the pending user operations, resubmitting the ones not included in time, and then sends the
+// unstarted ones in the order they were queued.
+func (s *Sender) processQueue(ctx context.Context) error {
+	pending, err := s.orm.FindUserOperationsByState(ctx, s.chainID, UserOperationPending)
+	if err != nil {
+		return fmt.Errorf("failed to find pending user operations: %w", err)
+	}
+	pendingByAccount := make(map[common.Address][]*UserOperationTx)
+	for _, op := range pending {
+		pendingByAccount[*op.Sender] = append(pendingByAccount[*op.Sender], op)
+	}
+	nonces := make(map[common.Address]*accountNonces)
+	getNonces := func(account common.Address) (*accountNonces, error) {
+		if n, ok := nonces[account]; ok {
+			return n, nil
+		}
+		n, err := s.accountNonces(ctx, account, pendingByAccount[account])
+		if err != nil {
+			return nil, err
+		}
+		nonces[account] = n
+		return n, nil
+	}
+
+	for _, op := range pending {
+		n, err := getNonces(*op.Sender)
+		if err != nil {
+			return err
+		}
+		if err = s.checkPending(ctx, op, n); err != nil {
+			s.lggr.Errorw("Failed to check pending user operation", "id", op.ID, "userOpHash", op.Hash, "err", err)
+		}
+	}
+
+	unstarted, err := s.orm.FindUserOperationsByState(ctx, s.chainID, UserOperationUnstarted)
+	if err != nil {
+		return fmt.Errorf("failed to find unstarted user operations: %w", err)
+	}
+	// The user operations of an owner are sent in order, so the ones queued after one which can't be sent wait for it
+	blocked := make(map[common.Address]bool)
+	for _, op := range unstarted {
+		if blocked[op.Owner] {
+			continue
+		}
+		account, err := s.AccountAddress(ctx, op.Owner)
+		if err != nil {
+			return err
+		}
+		n, err := getNonces(account)
+		if err != nil {
+			return err
+		}
+		if n.pending >= maxPendingPerAccount {
+			blocked[op.Owner] = true
+			continue
+		}
+		err = s.send(ctx, op, account, n.next)
+		if errors.Is(err, errUserOperationRejected) {
+			s.lggr.Errorw("User operation rejected", "id", op.ID, "idempotencyKey", op.IdempotencyKey, "err", err)
+			continue
+		} else if err != nil {
+			s.lggr.Errorw("Failed to send user operation, retrying later", "id", op.ID, "idempotencyKey", op.IdempotencyKey, "err", err)
+			blocked[op.Owner] = true
+			continue
+		}
+		n.next = new(big.Int).Add(n.next, big.NewInt(1))
+		n.pending++
+	}
+	return nil
+}
+
+// accountNonces returns the nonces of the account, given its pending user operations.
+func (s *Sender) accountNonces(ctx context.Context, account common.Address, pending []*UserOperationTx) (*accountNonces, error) {
+	onChain, err := s.chainNonce(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+	n := &accountNonces{onChain: onChain, next: new(big.Int).Set(onChain)}
+	for _, op := range pending {
+		nonce := op.Nonce.ToInt()
+		if nonce.Cmp(onChain) < 0 {
+			continue
+		}
+		n.pending++
+		if nonce.Cmp(n.next) >= 0 {
+			n.next = new(big.Int).Add(nonce, big.NewInt(1))
+		}
+	}
+	return n, nil
+}
+
+// checkPending marks the pending user operation included once one of the hashes it was sent with has a receipt.
+// Otherwise, it is resubmitted with bumped fees if its nonce is still unused after the resend threshold, or marked as
+// failed if its nonce is used but none of its hashes has a receipt within receiptTimeout.
+func (s *Sender) checkPending(ctx context.Context, op *UserOperationTx, n *accountNonces) error {
+	receipt, err := s.bundler.GetUserOperationReceipt(ctx, *op.Hash)
+	if err != nil {
+		return fmt.Errorf("failed to get user operation receipt: %w", err)
+	}
+	if receipt != nil {
+		return s.markIncluded(ctx, op, receipt)
+	}
+	if op.Nonce.ToInt().Cmp(n.onChain) < 0 {
+		// The nonce is used, so the user operation was included with one of its previous hashes
+		for _, hash := range op.hashes()[:len(op.PreviousHashes)] {
+			receipt, err = s.bundler.GetUserOperationReceipt(ctx, hash)
+			if err != nil {
+				return fmt.Errorf("failed to get user operation receipt: %w", err)
+			}
+			if receipt != nil {
+				return s.markIncluded(ctx, op, receipt)
+			}
+		}
+		// Unless the bundler hasn't indexed its receipt yet, the nonce was used by another user operation of the account
+		usedAt, ok := s.nonceUsedAt[op.ID]
+		if !ok {
+			s.nonceUsedAt[op.ID] = time.Now()
+			return nil
+		}
+		if time.Since(usedAt) < receiptTimeout {
+			return nil
+		}
+		delete(s.nonceUsedAt, op.ID)
+		s.lggr.Errorw("Nonce of user operation used without a receipt for any of its hashes, marking it as failed", "id", op.ID,
+			"userOpHash", op.Hash, "sender", op.Sender, "nonce", op.Nonce, "previousHashes", len(op.PreviousHashes))
+		reason := fmt.Sprintf("nonce %s of account %s was used, but none of the hashes of the user operation has a receipt", op.Nonce, op.Sender)
+		op.State, op.Error = UserOperationFailed, &reason
+		if err = s.orm.UpdateUserOperation(ctx, op); err != nil {
+			return fmt.Errorf("failed to save failed user operation: %w", err)
+		}
+		return nil
+	}
+	if s.resendAfter == 0 || time.Since(*op.BroadcastAt) < s.resendAfter {
+		return nil
+	}
+	s.lggr.Warnw("User operation not included in time, resubmitting it with bumped fees", "id", op.ID, "userOpHash", op.Hash,
+		"sender", op.Sender, "nonce", op.Nonce, "broadcastAt", op.BroadcastAt)
+	return s.send(ctx, op, *op.Sender, op.Nonce.ToInt())
+}
+
+// markIncluded saves the user operation as included, or failed if it reverted.
+func (s *Sender) markIncluded(ctx context.Context, op *UserOperationTx, receipt *Receipt) error {
+	delete(s.nonceUsedAt, op.ID)
+	hash := receipt.UserOpHash
+	if op.Hash != nil && *op.Hash != hash {
+		op.PreviousHashes = append(op.PreviousHashes, op.Hash.Bytes())
+		op.Hash = &hash
+	}
+	if receipt.Receipt.BlockNumber != nil {
+		blockNumber := receipt.Receipt.BlockNumber.ToInt().Int64()
+		op.BlockNumber = &blockNumber
+	}
+	op.State = UserOperationIncluded
+	if !receipt.Success {
+		reason := fmt.Sprintf("user operation %s reverted: %s", hash, receipt.Reason)
+		op.State, op.Error = UserOperationFailed, &reason
+	}
+	if err := s.orm.UpdateUserOperation(ctx, op); err != nil {
+		return fmt.Errorf("failed to save included user operation: %w", err)
+	}
+	s.lggr.Infow("User operation included", "id", op.ID, "userOpHash", hash, "success", receipt.Success, "blockNumber", op.BlockNumber)
+	return nil
+}
+
+// send signs the user operation with the nonce of the account, and sends it to the bundler. It is saved as pending
+// before it is sent, so that its nonce is never reused if saving it fails. Resubmitted user operations replace
+// the previous ones with fees bumped by at least feeBumpPercent.
+//
+// If the bundler or the paymaster rejects a user operation which was never sent, it is marked as failed, and
+// errUserOperationRejected is returned.
+func (s *Sender) send(ctx context.Context, op *UserOperationTx, account common.Address, nonce *big.Int) error {
+	userOp, err := s.buildUserOperation(ctx, op, account, nonce)
+	if err == nil {
+		err = s.sendUserOperation(ctx, op, userOp)
+	}
+	if errors.Is(err, errUserOperationRejected) && op.State == UserOperationUnstarted {
+		reason := err.Error()
+		op.State, op.Error = UserOperationFailed, &reason
+		if uerr := s.orm.UpdateUserOperation(ctx, op); uerr != nil {
+			return fmt.Errorf("failed to save rejected user operation: %w", uerr)
+		}
+	}
+	return err
+}
+
+func (s *Sender) sendUserOperation(ctx context.Context, op *UserOperationTx, userOp *UserOperation) error {
+	hash := userOp.Hash(s.entryPoint, s.chainID)
+	signature, err := s.keystore.SignMessage(ctx, op.Owner, hash[:])
+	if err != nil {
+		return fmt.Errorf("failed to sign user operation: %w", err)
+	}
+	signature[64] += 27
+	userOp.Signature = signature
+
+	previous := *op
+	now := time.Now()
+	if op.Hash != nil {
+		op.PreviousHashes = append(op.PreviousHashes, op.Hash.Bytes())
+	}
+	op.State = UserOperationPending
+	op.Sender = &userOp.Sender
+	op.Nonce = ubig.New(userOp.Nonce.ToInt())
+	op.Hash = &hash
+	op.MaxFeePerGas = ubig.New(userOp.MaxFeePerGas.ToInt())
+	op.MaxPriorityFeePerGas = ubig.New(userOp.MaxPriorityFeePerGas.ToInt())
+	op.BroadcastAt = &now
+	if err = s.orm.UpdateUserOperation(ctx, op); err != nil {
+		*op = previous
+		return fmt.Errorf("failed to save user operation: %w", err)
+	}
+	sentHash, err := s.bundler.SendUserOperation(ctx, userOp, s.entryPoint)
+	var rpcErr rpc.Error
+	if err != nil && !errors.As(err, &rpcErr) {
+		// The bundler may have received the user operation, so it is left pending with its hash, checked for a receipt
+		// and resubmitted at the same nonce once the resend threshold passes
+		s.lggr.Warnw("Failed to reach the bundler, keeping user operation pending", "id", op.ID, "userOpHash", hash,
+			"sender", op.Sender, "nonce", op.Nonce, "err", err)
+		return fmt.Errorf("failed to send user operation: %w", err)
+	}
+	if err != nil {
+		// The bundler rejected the user operation, so the previous one, if any, is still the one pending
+		*op = previous
+		if uerr := s.orm.UpdateUserOperation(ctx, op); uerr != nil {
+			// The user operation is left pending, and resubmitted at the same nonce once the resend threshold passes
+			s.lggr.Errorw("Failed to restore user operation which couldn't be sent", "id", op.ID, "userOpHash", hash, "err", uerr)
+		}
+		return bundlerError("failed to send user operation", err)
+	}
+	if sentHash != hash {
+		s.lggr.Warnw("Bundler returned an unexpected user operation hash", "userOpHash", hash, "bundlerHash", sentHash)
+	}
+	s.lggr.Infow("Sent user operation", "id", op.ID, "userOpHash", hash, "sender", op.Sender, "owner", op.Owner, "nonce", op.Nonce,
+		"to", op.ToAddress, "resubmitted", previous.Hash != nil)
+	return nil
+}
+
+// buildUserOperation returns the unsigned user operation of the account calling the recipient of the transaction
+// request, with its fees and gas set.
+func (s *Sender) buildUserOperation(ctx context.Context, op *UserOperationTx, account common.Address, nonce *big.Int) (*UserOperation, error) {
+	callData, err := accountABI.Pack("execute", op.ToAddress, op.Value.ToInt(), op.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode account call: %w", err)
+	}
+	userOp := &UserOperation{
+		Sender:    account,
+		Nonce:     (*hexutil.Big)(nonce),
+		CallData:  callData,
+		Signature: dummySignature,
+	}
+	if err = s.setFactory(ctx, userOp, op.Owner); err != nil {
+		return nil, err
+	}
+	if err = s.setFees(ctx, userOp, op); err != nil {
+		return nil, err
+	}
+	if err = s.setGas(ctx, userOp); err != nil {
+		return nil, err
+	}
+	return userOp, nil
+}
+
+// bundlerError wraps the error of a bundler or paymaster call with errUserOperationRejected if they rejected the
+// user operation, as opposed to failing to respond.
+func bundlerError(msg string, err error) error {
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) {
+		return fmt.Errorf("%w: %s: %w", errUserOperationRejected, msg, err)
+	}
+	return fmt.Errorf("%s: %w", msg, err)
+}
+
+// AccountAddress returns the address of the smart account owned by the key, whether it is deployed or not.
+func (s *Sender) AccountAddress(ctx context.Context, owner common.Address) (common.Address, error) {
+	s.accountsMu.Lock()
+	account, ok := s.accounts[owner]
+	s.accountsMu.Unlock()
+	if ok {
+		return account, nil
+	}
+
+	data, err := factoryABI.Pack("getAddress", owner, accountSalt)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to encode account address call: %w", err)
+	}
+	res, err := s.client.CallContract(ctx, ethereum.CallMsg{To: &s.factory, Data: data}, nil)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to get account address of %s: %w", owner, err)
+	}
+	out, err := factoryABI.Unpack("getAddress", res)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to decode account address of %s: %w", owner, err)
+	}
+	account = out[0].(common.Address)
+
+	s.accountsMu.Lock()
+	s.accounts[owner] = account
+	s.accountsMu.Unlock()
+	return account, nil
+}
+
+// chainNonce returns the next nonce of the account on chain, which lags behind its user operations in the bundler mempool.
+func (s *Sender) chainNonce(ctx context.Context, account common.Address) (*big.Int, error) {
+	data, err := entryPointABI.Pack("getNonce", account, big.NewInt(0))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode nonce call: %w", err)
+	}
+	res, err := s.client.CallContract(ctx, ethereum.CallMsg{To: &s.entryPoint, Data: data}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get nonce of account %s: %w", account, err)
+	}
+	out, err := entryPointABI.Unpack("getNonce", res)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode nonce of account %s: %w", account, err)
+	}
+	return out[0].(*big.Int), nil
+}
+
+// setFactory sets the factory call creating the account of the user operation, unless it is already deployed.
+func (s *Sender) setFactory(ctx context.Context, op *UserOperation, owner common.Address) error {
+	code, err := s.client.CodeAt(ctx, op.Sender, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get code of account %s: %w", op.Sender, err)
+	}
+	if len(code) > 0 {
+		return nil
+	}
+	// The account is created by the first user operation, so the ones sent before it is included deploy it too
+	// and only the first one to be included does
+	data, err := factoryABI.Pack("createAccount", owner, accountSalt)
+	if err != nil {
+		return fmt.Errorf("failed to encode account creation: %w", err)
+	}
+	factory := s.factory
+	op.Factory = &factory
+	op.FactoryData = data
+	return nil
+}
+
+// setFees sets the gas prices of the user operation, as estimated for the call of the account. The fees of a
+// resubmitted user operation are bumped by at least feeBumpPercent from the previous ones, up to the max gas price.
+func (s *Sender) setFees(ctx context.Context, userOp *UserOperation, op *UserOperationTx) error {
+	maxPrice := s.feeConfig.PriceMaxKey(op.Owner)
+	fee, _, err := s.estimator.GetFee(ctx, op.Data, op.FeeLimit, maxPrice, &userOp.Sender, &op.ToAddress)
+	if err != nil {
+		return fmt.Errorf("failed to estimate user operation fees: %w", err)
+	}
+	var feeCap, tipCap *big.Int
+	if fee.ValidDynamic() {
+		feeCap, tipCap = fee.GasFeeCap.ToInt(), fee.GasTipCap.ToInt()
+	} else if fee.GasPrice != nil {
+		feeCap, tipCap = fee.GasPrice.ToInt(), fee.GasPrice.ToInt()
+	} else {
+		return errors.New("estimator returned no user operation fees")
+	}
+	if op.MaxFeePerGas != nil && op.MaxPriorityFeePerGas != nil {
+		feeCap = bumpFee(feeCap, op.MaxFeePerGas.ToInt(), maxPrice.ToInt())
+		tipCap = bumpFee(tipCap, op.MaxPriorityFeePerGas.ToInt(), maxPrice.ToInt())
+	}
+	userOp.MaxFeePerGas = (*hexutil.Big)(feeCap)
+	userOp.MaxPriorityFeePerGas = (*hexutil.Big)(tipCap)
+	return nil
+}
+
+// bumpFee returns the estimated fee, or the previous fee bumped by feeBumpPercent rounded up if higher, capped to maxFee.
+func bumpFee(estimated, previous, maxFee *big.Int) *big.Int {
+	bumped := new(big.Int).Mul(previous, big.NewInt(100+feeBumpPercent))
+	bumped.Add(bumped, big.NewInt(99)).Div(bumped, big.NewInt(100))
+	if estimated.Cmp(bumped) > 0 {
+		bumped = estimated
+	}
+	if bumped.Cmp(maxFee) > 0 {
+		return new(big.Int).Set(maxFee)
+	}
+	return bumped
+}
+
+// setGas sets the gas limits of the user operation as estimated by the bundler, and its paymaster fields if any.
+func (s *Sender) setGas(ctx context.Context, op *UserOperation) error {
+	if s.paymaster != nil {
+		stub, err := s.paymaster.GetPaymasterStubData(ctx, op, s.entryPoint, s.chainID)
+		if err != nil {
+			return bundlerError("failed to get paymaster stub data", err)
+		}
+		setPaymasterData(op, stub)
+	}
+	estimate, err := s.bundler.EstimateUserOperationGas(ctx, op, s.entryPoint)
+	if err != nil {
+		return bundlerError("failed to estimate user operation gas", err)
+	}
+	op.PreVerificationGas = estimate.PreVerificationGas
+	op.VerificationGasLimit = estimate.VerificationGasLimit
+	op.CallGasLimit = estimate.CallGasLimit
+	if s.paymaster == nil {
+		return nil
+	}
+	if estimate.PaymasterVerificationGasLimit != nil {
+		op.PaymasterVerificationGasLimit = estimate.PaymasterVerificationGasLimit
+	}
+	if estimate.PaymasterPostOpGasLimit != nil {
+		op.PaymasterPostOpGasLimit = estimate.PaymasterPostOpGasLimit
+	}
+	data, err := s.paymaster.GetPaymasterData(ctx, op, s.entryPoint, s.chainID)
+	if err != nil {
+		return bundlerError("failed to get paymaster data", err)
+	}
+	setPaymasterData(op, data)
+	return nil
+}
+
+func setPaymasterData(op *UserOperation, data *PaymasterData) {
+	op.Paymaster = data.Paymaster
+	op.PaymasterData = data.PaymasterData
+	if data.PaymasterVerificationGasLimit != nil {
+		op.PaymasterVerificationGasLimit = data.PaymasterVerificationGasLimit
+	}
+	if data.PaymasterPostOpGasLimit != nil {
+		op.PaymasterPostOpGasLimit = data.PaymasterPostOpGasLimit
+	}
+}
@@ -0,0 +1,547 @@
+package userops
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	txmgrcommon "github.com/smartcontractkit/chainlink-framework/chains/txmgr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	txmmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/evm/client/clienttest"
+	"github.com/smartcontractkit/chainlink/v2/evm/gas"
+	gasmocks "github.com/smartcontractkit/chainlink/v2/evm/gas/mocks"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/evm/types"
+)
+
+var (
+	testEntryPoint = common.HexToAddress("0x0000000071727De22E5E9d8BAf0edAc6f37da032")
+	testFactory    = common.HexToAddress("0x91E60e0613810449d098b0b5Ec8b51A0FE8c8985")
+	testAccount    = common.HexToAddress("0xb856DBD4fA1A79a46D426f537455e7d3E79ab7c4")
+	testPaymaster  = common.HexToAddress("0x0000000000325602a77416A16136FDafd04b299f")
+	testChainID    = big.NewInt(1337)
+)
+
+// bundlerStub is a local bundler and paymaster service, served over JSON-RPC.
+type bundlerStub struct {
+	mu          sync.Mutex
+	sent        []UserOperation
+	receipts    map[common.Hash]*Receipt
+	reject      string      // reason to reject the user operations sent with, if any
+	unavailable atomic.Bool // whether the user operations sent fail with an HTTP error instead of a JSON-RPC one
+}
+
+func (b *bundlerStub) SendUserOperation(op UserOperation, entryPoint common.Address) (common.Hash, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.reject != "" {
+		return common.Hash{}, errors.New(b.reject)
+	}
+	b.sent = append(b.sent, op)
+	return op.Hash(entryPoint, testChainID), nil
+}
+
+func (b *bundlerStub) EstimateUserOperationGas(op UserOperation, entryPoint common.Address) (*GasEstimate, error) {
+	return &GasEstimate{
+		PreVerificationGas:            (*hexutil.Big)(big.NewInt(50_000)),
+		VerificationGasLimit:          (*hexutil.Big)(big.NewInt(400_000)),
+		CallGasLimit:                  (*hexutil.Big)(big.NewInt(100_000)),
+		PaymasterVerificationGasLimit: (*hexutil.Big)(big.NewInt(30_000)),
+	}, nil
+}
+
+func (b *bundlerStub) GetUserOperationReceipt(hash common.Hash) (*Receipt, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.receipts[hash], nil
+}
+
+func (b *bundlerStub) GetPaymasterStubData(op UserOperation, entryPoint common.Address, chainID hexutil.Big, _ map[string]any) (*PaymasterData, error) {
+	paymaster := testPaymaster
+	return &PaymasterData{Paymaster: &paymaster, PaymasterData: hexutil.MustDecode("0xff"), PaymasterPostOpGasLimit: (*hexutil.Big)(big.NewInt(10_000))}, nil
+}
+
+func (b *bundlerStub) GetPaymasterData(op UserOperation, entryPoint common.Address, chainID hexutil.Big, _ map[string]any) (*PaymasterData, error) {
+	paymaster := testPaymaster
+	return &PaymasterData{Paymaster: &paymaster, PaymasterData: hexutil.MustDecode("0x01")}, nil
+}
+
+func (b *bundlerStub) sentOps() []UserOperation {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]UserOperation{}, b.sent...)
+}
+
+func (b *bundlerStub) setReceipt(hash common.Hash, success bool, blockNumber int64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	receipt := &Receipt{UserOpHash: hash, Success: success}
+	if !success {
+		receipt.Reason = "0x08c379a0"
+	}
+	receipt.Receipt.BlockNumber = (*hexutil.Big)(big.NewInt(blockNumber))
+	b.receipts[hash] = receipt
+}
+
+func (b *bundlerStub) setReject(reason string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.reject = reason
+}
+
+func newBundlerStub(t *testing.T) (*bundlerStub, string) {
+	stub := &bundlerStub{receipts: make(map[common.Hash]*Receipt)}
+	server := rpc.NewServer()
+	require.NoError(t, server.RegisterName("eth", stub))
+	require.NoError(t, server.RegisterName("pm", stub))
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if stub.unavailable.Load() && bytes.Contains(body, []byte("eth_sendUserOperation")) {
+			http.Error(w, "bundler unavailable", http.StatusBadGateway)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		server.ServeHTTP(w, r)
+	}))
+	t.Cleanup(func() {
+		ts.Close()
+		server.Stop()
+	})
+	return stub, ts.URL
+}
+
+type keySigner struct{ key *ecdsa.PrivateKey }
+
+func (k keySigner) SignMessage(_ context.Context, _ common.Address, message []byte) ([]byte, error) {
+	return crypto.Sign(accounts.TextHash(message), k.key)
+}
+
+type feeConfig struct{}
+
+func (feeConfig) PriceMaxKey(common.Address) *assets.Wei { return assets.GWei(100) }
+
+type headTracker struct{ finalized int64 }
+
+func (h headTracker) LatestAndFinalizedBlock(context.Context) (*evmtypes.Head, *evmtypes.Head, error) {
+	return &evmtypes.Head{Number: h.finalized + 10}, &evmtypes.Head{Number: h.finalized}, nil
+}
+
+type memoryORM struct {
+	mu  sync.Mutex
+	ops []UserOperationTx
+}
+
+func (o *memoryORM) InsertUserOperation(_ context.Context, op *UserOperationTx) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	op.ID = int64(len(o.ops) + 1)
+	op.CreatedAt = time.Now()
+	o.ops = append(o.ops, *op)
+	return nil
+}
+
+func (o *memoryORM) UpdateUserOperation(_ context.Context, op *UserOperationTx) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.ops[op.ID-1] = *op
+	return nil
+}
+
+func (o *memoryORM) FindUserOperationByIdempotencyKey(_ context.Context, _ *big.Int, idempotencyKey string) (*UserOperationTx, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for _, op := range o.ops {
+		if op.IdempotencyKey == idempotencyKey {
+			return &op, nil
+		}
+	}
+	return nil, nil
+}
+
+func (o *memoryORM) FindUserOperationsByState(_ context.Context, _ *big.Int, state UserOperationState) ([]*UserOperationTx, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	var ops []*UserOperationTx
+	for _, op := range o.ops {
+		if op.State == state {
+			ops = append(ops, &op)
+		}
+	}
+	return ops, nil
+}
+
+func (o *memoryORM) get(t *testing.T, idempotencyKey string) UserOperationTx {
+	op, err := o.FindUserOperationByIdempotencyKey(context.Background(), testChainID, idempotencyKey)
+	require.NoError(t, err)
+	require.NotNil(t, op)
+	return *op
+}
+
+func (o *memoryORM) setBroadcastAt(id int64, broadcastAt time.Time) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.ops[id-1].BroadcastAt = &broadcastAt
+}
+
+// testSender is a Sender through a local bundler stub, with a chain on which the account is deployed after its
+// first user operation, and whose nonce is set by the test.
+type testSender struct {
+	*Sender
+	stub       *bundlerStub
+	orm        *memoryORM
+	owner      common.Address
+	chainNonce atomic.Int64
+}
+
+func newTestSender(t *testing.T) *testSender {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	ts := &testSender{owner: crypto.PubkeyToAddress(key.PublicKey), orm: &memoryORM{}}
+
+	var url string
+	ts.stub, url = newBundlerStub(t)
+	bundler, err := NewBundlerClient(url)
+	require.NoError(t, err)
+	paymaster, err := NewPaymasterClient(url)
+	require.NoError(t, err)
+
+	client := clienttest.NewClient(t)
+	client.On("CallContract", mock.Anything, mock.MatchedBy(func(msg ethereum.CallMsg) bool { return *msg.To == testFactory }), mock.Anything).
+		Return(common.LeftPadBytes(testAccount.Bytes(), 32), nil).Once()
+	client.On("CallContract", mock.Anything, mock.MatchedBy(func(msg ethereum.CallMsg) bool { return *msg.To == testEntryPoint }), mock.Anything).
+		Return(func(context.Context, ethereum.CallMsg, *big.Int) ([]byte, error) {
+			return common.LeftPadBytes(big.NewInt(ts.chainNonce.Load()).Bytes(), 32), nil
+		}).Maybe()
+	client.On("CodeAt", mock.Anything, testAccount, mock.Anything).Return(nil, nil).Once()
+	client.On("CodeAt", mock.Anything, testAccount, mock.Anything).Return([]byte{1}, nil).Maybe()
+
+	estimator := gasmocks.NewEvmFeeEstimator(t)
+	fee := gas.EvmFee{DynamicFee: gas.DynamicFee{GasTipCap: assets.GWei(1), GasFeeCap: assets.GWei(10)}}
+	estimator.On("GetFee", mock.Anything, mock.Anything, mock.Anything, assets.GWei(100), &testAccount, mock.Anything).Return(fee, uint64(0), nil).Maybe()
+
+	ts.Sender = NewSender(logger.TestLogger(t), testChainID, testEntryPoint, testFactory, client, keySigner{key}, estimator,
+		feeConfig{}, headTracker{finalized: 100}, bundler, paymaster, ts.orm, time.Minute)
+	return ts
+}
+
+func TestSender(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+	sender := newTestSender(t)
+	to := common.HexToAddress("0x5431F5F973781809D18643b87B44921b11355d81")
+	key := "job-run-1"
+	txRequest := txmgr.TxRequest{FromAddress: sender.owner, ToAddress: to, EncodedPayload: []byte{1, 2, 3}, Value: *big.NewInt(5), FeeLimit: 100_000, IdempotencyKey: &key}
+
+	queued, err := sender.Enqueue(ctx, txRequest)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), queued.ID)
+	assert.Equal(t, UserOperationUnstarted, queued.State)
+	assert.Equal(t, sender.owner, queued.Owner)
+	assert.Empty(t, sender.stub.sentOps(), "user operations are sent by the queue loop")
+
+	require.NoError(t, sender.processQueue(ctx))
+	sent := sender.orm.get(t, key)
+	assert.Equal(t, UserOperationPending, sent.State)
+	require.NotNil(t, sent.Sender)
+	assert.Equal(t, testAccount, *sent.Sender)
+	assert.Equal(t, int64(0), sent.Nonce.Int64())
+
+	ops := sender.stub.sentOps()
+	require.Len(t, ops, 1)
+	op := ops[0]
+	assert.Equal(t, *sent.Hash, op.Hash(testEntryPoint, testChainID))
+	assert.Equal(t, testAccount, op.Sender)
+	require.NotNil(t, op.Factory, "first user operation must deploy the account")
+	assert.Equal(t, testFactory, *op.Factory)
+	assert.Equal(t, big.NewInt(10_000_000_000), op.MaxFeePerGas.ToInt())
+	assert.Equal(t, big.NewInt(1_000_000_000), op.MaxPriorityFeePerGas.ToInt())
+	assert.Equal(t, big.NewInt(100_000), op.CallGasLimit.ToInt())
+	require.NotNil(t, op.Paymaster)
+	assert.Equal(t, testPaymaster, *op.Paymaster)
+	assert.Equal(t, hexutil.Bytes{1}, op.PaymasterData)
+	assert.Equal(t, big.NewInt(30_000), op.PaymasterVerificationGasLimit.ToInt())
+	assert.Equal(t, big.NewInt(10_000), op.PaymasterPostOpGasLimit.ToInt())
+
+	args, err := accountABI.Methods["execute"].Inputs.Unpack(op.CallData[4:])
+	require.NoError(t, err)
+	assert.Equal(t, []any{to, big.NewInt(5), []byte{1, 2, 3}}, args)
+
+	// The account validates the EIP-191 signature of the hash by its owner
+	sig := append([]byte{}, op.Signature...)
+	require.Len(t, sig, 65)
+	sig[64] -= 27
+	pub, err := crypto.SigToPub(accounts.TextHash(sent.Hash[:]), sig)
+	require.NoError(t, err)
+	assert.Equal(t, sender.owner, crypto.PubkeyToAddress(*pub))
+
+	t.Run("returns the user operation queued for the idempotency key", func(t *testing.T) {
+		existing, err := sender.Enqueue(ctx, txRequest)
+		require.NoError(t, err)
+		assert.Equal(t, sent.ID, existing.ID)
+		require.NoError(t, sender.processQueue(ctx))
+		assert.Len(t, sender.stub.sentOps(), 1)
+	})
+
+	t.Run("sends the next user operations of the account at the next nonces", func(t *testing.T) {
+		next := txRequest
+		next.IdempotencyKey = nil
+		first, err := sender.Enqueue(ctx, next)
+		require.NoError(t, err)
+		assert.NotEmpty(t, first.IdempotencyKey, "idempotency key must be generated")
+		second, err := sender.Enqueue(ctx, next)
+		require.NoError(t, err)
+		assert.NotEqual(t, first.IdempotencyKey, second.IdempotencyKey)
+
+		require.NoError(t, sender.processQueue(ctx))
+		assert.Equal(t, int64(1), sender.orm.get(t, first.IdempotencyKey).Nonce.Int64())
+		assert.Equal(t, int64(2), sender.orm.get(t, second.IdempotencyKey).Nonce.Int64())
+		ops := sender.stub.sentOps()
+		require.Len(t, ops, 3)
+		assert.Nil(t, ops[1].Factory, "deployed account must not be created again")
+	})
+
+	t.Run("waits for pending user operations to be included", func(t *testing.T) {
+		next := txRequest
+		next.IdempotencyKey = nil
+		for range maxPendingPerAccount {
+			_, err := sender.Enqueue(ctx, next)
+			require.NoError(t, err)
+		}
+		require.NoError(t, sender.processQueue(ctx))
+		assert.Len(t, sender.stub.sentOps(), maxPendingPerAccount, "accounts have at most maxPendingPerAccount user operations pending")
+
+		sender.chainNonce.Store(3)
+		require.NoError(t, sender.processQueue(ctx))
+		assert.Len(t, sender.stub.sentOps(), 3+maxPendingPerAccount)
+	})
+}
+
+func TestSender_Resubmit(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+	sender := newTestSender(t)
+	key := "job-run-1"
+	_, err := sender.Enqueue(ctx, txmgr.TxRequest{FromAddress: sender.owner, IdempotencyKey: &key})
+	require.NoError(t, err)
+	require.NoError(t, sender.processQueue(ctx))
+	first := sender.orm.get(t, key)
+
+	// Not resubmitted before the resend threshold
+	require.NoError(t, sender.processQueue(ctx))
+	require.Len(t, sender.stub.sentOps(), 1)
+
+	// Replaced at the same nonce with bumped fees once dropped
+	sender.orm.setBroadcastAt(first.ID, time.Now().Add(-2*time.Minute))
+	require.NoError(t, sender.processQueue(ctx))
+	ops := sender.stub.sentOps()
+	require.Len(t, ops, 2)
+	assert.Equal(t, int64(0), ops[1].Nonce.ToInt().Int64())
+	assert.Equal(t, big.NewInt(11_000_000_000), ops[1].MaxFeePerGas.ToInt())
+	assert.Equal(t, big.NewInt(1_100_000_000), ops[1].MaxPriorityFeePerGas.ToInt())
+	resubmitted := sender.orm.get(t, key)
+	assert.Equal(t, UserOperationPending, resubmitted.State)
+	assert.Equal(t, ops[1].Hash(testEntryPoint, testChainID), *resubmitted.Hash)
+	assert.Equal(t, []common.Hash{*first.Hash, *resubmitted.Hash}, resubmitted.hashes())
+
+	// Included with its previous hash
+	sender.chainNonce.Store(1)
+	sender.stub.setReceipt(*first.Hash, true, 105)
+	require.NoError(t, sender.processQueue(ctx))
+	included := sender.orm.get(t, key)
+	assert.Equal(t, UserOperationIncluded, included.State)
+	assert.Equal(t, *first.Hash, *included.Hash)
+	require.NotNil(t, included.BlockNumber)
+	assert.Equal(t, int64(105), *included.BlockNumber)
+}
+
+func TestSender_Rejected(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+	sender := newTestSender(t)
+	rejected, accepted := "job-run-1", "job-run-2"
+
+	sender.stub.setReject("AA21 didn't pay prefund")
+	_, err := sender.Enqueue(ctx, txmgr.TxRequest{FromAddress: sender.owner, IdempotencyKey: &rejected})
+	require.NoError(t, err)
+	require.NoError(t, sender.processQueue(ctx))
+	op := sender.orm.get(t, rejected)
+	assert.Equal(t, UserOperationFailed, op.State)
+	assert.Nil(t, op.Nonce)
+	require.NotNil(t, op.Error)
+	assert.Contains(t, *op.Error, "AA21 didn't pay prefund")
+	status, err := sender.Status(ctx, rejected)
+	require.ErrorContains(t, err, "AA21 didn't pay prefund")
+	assert.Equal(t, commontypes.Failed, status)
+
+	// The nonce of the rejected user operation is used by the next one
+	sender.stub.setReject("")
+	_, err = sender.Enqueue(ctx, txmgr.TxRequest{FromAddress: sender.owner, IdempotencyKey: &accepted})
+	require.NoError(t, err)
+	require.NoError(t, sender.processQueue(ctx))
+	op = sender.orm.get(t, accepted)
+	assert.Equal(t, UserOperationPending, op.State)
+	assert.Equal(t, int64(0), op.Nonce.Int64())
+}
+
+func TestSender_BundlerUnavailable(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+	sender := newTestSender(t)
+	key := "job-run-1"
+
+	// The bundler may have received the user operation, so it is kept pending with its hash
+	sender.stub.unavailable.Store(true)
+	_, err := sender.Enqueue(ctx, txmgr.TxRequest{FromAddress: sender.owner, IdempotencyKey: &key})
+	require.NoError(t, err)
+	require.NoError(t, sender.processQueue(ctx))
+	first := sender.orm.get(t, key)
+	assert.Equal(t, UserOperationPending, first.State)
+	require.NotNil(t, first.Hash)
+	assert.Equal(t, int64(0), first.Nonce.Int64())
+	assert.Empty(t, sender.stub.sentOps())
+
+	// Resubmitted at the same nonce once the resend threshold passes, keeping the hash it was first sent with
+	sender.stub.unavailable.Store(false)
+	sender.orm.setBroadcastAt(first.ID, time.Now().Add(-2*time.Minute))
+	require.NoError(t, sender.processQueue(ctx))
+	ops := sender.stub.sentOps()
+	require.Len(t, ops, 1)
+	assert.Equal(t, int64(0), ops[0].Nonce.ToInt().Int64())
+	resubmitted := sender.orm.get(t, key)
+	assert.Equal(t, []common.Hash{*first.Hash, ops[0].Hash(testEntryPoint, testChainID)}, resubmitted.hashes())
+
+	// The bundler rejecting the resubmission leaves the previous one pending
+	sender.stub.setReject("AA25 invalid account nonce")
+	sender.orm.setBroadcastAt(first.ID, time.Now().Add(-2*time.Minute))
+	require.NoError(t, sender.processQueue(ctx))
+	restored := sender.orm.get(t, key)
+	assert.Equal(t, resubmitted.hashes(), restored.hashes())
+}
+
+func TestSender_NonceUsedWithoutReceipt(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+	sender := newTestSender(t)
+	key := "job-run-1"
+	_, err := sender.Enqueue(ctx, txmgr.TxRequest{FromAddress: sender.owner, IdempotencyKey: &key})
+	require.NoError(t, err)
+	require.NoError(t, sender.processQueue(ctx))
+	op := sender.orm.get(t, key)
+
+	// The bundler may not have indexed the receipt yet
+	sender.chainNonce.Store(1)
+	require.NoError(t, sender.processQueue(ctx))
+	assert.Equal(t, UserOperationPending, sender.orm.get(t, key).State)
+	require.Contains(t, sender.nonceUsedAt, op.ID)
+
+	sender.nonceUsedAt[op.ID] = time.Now().Add(-receiptTimeout)
+	require.NoError(t, sender.processQueue(ctx))
+	failed := sender.orm.get(t, key)
+	assert.Equal(t, UserOperationFailed, failed.State)
+	require.NotNil(t, failed.Error)
+	assert.Contains(t, *failed.Error, "none of the hashes of the user operation has a receipt")
+	assert.NotContains(t, sender.nonceUsedAt, op.ID)
+}
+
+func TestTxManager(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+	sender := newTestSender(t)
+	txm := txmmocks.NewMockEvmTxManager(t)
+	userOpsTxm := NewTxManager(txm, sender.Sender)
+
+	t.Run("queues transactions with the user operation strategy", func(t *testing.T) {
+		key := "job-run-1"
+		tx, err := userOpsTxm.CreateTransaction(ctx, txmgr.TxRequest{FromAddress: sender.owner, IdempotencyKey: &key, Strategy: NewStrategy()})
+		require.NoError(t, err)
+		assert.Zero(t, tx.ID, "user operations aren't transactions of the wrapped txm")
+		assert.Equal(t, &key, tx.IdempotencyKey)
+		assert.Equal(t, sender.owner, tx.FromAddress)
+		assert.Equal(t, txmgrcommon.TxUnstarted, tx.State)
+
+		status, err := userOpsTxm.GetTransactionStatus(ctx, key)
+		require.NoError(t, err)
+		assert.Equal(t, commontypes.Pending, status)
+
+		require.NoError(t, sender.processQueue(ctx))
+		status, err = userOpsTxm.GetTransactionStatus(ctx, key)
+		require.NoError(t, err)
+		assert.Equal(t, commontypes.Pending, status)
+
+		sender.chainNonce.Store(1)
+		sender.stub.setReceipt(*sender.orm.get(t, key).Hash, true, 105)
+		require.NoError(t, sender.processQueue(ctx))
+		status, err = userOpsTxm.GetTransactionStatus(ctx, key)
+		require.NoError(t, err)
+		assert.Equal(t, commontypes.Unconfirmed, status)
+
+		sender.headTracker = headTracker{finalized: 105}
+		status, err = userOpsTxm.GetTransactionStatus(ctx, key)
+		require.NoError(t, err)
+		assert.Equal(t, commontypes.Finalized, status)
+	})
+
+	t.Run("reports reverted user operations as failed", func(t *testing.T) {
+		tx, err := userOpsTxm.CreateTransaction(ctx, txmgr.TxRequest{FromAddress: sender.owner, Strategy: NewStrategy()})
+		require.NoError(t, err)
+		require.NotNil(t, tx.IdempotencyKey, "idempotency key must be generated to track the user operation")
+
+		require.NoError(t, sender.processQueue(ctx))
+		sender.chainNonce.Store(2)
+		sender.stub.setReceipt(*sender.orm.get(t, *tx.IdempotencyKey).Hash, false, 106)
+		require.NoError(t, sender.processQueue(ctx))
+		status, err := userOpsTxm.GetTransactionStatus(ctx, *tx.IdempotencyKey)
+		require.ErrorContains(t, err, "reverted")
+		assert.Equal(t, commontypes.Failed, status)
+	})
+
+	t.Run("leaves other transactions to the wrapped txm", func(t *testing.T) {
+		key := "job-run-2"
+		txRequest := txmgr.TxRequest{FromAddress: sender.owner, IdempotencyKey: &key, Strategy: txmgrcommon.NewSendEveryStrategy()}
+		txm.On("CreateTransaction", mock.Anything, txRequest).Return(txmgr.Tx{ID: 42}, nil).Once()
+		txm.On("GetTransactionStatus", mock.Anything, key).Return(commontypes.Pending, nil).Once()
+
+		tx, err := userOpsTxm.CreateTransaction(ctx, txRequest)
+		require.NoError(t, err)
+		assert.Equal(t, int64(42), tx.ID)
+		status, err := userOpsTxm.GetTransactionStatus(ctx, key)
+		require.NoError(t, err)
+		assert.Equal(t, commontypes.Pending, status)
+		assert.Len(t, sender.stub.sentOps(), 2)
+	})
+}
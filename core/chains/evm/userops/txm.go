@@ -0,0 +1,141 @@
+package userops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/google/uuid"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	txmgrcommon "github.com/smartcontractkit/chainlink-framework/chains/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink-framework/chains/txmgr/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+)
+
+var _ txmgrtypes.TxStrategy = Strategy{}
+
+// NewStrategy creates a new TxStrategy sending transactions as user operations through the bundler, when account
+// abstraction is enabled for the chain. Otherwise, transactions are sent as usual.
+func NewStrategy() txmgrtypes.TxStrategy {
+	return Strategy{}
+}
+
+// Strategy sends transactions as user operations. Their queue isn't pruned, as the sender sends them in order.
+type Strategy struct{}
+
+func (Strategy) Subject() uuid.NullUUID { return uuid.NullUUID{} }
+func (Strategy) PruneQueue(ctx context.Context, pruneService txmgrtypes.UnstartedTxQueuePruner) ([]int64, error) {
+	return nil, nil
+}
+
+// IsUserOperation reports whether the transaction request is to be sent as a user operation.
+func IsUserOperation(txRequest txmgr.TxRequest) bool {
+	_, ok := txRequest.Strategy.(Strategy)
+	return ok
+}
+
+type txManager struct {
+	txmgr.TxManager
+	sender *Sender
+}
+
+// NewTxManager wraps the transaction manager to send the transaction requests with the user operation strategy
+// through the sender. The other transactions are left to the wrapped transaction manager.
+func NewTxManager(txm txmgr.TxManager, sender *Sender) txmgr.TxManager {
+	return &txManager{TxManager: txm, sender: sender}
+}
+
+func (t *txManager) Start(ctx context.Context) error {
+	if err := t.TxManager.Start(ctx); err != nil {
+		return err
+	}
+	return t.sender.Start(ctx)
+}
+
+func (t *txManager) Close() error {
+	return errors.Join(t.sender.Close(), t.TxManager.Close())
+}
+
+func (t *txManager) HealthReport() map[string]error {
+	report := t.TxManager.HealthReport()
+	services.CopyHealth(report, t.sender.HealthReport())
+	return report
+}
+
+// CreateTransaction queues the request to be sent as a user operation if it uses the user operation strategy. The
+// returned transaction has no ID, as it isn't a transaction of the wrapped transaction manager: its status is
+// tracked by its idempotency key, which is generated if the request has none.
+func (t *txManager) CreateTransaction(ctx context.Context, txRequest txmgr.TxRequest) (txmgr.Tx, error) {
+	if !IsUserOperation(txRequest) {
+		return t.TxManager.CreateTransaction(ctx, txRequest)
+	}
+	op, err := t.sender.Enqueue(ctx, txRequest)
+	if err != nil {
+		return txmgr.Tx{}, fmt.Errorf("CreateTransaction failed to queue user operation: %w", err)
+	}
+	return txmgr.Tx{
+		IdempotencyKey: &op.IdempotencyKey,
+		FromAddress:    op.Owner,
+		ToAddress:      op.ToAddress,
+		EncodedPayload: op.Data,
+		Value:          *op.Value.ToInt(),
+		FeeLimit:       op.FeeLimit,
+		CreatedAt:      op.CreatedAt,
+		State:          txmgrcommon.TxUnstarted,
+		ChainID:        op.EVMChainID.ToInt(),
+	}, nil
+}
+
+// GetTransactionStatus returns the status of the user operation queued for the idempotency key, or else of the
+// transaction of the wrapped transaction manager.
+func (t *txManager) GetTransactionStatus(ctx context.Context, transactionID string) (commontypes.TransactionStatus, error) {
+	status, err := t.sender.Status(ctx, transactionID)
+	if errors.Is(err, ErrUserOperationNotFound) {
+		return t.TxManager.GetTransactionStatus(ctx, transactionID)
+	}
+	return status, err
+}
+
+// CancelTransaction cancels the transaction of the wrapped transaction manager.
+func (t *txManager) CancelTransaction(ctx context.Context, etxID int64) (txmgr.Tx, error) {
+	replacer, ok := t.TxManager.(txmgr.TxReplacer)
+	if !ok {
+		return txmgr.Tx{}, errors.New("transaction manager can't replace transactions")
+	}
+	return replacer.CancelTransaction(ctx, etxID)
+}
+
+// SpeedUpTransaction speeds up the transaction of the wrapped transaction manager.
+func (t *txManager) SpeedUpTransaction(ctx context.Context, etxID int64) (txmgr.Tx, error) {
+	replacer, ok := t.TxManager.(txmgr.TxReplacer)
+	if !ok {
+		return txmgr.Tx{}, errors.New("transaction manager can't replace transactions")
+	}
+	return replacer.SpeedUpTransaction(ctx, etxID)
+}
+
+// HasUnconfirmedTransfer looks up the unconfirmed transfers of the wrapped transaction manager, as transfers aren't
+// sent as user operations.
+func (t *txManager) HasUnconfirmedTransfer(ctx context.Context, fromAddress, toAddress common.Address) (bool, error) {
+	transfers, ok := t.TxManager.(txmgr.TransferFinder)
+	if !ok {
+		return false, errors.New("transaction manager can't find unconfirmed transfers")
+	}
+	return transfers.HasUnconfirmedTransfer(ctx, fromAddress, toAddress)
+}
+
+// CreateBlobTransaction queues the blob transaction with the wrapped transaction manager, as blob transactions
+// can't be sent as user operations.
+func (t *txManager) CreateBlobTransaction(ctx context.Context, txRequest txmgr.TxRequest, sidecar *gethtypes.BlobTxSidecar) (txmgr.Tx, error) {
+	blobs, ok := t.TxManager.(txmgr.BlobTxManager)
+	if !ok {
+		return txmgr.Tx{}, errors.New("transaction manager can't send blob transactions")
+	}
+	return blobs.CreateBlobTransaction(ctx, txRequest, sidecar)
+}
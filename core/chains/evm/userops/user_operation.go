@@ -0,0 +1,79 @@
+package userops
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// UserOperation is an ERC-4337 v0.7 user operation, in the unpacked form used by bundler and paymaster RPCs.
+type UserOperation struct {
+	Sender                        common.Address  `json:"sender"`
+	Nonce                         *hexutil.Big    `json:"nonce"`
+	Factory                       *common.Address `json:"factory,omitempty"`
+	FactoryData                   hexutil.Bytes   `json:"factoryData,omitempty"`
+	CallData                      hexutil.Bytes   `json:"callData"`
+	CallGasLimit                  *hexutil.Big    `json:"callGasLimit"`
+	VerificationGasLimit          *hexutil.Big    `json:"verificationGasLimit"`
+	PreVerificationGas            *hexutil.Big    `json:"preVerificationGas"`
+	MaxFeePerGas                  *hexutil.Big    `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas          *hexutil.Big    `json:"maxPriorityFeePerGas"`
+	Paymaster                     *common.Address `json:"paymaster,omitempty"`
+	PaymasterVerificationGasLimit *hexutil.Big    `json:"paymasterVerificationGasLimit,omitempty"`
+	PaymasterPostOpGasLimit       *hexutil.Big    `json:"paymasterPostOpGasLimit,omitempty"`
+	PaymasterData                 hexutil.Bytes   `json:"paymasterData,omitempty"`
+	Signature                     hexutil.Bytes   `json:"signature"`
+}
+
+// Hash returns the hash of the user operation signed by the owner of its account, as computed by the
+// EntryPoint contract.
+func (op *UserOperation) Hash(entryPoint common.Address, chainID *big.Int) common.Hash {
+	packed := crypto.Keccak256(
+		word(op.Sender.Bytes()),
+		word(toBig(op.Nonce).Bytes()),
+		crypto.Keccak256(op.initCode()),
+		crypto.Keccak256(op.CallData),
+		packUint128s(op.VerificationGasLimit, op.CallGasLimit),
+		word(toBig(op.PreVerificationGas).Bytes()),
+		packUint128s(op.MaxPriorityFeePerGas, op.MaxFeePerGas),
+		crypto.Keccak256(op.paymasterAndData()),
+	)
+	return crypto.Keccak256Hash(packed, word(entryPoint.Bytes()), word(chainID.Bytes()))
+}
+
+// initCode returns the factory address followed by the factory data, or nothing if the account is deployed.
+func (op *UserOperation) initCode() []byte {
+	if op.Factory == nil {
+		return nil
+	}
+	return append(op.Factory.Bytes(), op.FactoryData...)
+}
+
+// paymasterAndData returns the paymaster address, its gas limits and data, or nothing without paymaster.
+func (op *UserOperation) paymasterAndData() []byte {
+	if op.Paymaster == nil {
+		return nil
+	}
+	b := op.Paymaster.Bytes()
+	b = append(b, common.LeftPadBytes(toBig(op.PaymasterVerificationGasLimit).Bytes(), 16)...)
+	b = append(b, common.LeftPadBytes(toBig(op.PaymasterPostOpGasLimit).Bytes(), 16)...)
+	return append(b, op.PaymasterData...)
+}
+
+// packUint128s packs two uint128 values in a 32 bytes word, the first one in the high bytes.
+func packUint128s(high, low *hexutil.Big) []byte {
+	return append(common.LeftPadBytes(toBig(high).Bytes(), 16), common.LeftPadBytes(toBig(low).Bytes(), 16)...)
+}
+
+func word(b []byte) []byte {
+	return common.LeftPadBytes(b, 32)
+}
+
+func toBig(b *hexutil.Big) *big.Int {
+	if b == nil {
+		return new(big.Int)
+	}
+	return b.ToInt()
+}
@@ -0,0 +1,97 @@
+package userops
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// packedHash computes the user operation hash with the ABI encoding of the EntryPoint v0.7 contract.
+func packedHash(t *testing.T, op *UserOperation, entryPoint common.Address, chainID *big.Int) common.Hash {
+	newType := func(name string) abi.Type {
+		typ, err := abi.NewType(name, "", nil)
+		require.NoError(t, err)
+		return typ
+	}
+	address, uint256, bytes32 := newType("address"), newType("uint256"), newType("bytes32")
+	pack := func(high, low *hexutil.Big) [32]byte {
+		return [32]byte(new(big.Int).Or(new(big.Int).Lsh(high.ToInt(), 128), low.ToInt()).FillBytes(make([]byte, 32)))
+	}
+
+	var initCode, paymasterAndData []byte
+	if op.Factory != nil {
+		initCode = append(op.Factory.Bytes(), op.FactoryData...)
+	}
+	if op.Paymaster != nil {
+		paymasterAndData = op.Paymaster.Bytes()
+		paymasterAndData = append(paymasterAndData, common.LeftPadBytes(op.PaymasterVerificationGasLimit.ToInt().Bytes(), 16)...)
+		paymasterAndData = append(paymasterAndData, common.LeftPadBytes(op.PaymasterPostOpGasLimit.ToInt().Bytes(), 16)...)
+		paymasterAndData = append(paymasterAndData, op.PaymasterData...)
+	}
+
+	packed, err := abi.Arguments{{Type: address}, {Type: uint256}, {Type: bytes32}, {Type: bytes32}, {Type: bytes32}, {Type: uint256}, {Type: bytes32}, {Type: bytes32}}.Pack(
+		op.Sender,
+		op.Nonce.ToInt(),
+		crypto.Keccak256Hash(initCode),
+		crypto.Keccak256Hash(op.CallData),
+		pack(op.VerificationGasLimit, op.CallGasLimit),
+		op.PreVerificationGas.ToInt(),
+		pack(op.MaxPriorityFeePerGas, op.MaxFeePerGas),
+		crypto.Keccak256Hash(paymasterAndData),
+	)
+	require.NoError(t, err)
+	encoded, err := abi.Arguments{{Type: bytes32}, {Type: address}, {Type: uint256}}.Pack(crypto.Keccak256Hash(packed), entryPoint, chainID)
+	require.NoError(t, err)
+	return crypto.Keccak256Hash(encoded)
+}
+
+func TestUserOperation_Hash(t *testing.T) {
+	t.Parallel()
+
+	entryPoint := common.HexToAddress("0x0000000071727De22E5E9d8BAf0edAc6f37da032")
+	factory := common.HexToAddress("0x91E60e0613810449d098b0b5Ec8b51A0FE8c8985")
+	paymaster := common.HexToAddress("0x0000000000325602a77416A16136FDafd04b299f")
+	chainID := big.NewInt(11155111)
+	newBig := func(i int64) *hexutil.Big { return (*hexutil.Big)(big.NewInt(i)) }
+
+	op := &UserOperation{
+		Sender:                        common.HexToAddress("0xb856DBD4fA1A79a46D426f537455e7d3E79ab7c4"),
+		Nonce:                         newBig(7),
+		Factory:                       &factory,
+		FactoryData:                   hexutil.MustDecode("0x5fbfb9cf"),
+		CallData:                      hexutil.MustDecode("0xb61d27f6"),
+		CallGasLimit:                  newBig(100_000),
+		VerificationGasLimit:          newBig(500_000),
+		PreVerificationGas:            newBig(50_000),
+		MaxFeePerGas:                  newBig(30_000_000_000),
+		MaxPriorityFeePerGas:          newBig(1_000_000_000),
+		Paymaster:                     &paymaster,
+		PaymasterVerificationGasLimit: newBig(60_000),
+		PaymasterPostOpGasLimit:       newBig(10_000),
+		PaymasterData:                 hexutil.MustDecode("0x01020304"),
+	}
+	assert.Equal(t, packedHash(t, op, entryPoint, chainID), op.Hash(entryPoint, chainID))
+
+	t.Run("without factory and paymaster", func(t *testing.T) {
+		deployed := *op
+		deployed.Factory, deployed.FactoryData = nil, nil
+		deployed.Paymaster, deployed.PaymasterData = nil, nil
+
+		hash := deployed.Hash(entryPoint, chainID)
+		assert.Equal(t, packedHash(t, &deployed, entryPoint, chainID), hash)
+		assert.NotEqual(t, op.Hash(entryPoint, chainID), hash)
+	})
+
+	t.Run("depends on the signed fields only", func(t *testing.T) {
+		signed := *op
+		signed.Signature = dummySignature
+		assert.Equal(t, op.Hash(entryPoint, chainID), signed.Hash(entryPoint, chainID))
+		assert.NotEqual(t, op.Hash(entryPoint, chainID), op.Hash(entryPoint, big.NewInt(1)))
+	})
+}
@@ -288,7 +288,9 @@ func newChain(ctx context.Context, cfg *config.ChainScoped, nodes []*toml.Node,
 
 	var balanceMonitor monitor.BalanceMonitor
 	if opts.AppConfig.EVMRPCEnabled() && cfg.EVM().BalanceMonitor().Enabled() {
-		balanceMonitor = monitor.NewBalanceMonitor(cl, opts.KeyStore, l)
+		// keys are only topped up by transaction managers which can find unconfirmed transfers
+		sender, _ := txm.(monitor.NativeTokenSender)
+		balanceMonitor = monitor.NewBalanceMonitor(cl, opts.KeyStore, cfg.EVM(), sender, l)
 		headBroadcaster.Subscribe(balanceMonitor)
 	}
 
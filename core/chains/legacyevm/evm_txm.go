@@ -7,6 +7,7 @@ import (
 	httypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/userops"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	evmclient "github.com/smartcontractkit/chainlink/v2/evm/client"
 	evmconfig "github.com/smartcontractkit/chainlink/v2/evm/config"
@@ -73,12 +74,43 @@ func newEvmTxm(
 			estimator,
 			headTracker,
 			txmv2)
+		if err == nil && cfg.Transactions().AccountAbstraction().Enabled() {
+			txm, err = newUserOpsTxm(ds, cfg, client, lggr, opts, headTracker, estimator, txm)
+		}
 	} else {
 		txm = opts.GenTxManager(chainID)
 	}
 	return
 }
 
+// newUserOpsTxm wraps the txm to send the transactions with the user operation strategy through the bundler.
+func newUserOpsTxm(
+	ds sqlutil.DataSource,
+	cfg evmconfig.EVM,
+	client evmclient.Client,
+	lggr logger.Logger,
+	opts ChainRelayOpts,
+	headTracker httypes.HeadTracker,
+	estimator gas.EvmFeeEstimator,
+	txm txmgr.TxManager,
+) (txmgr.TxManager, error) {
+	aaCfg := cfg.Transactions().AccountAbstraction()
+	bundler, err := userops.NewBundlerClient(aaCfg.BundlerURL().String())
+	if err != nil {
+		return nil, err
+	}
+	var paymaster userops.Paymaster
+	if aaCfg.PaymasterURL() != nil {
+		if paymaster, err = userops.NewPaymasterClient(aaCfg.PaymasterURL().String()); err != nil {
+			return nil, err
+		}
+	}
+	lggr.Infow("Sending user operations through bundler", "entryPoint", aaCfg.EntryPoint(), "accountFactory", aaCfg.AccountFactory(), "paymaster", paymaster != nil)
+	sender := userops.NewSender(lggr, cfg.ChainID(), aaCfg.EntryPoint(), aaCfg.AccountFactory(), client, opts.KeyStore, estimator,
+		txmgr.NewEvmTxmFeeConfig(cfg.GasEstimator()), headTracker, bundler, paymaster, userops.NewORM(ds), cfg.Transactions().ResendAfterThreshold())
+	return userops.NewTxManager(txm, sender), nil
+}
+
 func newGasEstimator(
 	cfg evmconfig.EVM,
 	client evmclient.Client,
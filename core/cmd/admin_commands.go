@@ -21,6 +21,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/sessions"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
 
@@ -68,6 +69,31 @@ func initAdminSubCmds(s *Shell) []cli.Command {
 				},
 			},
 		},
+		{
+			Name:   "rotate-keystore-password",
+			Usage:  "Re-encrypt the keystore with a new password, without restarting the node",
+			Action: s.RotateKeystorePassword,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:     "old-password",
+					Usage:    "`FILE` containing the current keystore password",
+					Required: true,
+				},
+				cli.StringFlag{
+					Name:     "new-password",
+					Usage:    "`FILE` containing the new keystore password",
+					Required: true,
+				},
+				cli.IntFlag{
+					Name:  "scrypt-n",
+					Usage: "optional, scrypt N parameter to encrypt the keystore with, a power of two. Defaults to the one of the node",
+				},
+				cli.IntFlag{
+					Name:  "scrypt-p",
+					Usage: "optional, scrypt P parameter to encrypt the keystore with. Defaults to the one of the node",
+				},
+			},
+		},
 		{
 			Name:   "status",
 			Usage:  "Displays the health of various services running inside the node.",
@@ -131,6 +157,49 @@ func initAdminSubCmds(s *Shell) []cli.Command {
 				},
 			},
 		},
+		{
+			Name:  "tokens",
+			Usage: "Create, list, or delete your scoped API tokens",
+			Subcommands: cli.Commands{
+				{
+					Name:   "list",
+					Usage:  "Lists your API tokens and their scopes",
+					Action: s.ListAPITokens,
+				},
+				{
+					Name:   "create",
+					Usage:  "Create a new scoped API token",
+					Action: s.CreateAPIToken,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:     "name",
+							Usage:    "Name of the new API token",
+							Required: true,
+						},
+						cli.StringSliceFlag{
+							Name:  "scope",
+							Usage: "Scope of the new API token, written '<resource>:<role>' or '<resource>:<role>:<EVM chain ID>', e.g. 'jobs:view'. Can be repeated",
+						},
+						cli.DurationFlag{
+							Name:  "expires-in",
+							Usage: "optional, duration after which the new API token expires",
+						},
+					},
+				},
+				{
+					Name:   "delete",
+					Usage:  "Delete an API token",
+					Action: s.DeleteAPIToken,
+					Flags: []cli.Flag{
+						cli.Int64Flag{
+							Name:     "id",
+							Usage:    "ID of the API token to delete",
+							Required: true,
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -302,6 +371,186 @@ func (s *Shell) DeleteUser(c *cli.Context) (err error) {
 	return s.renderAPIResponse(response, &AdminUsersPresenter{}, "Successfully deleted API user")
 }
 
+type APITokenPresenter struct {
+	JAID
+	presenters.APITokenResource
+}
+
+var apiTokensTableHeaders = []string{"ID", "Name", "Access key", "Scopes", "Expires at", "Last used at", "Created at"}
+
+func (p *APITokenPresenter) ToRow() []string {
+	formatTime := func(t *time.Time) string {
+		if t == nil {
+			return ""
+		}
+		return t.String()
+	}
+	row := []string{
+		p.ID,
+		p.Name,
+		p.AccessKey,
+		strings.Join(p.Scopes, ", "),
+		formatTime(p.ExpiresAt),
+		formatTime(p.LastUsedAt),
+		p.CreatedAt.String(),
+	}
+	return row
+}
+
+// RenderTable implements TableRenderer
+func (p *APITokenPresenter) RenderTable(rt RendererTable) error {
+	rows := [][]string{p.ToRow()}
+
+	renderList(apiTokensTableHeaders, rows, rt.Writer)
+	if p.Secret != "" {
+		if _, err := rt.Write([]byte(fmt.Sprintf("\nSecret: %s\nThe secret is only shown once, store it safely.\n", p.Secret))); err != nil {
+			return err
+		}
+	}
+
+	return cutils.JustError(rt.Write([]byte("\n")))
+}
+
+type APITokenPresenters []APITokenPresenter
+
+// RenderTable implements TableRenderer
+func (ps APITokenPresenters) RenderTable(rt RendererTable) error {
+	rows := [][]string{}
+
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+
+	if _, err := rt.Write([]byte("API tokens\n")); err != nil {
+		return err
+	}
+	renderList(apiTokensTableHeaders, rows, rt.Writer)
+
+	return cutils.JustError(rt.Write([]byte("\n")))
+}
+
+// ListAPITokens renders the API tokens of the logged in user
+func (s *Shell) ListAPITokens(_ *cli.Context) (err error) {
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/user/tokens", nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &APITokenPresenters{})
+}
+
+// CreateAPIToken creates a scoped API token for the logged in user, prompting for their password
+func (s *Shell) CreateAPIToken(c *cli.Context) (err error) {
+	scopes := c.StringSlice("scope")
+	if len(scopes) == 0 {
+		return s.errorOut(errors.New("must specify at least one scope"))
+	}
+	if _, err = sessions.ParseAPITokenScopes(scopes); err != nil {
+		return s.errorOut(err)
+	}
+
+	request := web.CreateAPITokenRequest{
+		Name:   c.String("name"),
+		Scopes: scopes,
+	}
+	if c.IsSet("expires-in") {
+		expiresAt := time.Now().Add(c.Duration("expires-in"))
+		request.ExpiresAt = &expiresAt
+	}
+
+	fmt.Println("Password of your user:")
+	request.Password = s.PasswordPrompter.Prompt()
+
+	requestData, err := json.Marshal(request)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	buf := bytes.NewBuffer(requestData)
+	response, err := s.HTTP.Post(s.ctx(), "/v2/user/tokens", buf)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := response.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(response, &APITokenPresenter{}, "Successfully created new API token")
+}
+
+// DeleteAPIToken deletes an API token of the logged in user by ID, prompting for their password
+func (s *Shell) DeleteAPIToken(c *cli.Context) (err error) {
+	fmt.Println("Password of your user:")
+	request := web.DeleteAPITokenRequest{Password: s.PasswordPrompter.Prompt()}
+
+	requestData, err := json.Marshal(request)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	buf := bytes.NewBuffer(requestData)
+	response, err := s.HTTP.Post(s.ctx(), fmt.Sprintf("/v2/user/tokens/%d/delete", c.Int64("id")), buf)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := response.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(response, &APITokenPresenter{}, "Successfully deleted API token")
+}
+
+// RotateKeystorePassword has the node re-encrypt its keystore with a new password
+func (s *Shell) RotateKeystorePassword(c *cli.Context) (err error) {
+	oldPassword, err := utils.PasswordFromFile(c.String("old-password"))
+	if err != nil {
+		return s.errorOut(fmt.Errorf("could not read old password file: %w", err))
+	}
+	newPassword, err := utils.PasswordFromFile(c.String("new-password"))
+	if err != nil {
+		return s.errorOut(fmt.Errorf("could not read new password file: %w", err))
+	}
+
+	requestData, err := json.Marshal(web.RotateKeystorePasswordRequest{
+		OldPassword: oldPassword,
+		NewPassword: newPassword,
+		ScryptN:     c.Int("scrypt-n"),
+		ScryptP:     c.Int("scrypt-p"),
+	})
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/keystore/password", bytes.NewBuffer(requestData))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	switch resp.StatusCode {
+	case http.StatusNoContent:
+		fmt.Println("Keystore password rotated. Set the new password as the Password.Keystore secret before restarting the node.")
+	case http.StatusConflict:
+		fmt.Println("Old keystore password did not match.")
+	default:
+		return s.printResponseBody(resp)
+	}
+	return nil
+}
+
 // Status will display the health of various services
 func (s *Shell) Status(c *cli.Context) error {
 	resp, err := s.HTTP.Get(s.ctx(), "/health?full=1", nil)
@@ -5,6 +5,8 @@ import (
 	"flag"
 	"fmt"
 	"math/rand"
+	"os"
+	"path/filepath"
 	"strconv"
 	"testing"
 	"time"
@@ -208,3 +210,43 @@ func (t *testRenderer) Render(i interface{}, s ...string) error {
 	t.presenters = *adminPresenters
 	return nil
 }
+
+func TestShell_RotateKeystorePassword(t *testing.T) {
+	ctx := testutils.Context(t)
+	app := startNewApplicationV2(t, nil)
+	client, _ := app.NewShellAndRenderer()
+
+	newPassword := "rotated-keystore-p4SsW0rD"
+	newPasswordFile := filepath.Join(t.TempDir(), "new_password.txt")
+	require.NoError(t, os.WriteFile(newPasswordFile, []byte(newPassword+"\n"), 0600))
+
+	tests := []struct {
+		name        string
+		oldPassword string
+		newPassword string
+		err         string
+	}{
+		{"Missing old password file", filepath.Join(t.TempDir(), "missing.txt"), newPasswordFile, "could not read old password file"},
+		{"Missing new password file", "../internal/fixtures/correct_password.txt", filepath.Join(t.TempDir(), "missing.txt"), "could not read new password file"},
+		{"Valid params", "../internal/fixtures/correct_password.txt", newPasswordFile, ""},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			set := flag.NewFlagSet("test", 0)
+			flagSetApplyFromAction(client.RotateKeystorePassword, set, "")
+
+			require.NoError(t, set.Set("old-password", test.oldPassword))
+			require.NoError(t, set.Set("new-password", test.newPassword))
+			c := cli.NewContext(nil, set, nil)
+			if test.err != "" {
+				assert.ErrorContains(t, client.RotateKeystorePassword(c), test.err)
+			} else {
+				assert.NoError(t, client.RotateKeystorePassword(c))
+			}
+		})
+	}
+
+	require.NoError(t, app.GetKeyStore().Unlock(ctx, newPassword))
+}
@@ -3,8 +3,12 @@ package cmd
 import (
 	"bytes"
 	"fmt"
+	"io"
+	"net/http"
 	"net/url"
+	"os"
 	"strconv"
+	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/urfave/cli"
@@ -48,6 +52,56 @@ func initBlocksSubCmds(s *Shell) []cli.Command {
 				},
 			},
 		},
+		{
+			Name:  "logs",
+			Usage: "Commands for moving saved logs between nodes",
+			Subcommands: []cli.Command{
+				{
+					Name:   "export",
+					Usage:  "Export the finalized logs of LogPoller filters, and their blocks, to a gzipped archive",
+					Action: s.ExportLogs,
+					Flags: []cli.Flag{
+						cli.Int64Flag{
+							Name:  "evm-chain-id",
+							Usage: "Chain ID of the EVM-based blockchain",
+						},
+						cli.StringSliceFlag{
+							Name:  "filter",
+							Usage: "Name of a LogPoller filter to export. Can be repeated, all filters are exported if unset",
+						},
+						cli.Int64Flag{
+							Name:  "from-block",
+							Usage: "First block to export",
+						},
+						cli.Int64Flag{
+							Name:  "to-block",
+							Usage: "Last block to export, defaults to the latest finalized block",
+						},
+						cli.StringFlag{
+							Name:     "output, o",
+							Usage:    "Path where the archive is written",
+							Required: true,
+						},
+					},
+				},
+				{
+					Name:   "import",
+					Usage:  "Import the logs of registered filters from an archive created by export, after checking them against the chain",
+					Action: s.ImportLogs,
+					Flags: []cli.Flag{
+						cli.Int64Flag{
+							Name:  "evm-chain-id",
+							Usage: "Chain ID of the EVM-based blockchain",
+						},
+						cli.StringFlag{
+							Name:     "file, f",
+							Usage:    "Path of the archive to import",
+							Required: true,
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -133,3 +187,102 @@ func (s *Shell) FindLCA(c *cli.Context) (err error) {
 
 	return s.renderAPIResponse(resp, &LCAPresenter{}, "Last Common Ancestor")
 }
+
+// ExportLogs writes an archive of the finalized logs matching LogPoller filters to a file.
+func (s *Shell) ExportLogs(c *cli.Context) (err error) {
+	v := url.Values{}
+	if c.IsSet("evm-chain-id") {
+		v.Add("evmChainID", fmt.Sprintf("%d", c.Int64("evm-chain-id")))
+	}
+	for _, filter := range c.StringSlice("filter") {
+		v.Add("filter", filter)
+	}
+	if c.IsSet("from-block") {
+		v.Add("fromBlock", strconv.FormatInt(c.Int64("from-block"), 10))
+	}
+	if c.IsSet("to-block") {
+		v.Add("toBlock", strconv.FormatInt(c.Int64("to-block"), 10))
+	}
+
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/logs/export?"+v.Encode())
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return s.errorOut(fmt.Errorf("error exporting logs: %w", httpError(resp)))
+	}
+
+	output := c.String("output")
+	f, err := os.Create(output)
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "failed to create archive file"))
+	}
+	defer func() {
+		if cerr := f.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+	if _, err = io.Copy(f, resp.Body); err != nil {
+		return s.errorOut(errors.Wrap(err, "failed to write archive file"))
+	}
+
+	fmt.Println("Exported logs to", output)
+	return nil
+}
+
+// LogsArchivePresenter implements TableRenderer for a LogsArchiveResponse.
+type LogsArchivePresenter struct {
+	web.LogsArchiveResponse
+}
+
+// ToRow presents the LogsArchiveResponse as a slice of strings.
+func (p *LogsArchivePresenter) ToRow() []string {
+	return []string{
+		p.EVMChainID.String(),
+		strconv.FormatInt(p.FromBlock, 10),
+		strconv.FormatInt(p.ToBlock, 10),
+		strings.Join(p.Filters, "\n"),
+		strconv.Itoa(p.Blocks),
+		strconv.Itoa(p.Logs),
+	}
+}
+
+// RenderTable implements TableRenderer
+// Just renders a single row
+func (p LogsArchivePresenter) RenderTable(rt RendererTable) error {
+	renderList([]string{"ChainID", "From Block", "To Block", "Filters", "Blocks", "Logs"}, [][]string{p.ToRow()}, rt.Writer)
+
+	return nil
+}
+
+// ImportLogs imports an archive of logs created by ExportLogs.
+func (s *Shell) ImportLogs(c *cli.Context) (err error) {
+	v := url.Values{}
+	if c.IsSet("evm-chain-id") {
+		v.Add("evmChainID", fmt.Sprintf("%d", c.Int64("evm-chain-id")))
+	}
+
+	f, err := os.Open(c.String("file"))
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "failed to open archive file"))
+	}
+	defer f.Close()
+
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/logs/import?"+v.Encode(), f)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &LogsArchivePresenter{}, "Imported Logs")
+}
@@ -62,6 +62,16 @@ func initEVMTxSubCmd(s *Shell) cli.Command {
 				Usage:  "get information on a specific Ethereum Transaction",
 				Action: s.ShowTransaction,
 			},
+			{
+				Name:   "cancel",
+				Usage:  "Replace the unconfirmed Ethereum Transaction <id> with a 0-value self-transfer at the same nonce",
+				Action: s.CancelTransaction,
+			},
+			{
+				Name:   "speedup",
+				Usage:  "Replace the unconfirmed Ethereum Transaction <id> with a copy with a bumped fee at the same nonce",
+				Action: s.SpeedUpTransaction,
+			},
 		},
 	}
 }
@@ -131,6 +141,35 @@ func (s *Shell) ShowTransaction(c *cli.Context) (err error) {
 	return err
 }
 
+// CancelTransaction replaces the given unconfirmed transaction with a 0-value self-transfer
+func (s *Shell) CancelTransaction(c *cli.Context) error {
+	return s.replaceTransaction(c, "cancel")
+}
+
+// SpeedUpTransaction replaces the given unconfirmed transaction with a copy with a bumped fee
+func (s *Shell) SpeedUpTransaction(c *cli.Context) error {
+	return s.replaceTransaction(c, "speedup")
+}
+
+func (s *Shell) replaceTransaction(c *cli.Context, action string) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the id of the transaction"))
+	}
+	id := c.Args().First()
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/transactions/evm/"+id+"/"+action, nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	err = s.renderAPIResponse(resp, &EthTxPresenter{})
+	return err
+}
+
 // SendEther transfers ETH from the node's account to a specified address.
 func (s *Shell) SendEther(c *cli.Context) (err error) {
 	if c.NArg() < 3 {
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"strconv"
 	"strings"
 	"time"
 
@@ -50,6 +51,21 @@ func initJobsSubCmds(s *Shell) []cli.Command {
 			Usage:  "Trigger a job run",
 			Action: s.TriggerPipelineRun,
 		},
+		{
+			Name:   "simulate",
+			Usage:  "Simulate a run of a job spec without persisting it or sending transactions",
+			Action: s.SimulateJob,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "vars",
+					Usage: "pipeline variables as a JSON object or a path to a JSON file",
+				},
+				cli.StringFlag{
+					Name:  "stubs",
+					Usage: "responses of http, bridge and ethcall tasks keyed by dot ID, as a JSON object or a path to a JSON file",
+				},
+			},
+		},
 	}
 }
 
@@ -313,3 +329,128 @@ func (s *Shell) TriggerPipelineRun(c *cli.Context) error {
 	err = s.renderAPIResponse(resp, &run, "Pipeline run successfully triggered")
 	return err
 }
+
+// PipelineSimulationPresenter wraps the JSONAPI pipeline simulation resource and adds rendering functionality
+type PipelineSimulationPresenter struct {
+	JAID
+	presenters.PipelineSimulationResource
+}
+
+// RenderTable implements TableRenderer
+func (p *PipelineSimulationPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Dot ID", "Type", "Inputs", "Output", "Error", "Attempts", "Duration"})
+	for _, tr := range p.TaskRuns {
+		var inputs []string
+		for _, input := range tr.Inputs {
+			switch {
+			case input.Error != nil:
+				inputs = append(inputs, "error: "+*input.Error)
+			case input.Value != nil:
+				inputs = append(inputs, *input.Value)
+			default:
+				inputs = append(inputs, "null")
+			}
+		}
+		var duration string
+		if tr.FinishedAt.Valid {
+			duration = tr.FinishedAt.Time.Sub(tr.CreatedAt).String()
+		}
+		table.Append([]string{
+			tr.DotID,
+			tr.Type.String(),
+			strings.Join(inputs, "\n"),
+			stringOrNull(tr.Output),
+			stringOrEmpty(tr.Error),
+			strconv.FormatUint(uint64(tr.Attempts), 10),
+			duration,
+		})
+	}
+	render("Simulated Task Runs", table)
+
+	if len(p.Transactions) > 0 {
+		txTable := rt.newTable([]string{"Dot ID", "To", "Data", "Gas Limit"})
+		for _, tx := range p.Transactions {
+			var gasLimit string
+			if tx.GasLimit != nil {
+				gasLimit = strconv.FormatUint(*tx.GasLimit, 10)
+			}
+			txTable.Append([]string{tx.DotID, tx.To.Hex(), tx.Data.String(), gasLimit})
+		}
+		render("Simulated Transactions", txTable)
+	}
+
+	outputs := rt.newTable([]string{"Outputs", "Fatal Errors"})
+	for i := range p.Outputs {
+		var fatal *string
+		if i < len(p.FatalErrors) {
+			fatal = p.FatalErrors[i]
+		}
+		outputs.Append([]string{stringOrNull(p.Outputs[i]), stringOrEmpty(fatal)})
+	}
+	render("Simulated Run", outputs)
+	return nil
+}
+
+func stringOrNull(s *string) string {
+	if s == nil {
+		return "null"
+	}
+	return *s
+}
+
+func stringOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// SimulateJob dry-runs the pipeline of a job spec against stubbed responses
+// Valid input is a TOML string or a path to TOML file
+func (s *Shell) SimulateJob(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass in TOML or filepath"))
+	}
+
+	tomlString, err := getTOMLString(c.Args().First())
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	request := web.SimulateJobRequest{TOML: tomlString}
+	if v := c.String("vars"); v != "" {
+		buf, rerr := getBufferFromJSON(v)
+		if rerr != nil {
+			return s.errorOut(rerr)
+		}
+		if rerr = json.Unmarshal(buf.Bytes(), &request.Vars); rerr != nil {
+			return s.errorOut(errors.Wrap(rerr, "invalid vars"))
+		}
+	}
+	if v := c.String("stubs"); v != "" {
+		buf, rerr := getBufferFromJSON(v)
+		if rerr != nil {
+			return s.errorOut(rerr)
+		}
+		if rerr = json.Unmarshal(buf.Bytes(), &request.Stubs); rerr != nil {
+			return s.errorOut(errors.Wrap(rerr, "invalid stubs"))
+		}
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/jobs/simulate", bytes.NewReader(body))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &PipelineSimulationPresenter{})
+}
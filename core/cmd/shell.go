@@ -47,6 +47,8 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/hsm"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/remotesigner"
 	"github.com/smartcontractkit/chainlink/v2/core/services/llo"
 	"github.com/smartcontractkit/chainlink/v2/core/services/periodicbackup"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/mercury/wsrpc"
@@ -205,7 +207,18 @@ func (n ChainlinkAppFactory) NewApplication(ctx context.Context, cfg chainlink.G
 	}
 
 	ds := sqlutil.WrapDataSource(db, appLggr, sqlutil.TimeoutHook(cfg.Database().DefaultQueryTimeout), sqlutil.MonitorHook(cfg.Database().LogSQL))
-	keyStore := keystore.New(ds, utils.GetScryptParams(cfg), appLggr)
+	keyStore, closeSigner, err := newKeyStore(ds, cfg, appLggr)
+	if err != nil {
+		return nil, err
+	}
+	if closeSigner != nil {
+		// the application closes the signer on shutdown, unless it fails to be created
+		defer func() {
+			if err != nil {
+				err = multierr.Append(err, closeSigner())
+			}
+		}()
+	}
 
 	err = keyStoreAuthenticator.Authenticate(ctx, keyStore, cfg.Password())
 	if err != nil {
@@ -217,7 +230,7 @@ func (n ChainlinkAppFactory) NewApplication(ctx context.Context, cfg chainlink.G
 		return nil, errors.Wrap(err, "failed to ensure CSA key")
 	}
 
-	beholderAuthHeaders, csaPubKeyHex, err := keystore.BuildBeholderAuth(keyStore)
+	beholderAuthHeaders, csaPubKeyHex, err := keystore.BuildBeholderAuth(ctx, keyStore)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to build Beholder auth")
 	}
@@ -325,6 +338,7 @@ func (n ChainlinkAppFactory) NewApplication(ctx context.Context, cfg chainlink.G
 		Config:                     cfg,
 		DS:                         ds,
 		KeyStore:                   keyStore,
+		CloseSigner:                closeSigner,
 		RelayerChainInteroperators: relayChainInterops,
 		MailMon:                    mailMon,
 		Logger:                     appLggr,
@@ -342,6 +356,29 @@ func (n ChainlinkAppFactory) NewApplication(ctx context.Context, cfg chainlink.G
 	})
 }
 
+// newKeyStore returns the keystore of the node, which delegates signing to the PKCS#11 token or the remote signer if
+// one is enabled, and the function closing its signer if it has one.
+func newKeyStore(ds sqlutil.DataSource, cfg chainlink.GeneralConfig, lggr logger.Logger) (keystore.Master, func() error, error) {
+	if pkcs11Cfg := cfg.Keystore().PKCS11(); pkcs11Cfg.Enabled() {
+		signer, err := hsm.NewSigner(pkcs11Cfg, lggr)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "failed to open PKCS#11 token")
+		}
+		lggr.Infow("Holding EVM and OCR2 keys in PKCS#11 token", "token", pkcs11Cfg.TokenLabel())
+		return keystore.NewWithSigner(ds, utils.GetScryptParams(cfg), signer, lggr), signer.Close, nil
+	}
+	signerCfg := cfg.Keystore().RemoteSigner()
+	if !signerCfg.Enabled() {
+		return keystore.New(ds, utils.GetScryptParams(cfg), lggr), nil, nil
+	}
+	signer, err := remotesigner.NewClient(signerCfg)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to create remote signer client")
+	}
+	lggr.Infow("Delegating signing to remote signer", "endpoint", signerCfg.Endpoint())
+	return keystore.NewWithSigner(ds, utils.GetScryptParams(cfg), signer, lggr), signer.Close, nil
+}
+
 // handleNodeVersioning is a setup-time helper to encapsulate version changes and db migration
 func handleNodeVersioning(ctx context.Context, db *sqlx.DB, appLggr logger.Logger, rootDir string, cfg config.Database, healthReportPort uint16) error {
 	var err error
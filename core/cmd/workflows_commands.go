@@ -0,0 +1,281 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pelletier/go-toml/v2"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func initWorkflowsSubCmds(s *Shell) []cli.Command {
+	return []cli.Command{
+		{
+			Name:  "executions",
+			Usage: "Commands for browsing the execution history of workflows",
+			Subcommands: []cli.Command{
+				{
+					Name:   "list",
+					Usage:  "List workflow executions, most recent first",
+					Action: s.ListWorkflowExecutions,
+					Flags: []cli.Flag{
+						cli.IntFlag{
+							Name:  "page",
+							Usage: "page of results to display",
+						},
+						cli.StringFlag{
+							Name:  "workflow-id",
+							Usage: "only list the executions of this workflow",
+						},
+						cli.StringSliceFlag{
+							Name:  "status",
+							Usage: "only list the executions with this status (started, completed, completed_early_exit, errored or timeout), can be repeated",
+						},
+					},
+				},
+				{
+					Name:   "show",
+					Usage:  "Show a workflow execution along with the inputs, outputs and errors of its steps",
+					Action: s.ShowWorkflowExecution,
+				},
+				{
+					Name:   "replay",
+					Usage:  "Replay a failed workflow execution from a step, reusing the outputs of the steps it depends on",
+					Action: s.ReplayWorkflowExecution,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "step",
+							Usage: "ref of the step to replay the execution from",
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:   "simulate",
+			Usage:  "Simulate a compiled WASM workflow locally, against mocked capabilities",
+			Action: s.SimulateWorkflow,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "wasm",
+					Usage: "path to the compiled workflow binary, compressed or not",
+				},
+				cli.StringFlag{
+					Name:  "config",
+					Usage: "path to the TOML simulation config: the workflow config, the trigger events to fire and the responses of the mocked capabilities",
+				},
+			},
+		},
+	}
+}
+
+// WorkflowExecutionPresenter wraps the JSONAPI workflow execution resource and
+// adds rendering functionality.
+type WorkflowExecutionPresenter struct {
+	JAID
+	presenters.WorkflowExecutionResource
+}
+
+// ToRow presents the WorkflowExecutionPresenter as a slice of strings.
+func (p *WorkflowExecutionPresenter) ToRow() []string {
+	return []string{
+		p.GetID(),
+		p.WorkflowID,
+		p.Status,
+		formatOptionalTime(p.CreatedAt),
+		formatOptionalTime(p.FinishedAt),
+	}
+}
+
+// RenderTable implements TableRenderer
+func (p *WorkflowExecutionPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"ID", "Workflow ID", "Status", "Created At", "Finished At"})
+	table.Append(p.ToRow())
+	render("Workflow Execution", table)
+
+	steps := rt.newTable([]string{"Ref", "Status", "Attempts", "Inputs", "Outputs", "Error"})
+	for _, step := range p.Steps {
+		var errMsg string
+		if step.Error != nil {
+			errMsg = *step.Error
+		}
+		// attempts are only recorded for retried steps
+		attempts := "1"
+		if len(step.Attempts) > 0 {
+			attempts = strconv.Itoa(len(step.Attempts))
+		}
+		steps.Append([]string{
+			step.Ref,
+			step.Status,
+			attempts,
+			formatStepValue(step.Inputs),
+			formatStepValue(step.Outputs),
+			errMsg,
+		})
+	}
+	render("Steps", steps)
+	return nil
+}
+
+// WorkflowExecutionPresenters implements TableRenderer for a slice of
+// WorkflowExecutionPresenter.
+type WorkflowExecutionPresenters []WorkflowExecutionPresenter
+
+// RenderTable implements TableRenderer
+func (ps WorkflowExecutionPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"ID", "Workflow ID", "Status", "Created At", "Finished At"})
+	for _, p := range ps {
+		table.Append(p.ToRow())
+	}
+
+	render("Workflow Executions", table)
+	return nil
+}
+
+// WorkflowSimulationPresenters renders the executions of a simulated workflow.
+type WorkflowSimulationPresenters []WorkflowExecutionPresenter
+
+// RenderTable implements TableRenderer
+func (ps WorkflowSimulationPresenters) RenderTable(rt RendererTable) error {
+	for _, p := range ps {
+		if err := p.RenderTable(rt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+func formatStepValue(v interface{}) string {
+	if v == nil {
+		return ""
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}
+
+// ListWorkflowExecutions lists the workflow executions, most recent first,
+// optionally filtered by workflow ID and status.
+func (s *Shell) ListWorkflowExecutions(c *cli.Context) error {
+	q := url.Values{}
+	if workflowID := c.String("workflow-id"); workflowID != "" {
+		q.Set("workflowID", workflowID)
+	}
+	if statuses := c.StringSlice("status"); len(statuses) > 0 {
+		q.Set("status", strings.Join(statuses, ","))
+	}
+	uri := "/v2/workflows/executions"
+	if len(q) > 0 {
+		uri += "?" + q.Encode()
+	}
+	return s.getPage(uri, c.Int("page"), &WorkflowExecutionPresenters{})
+}
+
+// ShowWorkflowExecution shows a workflow execution along with its steps.
+func (s *Shell) ShowWorkflowExecution(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the id of the workflow execution"))
+	}
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/workflows/executions/"+url.PathEscape(c.Args().First()))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &WorkflowExecutionPresenter{})
+}
+
+// ReplayWorkflowExecution replays a failed workflow execution from the given step.
+func (s *Shell) ReplayWorkflowExecution(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the id of the workflow execution"))
+	}
+	if c.String("step") == "" {
+		return s.errorOut(errors.New("must pass the ref of the step to replay from with --step"))
+	}
+
+	body, err := json.Marshal(web.ReplayWorkflowExecutionRequest{StepRef: c.String("step")})
+	if err != nil {
+		return s.errorOut(err)
+	}
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/workflows/executions/"+url.PathEscape(c.Args().First())+"/replay", bytes.NewReader(body))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &WorkflowExecutionPresenter{})
+}
+
+// SimulateWorkflow runs a compiled workflow in-process against the capabilities mocked
+// by the simulation config, firing its trigger events one after the other.
+func (s *Shell) SimulateWorkflow(c *cli.Context) error {
+	if c.String("wasm") == "" {
+		return s.errorOut(errors.New("must pass the path to the workflow binary with --wasm"))
+	}
+	binary, err := os.ReadFile(c.String("wasm"))
+	if err != nil {
+		return s.errorOut(fmt.Errorf("failed to read workflow binary: %w", err))
+	}
+
+	var cfg workflows.SimulationConfig
+	if path := c.String("config"); path != "" {
+		b, rerr := os.ReadFile(path)
+		if rerr != nil {
+			return s.errorOut(fmt.Errorf("failed to read simulation config: %w", rerr))
+		}
+		d := toml.NewDecoder(bytes.NewReader(b))
+		d.DisallowUnknownFields()
+		if rerr = d.Decode(&cfg); rerr != nil {
+			return s.errorOut(fmt.Errorf("invalid simulation config: %w", rerr))
+		}
+	}
+
+	result, err := workflows.Simulate(s.ctx(), s.Logger, binary, cfg)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	order := make(map[string]int, len(result.StepRefs))
+	for i, ref := range result.StepRefs {
+		order[ref] = i
+	}
+	ps := make(WorkflowSimulationPresenters, len(result.Executions))
+	for i, execution := range result.Executions {
+		r := presenters.NewWorkflowExecutionResource(execution)
+		// show the steps in the order they were executed in, rather than by ref
+		sort.SliceStable(r.Steps, func(i, j int) bool { return order[r.Steps[i].Ref] < order[r.Steps[j].Ref] })
+		ps[i] = WorkflowExecutionPresenter{JAID: NewJAID(r.ID), WorkflowExecutionResource: r}
+	}
+	return s.Render(&ps)
+}
@@ -0,0 +1,131 @@
+package cmd_test
+
+import (
+	"errors"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+
+	"github.com/smartcontractkit/chainlink/v2/core/cmd"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/wasmtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+func TestShell_WorkflowExecutions(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, nil)
+	client, r := app.NewShellAndRenderer()
+
+	var ids []string
+	for _, status := range []string{store.StatusCompleted, store.StatusErrored} {
+		id := uuid.NewString()
+		_, err := app.WorkflowORM().Add(testutils.Context(t), &store.WorkflowExecution{
+			ExecutionID: id,
+			Status:      status,
+			Steps: map[string]*store.WorkflowExecutionStep{
+				"trigger": {
+					ExecutionID: id,
+					Ref:         "trigger",
+					Status:      status,
+					Outputs:     store.StepOutput{Value: values.NewString("ok"), Err: errors.New("boom")},
+				},
+			},
+		})
+		require.NoError(t, err)
+		ids = append(ids, id)
+	}
+
+	// list with a status filter
+	set := flag.NewFlagSet("test workflow executions list", 0)
+	flagSetApplyFromAction(client.ListWorkflowExecutions, set, "")
+	require.NoError(t, set.Set("status", store.StatusErrored))
+
+	require.NoError(t, client.ListWorkflowExecutions(cli.NewContext(nil, set, nil)))
+	executions := *r.Renders[0].(*cmd.WorkflowExecutionPresenters)
+	require.Len(t, executions, 1)
+	assert.Equal(t, ids[1], executions[0].ID)
+	assert.Equal(t, store.StatusErrored, executions[0].Status)
+
+	// show
+	set = flag.NewFlagSet("test workflow executions show", 0)
+	flagSetApplyFromAction(client.ShowWorkflowExecution, set, "")
+	require.NoError(t, set.Parse([]string{ids[1]}))
+
+	require.NoError(t, client.ShowWorkflowExecution(cli.NewContext(nil, set, nil)))
+	execution := r.Renders[1].(*cmd.WorkflowExecutionPresenter)
+	assert.Equal(t, ids[1], execution.ID)
+	require.Len(t, execution.Steps, 1)
+	assert.Equal(t, "trigger", execution.Steps[0].Ref)
+	assert.Equal(t, "ok", execution.Steps[0].Outputs)
+	require.NotNil(t, execution.Steps[0].Error)
+	assert.Equal(t, "boom", *execution.Steps[0].Error)
+
+	// show without an id
+	set = flag.NewFlagSet("test workflow executions show", 0)
+	flagSetApplyFromAction(client.ShowWorkflowExecution, set, "")
+	require.ErrorContains(t, client.ShowWorkflowExecution(cli.NewContext(nil, set, nil)), "must pass the id of the workflow execution")
+
+	// replay without a step
+	set = flag.NewFlagSet("test workflow executions replay", 0)
+	flagSetApplyFromAction(client.ReplayWorkflowExecution, set, "")
+	require.NoError(t, set.Parse([]string{ids[1]}))
+	require.ErrorContains(t, client.ReplayWorkflowExecution(cli.NewContext(nil, set, nil)), "must pass the ref of the step")
+
+	// replay an execution whose workflow isn't running
+	set = flag.NewFlagSet("test workflow executions replay", 0)
+	flagSetApplyFromAction(client.ReplayWorkflowExecution, set, "")
+	require.NoError(t, set.Set("step", "trigger"))
+	require.NoError(t, set.Parse([]string{ids[1]}))
+	require.ErrorContains(t, client.ReplayWorkflowExecution(cli.NewContext(nil, set, nil)), "workflow engine is not running")
+}
+
+func TestShell_SimulateWorkflow(t *testing.T) {
+	t.Parallel()
+
+	r := &cltest.RendererMock{}
+	client := cmd.Shell{Renderer: r, Logger: logger.TestLogger(t)}
+
+	dir := t.TempDir()
+	binary := filepath.Join(dir, "workflow.wasm")
+	wasmtest.CreateTestBinary("core/services/workflows/test/wasm/cmd", binary, false, t)
+	config := filepath.Join(dir, "simulation.toml")
+	require.NoError(t, os.WriteFile(config, []byte(`
+[[Events]]
+Outputs = { cool_output = "foo" }
+`), 0600))
+
+	set := flag.NewFlagSet("test workflows simulate", 0)
+	flagSetApplyFromAction(client.SimulateWorkflow, set, "")
+	require.NoError(t, set.Set("wasm", binary))
+	require.NoError(t, set.Set("config", config))
+
+	require.NoError(t, client.SimulateWorkflow(cli.NewContext(nil, set, nil)))
+	executions := *r.Renders[0].(*cmd.WorkflowSimulationPresenters)
+	require.Len(t, executions, 1)
+	assert.Equal(t, store.StatusCompleted, executions[0].Status)
+	require.Len(t, executions[0].Steps, 2)
+	assert.Equal(t, "trigger", executions[0].Steps[0].Ref)
+	assert.Equal(t, "compute", executions[0].Steps[1].Ref)
+	assert.Equal(t, map[string]interface{}{"Value": true}, executions[0].Steps[1].Outputs)
+
+	// unknown fields in the config
+	require.NoError(t, os.WriteFile(config, []byte(`Evnts = []`), 0600))
+	require.ErrorContains(t, client.SimulateWorkflow(cli.NewContext(nil, set, nil)), "invalid simulation config")
+
+	// without a binary
+	set = flag.NewFlagSet("test workflows simulate", 0)
+	flagSetApplyFromAction(client.SimulateWorkflow, set, "")
+	require.ErrorContains(t, client.SimulateWorkflow(cli.NewContext(nil, set, nil)), "must pass the path to the workflow binary with --wasm")
+}
@@ -57,6 +57,7 @@ type AppConfig interface {
 	WebServer() WebServer
 	Tracing() Tracing
 	Telemetry() Telemetry
+	Keystore() Keystore
 }
 
 type DatabaseBackupMode string
@@ -1,6 +1,8 @@
 package config
 
 import (
+	"time"
+
 	"github.com/smartcontractkit/chainlink-common/pkg/types"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
@@ -29,6 +31,14 @@ type CapabilitiesWorkflowRegistry interface {
 	RelayID() types.RelayID
 }
 
+type CapabilitiesWorkflowExecutions interface {
+	ReaperInterval() time.Duration
+	ReaperThreshold() time.Duration
+	FailedReaperThreshold() time.Duration
+	MaxExecutionsPerWorkflow() uint32
+	ReaperBatchSize() uint32
+}
+
 type GatewayConnector interface {
 	ChainIDForNodeKey() string
 	NodeAddress() string
@@ -51,4 +61,5 @@ type Capabilities interface {
 	ExternalRegistry() CapabilitiesExternalRegistry
 	WorkflowRegistry() CapabilitiesWorkflowRegistry
 	GatewayConnector() GatewayConnector
+	WorkflowExecutions() CapabilitiesWorkflowExecutions
 }
@@ -102,6 +102,19 @@ func TestDoc(t *testing.T) {
 		docDefaults.Transactions.TransactionManagerV2.CustomURL = nil
 		docDefaults.Transactions.TransactionManagerV2.DualBroadcast = nil
 
+		// AccountAbstraction configs are only set if the feature is enabled
+		docDefaults.Transactions.AccountAbstraction = toml.AccountAbstractionConfig{}
+
+		// BalanceMonitor alerts and top-ups are only set if the features are enabled
+		docDefaults.BalanceMonitor.AlertThreshold = nil
+		docDefaults.BalanceMonitor.AlertWebhookURL = nil
+		docDefaults.BalanceMonitor.FunderAddress = nil
+		docDefaults.BalanceMonitor.TopUpThreshold = nil
+		docDefaults.BalanceMonitor.TopUpTarget = nil
+
+		// Composite estimator is only set if the mode is enabled
+		docDefaults.GasEstimator.Composite = toml.CompositeEstimator{}
+
 		// Fallback DA oracle is not set
 		docDefaults.GasEstimator.DAOracle = toml.DAOracle{}
 
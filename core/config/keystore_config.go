@@ -0,0 +1,25 @@
+package config
+
+import "time"
+
+type Keystore interface {
+	RemoteSigner() RemoteSigner
+	PKCS11() PKCS11
+}
+
+type RemoteSigner interface {
+	Enabled() bool
+	Endpoint() string
+	CACertFile() string
+	ClientCertFile() string
+	ClientKeyFile() string
+	InsecureConnection() bool
+	Timeout() time.Duration
+}
+
+type PKCS11 interface {
+	Enabled() bool
+	Library() string
+	TokenLabel() string
+	PIN() string
+}
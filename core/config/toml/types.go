@@ -57,6 +57,7 @@ type Core struct {
 	Mercury          Mercury          `toml:",omitempty"`
 	Capabilities     Capabilities     `toml:",omitempty"`
 	Telemetry        Telemetry        `toml:",omitempty"`
+	Keystore         Keystore         `toml:",omitempty"`
 }
 
 // SetFrom updates c with any non-nil values from f. (currently TOML field only!)
@@ -94,6 +95,7 @@ func (c *Core) SetFrom(f *Core) {
 	c.Insecure.setFrom(&f.Insecure)
 	c.Tracing.setFrom(&f.Tracing)
 	c.Telemetry.setFrom(&f.Telemetry)
+	c.Keystore.setFrom(&f.Keystore)
 }
 
 func (c *Core) ValidateConfig() (err error) {
@@ -123,6 +125,7 @@ type Secrets struct {
 	Prometheus PrometheusSecrets        `toml:",omitempty"`
 	Mercury    MercurySecrets           `toml:",omitempty"`
 	Threshold  ThresholdKeyShareSecrets `toml:",omitempty"`
+	Keystore   KeystoreSecrets          `toml:",omitempty"`
 }
 
 func dbURLPasswordComplexity(err error) string {
@@ -608,6 +611,7 @@ type WebServer struct {
 	ListenIP                *net.IP
 
 	LDAP      WebServerLDAP      `toml:",omitempty"`
+	OIDC      WebServerOIDC      `toml:",omitempty"`
 	MFA       WebServerMFA       `toml:",omitempty"`
 	RateLimit WebServerRateLimit `toml:",omitempty"`
 	TLS       WebServerTLS       `toml:",omitempty"`
@@ -652,17 +656,24 @@ func (w *WebServer) setFrom(f *WebServer) {
 	}
 
 	w.LDAP.setFrom(&f.LDAP)
+	w.OIDC.setFrom(&f.OIDC)
 	w.MFA.setFrom(&f.MFA)
 	w.RateLimit.setFrom(&f.RateLimit)
 	w.TLS.setFrom(&f.TLS)
 }
 
 func (w *WebServer) ValidateConfig() (err error) {
-	// Validate LDAP fields when authentication method is LDAPAuth
-	if *w.AuthenticationMethod != string(sessions.LDAPAuth) {
-		return
+	// Validate the fields of the authentication method in use
+	switch sessions.AuthenticationProviderName(*w.AuthenticationMethod) {
+	case sessions.LDAPAuth:
+		return w.validateLDAP()
+	case sessions.OIDCAuth:
+		return w.validateOIDC()
 	}
+	return
+}
 
+func (w *WebServer) validateLDAP() (err error) {
 	// Assert LDAP fields when AuthMethod set to LDAP
 	if *w.LDAP.BaseDN == "" {
 		err = multierr.Append(err, configutils.ErrInvalid{Name: "LDAP.BaseDN", Msg: "LDAP BaseDN can not be empty"})
@@ -691,6 +702,38 @@ func (w *WebServer) ValidateConfig() (err error) {
 	return err
 }
 
+func (w *WebServer) validateOIDC() (err error) {
+	// Assert OIDC fields when AuthMethod set to OIDC
+	if w.OIDC.Issuer == nil || w.OIDC.Issuer.IsZero() {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "OIDC.Issuer", Msg: "OIDC Issuer can not be empty"})
+	}
+	if *w.OIDC.ClientID == "" {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "OIDC.ClientID", Msg: "OIDC ClientID can not be empty"})
+	}
+	if w.OIDC.RedirectURL == nil || w.OIDC.RedirectURL.IsZero() {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "OIDC.RedirectURL", Msg: "OIDC RedirectURL can not be empty"})
+	}
+	if *w.OIDC.EmailClaim == "" {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "OIDC.EmailClaim", Msg: "OIDC EmailClaim can not be empty"})
+	}
+	if *w.OIDC.GroupsClaim == "" {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "OIDC.GroupsClaim", Msg: "OIDC GroupsClaim can not be empty"})
+	}
+	if *w.OIDC.AdminUserGroup == "" {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "OIDC.AdminUserGroup", Msg: "OIDC AdminUserGroup can not be empty"})
+	}
+	if *w.OIDC.EditUserGroup == "" {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "OIDC.EditUserGroup", Msg: "OIDC EditUserGroup can not be empty"})
+	}
+	if *w.OIDC.RunUserGroup == "" {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "OIDC.RunUserGroup", Msg: "OIDC RunUserGroup can not be empty"})
+	}
+	if *w.OIDC.ReadUserGroup == "" {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "OIDC.ReadUserGroup", Msg: "OIDC ReadUserGroup can not be empty"})
+	}
+	return err
+}
+
 type WebServerMFA struct {
 	RPID     *string
 	RPOrigin *string
@@ -852,12 +895,70 @@ func (w *WebServerLDAPSecrets) setFrom(f *WebServerLDAPSecrets) {
 	}
 }
 
+type WebServerOIDC struct {
+	SessionTimeout *commonconfig.Duration
+	Issuer         *commonconfig.URL
+	ClientID       *string
+	RedirectURL    *commonconfig.URL
+	EmailClaim     *string
+	GroupsClaim    *string
+	AdminUserGroup *string
+	EditUserGroup  *string
+	RunUserGroup   *string
+	ReadUserGroup  *string
+}
+
+func (w *WebServerOIDC) setFrom(f *WebServerOIDC) {
+	if v := f.SessionTimeout; v != nil {
+		w.SessionTimeout = v
+	}
+	if v := f.Issuer; v != nil {
+		w.Issuer = v
+	}
+	if v := f.ClientID; v != nil {
+		w.ClientID = v
+	}
+	if v := f.RedirectURL; v != nil {
+		w.RedirectURL = v
+	}
+	if v := f.EmailClaim; v != nil {
+		w.EmailClaim = v
+	}
+	if v := f.GroupsClaim; v != nil {
+		w.GroupsClaim = v
+	}
+	if v := f.AdminUserGroup; v != nil {
+		w.AdminUserGroup = v
+	}
+	if v := f.EditUserGroup; v != nil {
+		w.EditUserGroup = v
+	}
+	if v := f.RunUserGroup; v != nil {
+		w.RunUserGroup = v
+	}
+	if v := f.ReadUserGroup; v != nil {
+		w.ReadUserGroup = v
+	}
+}
+
+type WebServerOIDCSecrets struct {
+	ClientSecret *models.Secret
+}
+
+func (w *WebServerOIDCSecrets) setFrom(f *WebServerOIDCSecrets) {
+	if v := f.ClientSecret; v != nil {
+		w.ClientSecret = v
+	}
+}
+
 type WebServerSecrets struct {
 	LDAP WebServerLDAPSecrets `toml:",omitempty"`
+	OIDC WebServerOIDCSecrets `toml:",omitempty"`
 }
 
 func (w *WebServerSecrets) SetFrom(f *WebServerSecrets) error {
 	w.LDAP.setFrom(&f.LDAP)
+	w.OIDC.setFrom(&f.OIDC)
 	return nil
 }
 
@@ -1510,6 +1611,50 @@ func (r *WorkflowRegistry) setFrom(f *WorkflowRegistry) {
 	}
 }
 
+type WorkflowExecutions struct {
+	ReaperInterval           *commonconfig.Duration
+	ReaperThreshold          *commonconfig.Duration
+	FailedReaperThreshold    *commonconfig.Duration
+	MaxExecutionsPerWorkflow *uint32
+	ReaperBatchSize          *uint32
+}
+
+func (w *WorkflowExecutions) setFrom(f *WorkflowExecutions) {
+	if v := f.ReaperInterval; v != nil {
+		w.ReaperInterval = v
+	}
+	if v := f.ReaperThreshold; v != nil {
+		w.ReaperThreshold = v
+	}
+	if v := f.FailedReaperThreshold; v != nil {
+		w.FailedReaperThreshold = v
+	}
+	if v := f.MaxExecutionsPerWorkflow; v != nil {
+		w.MaxExecutionsPerWorkflow = v
+	}
+	if v := f.ReaperBatchSize; v != nil {
+		w.ReaperBatchSize = v
+	}
+}
+
+func (w *WorkflowExecutions) ValidateConfig() (err error) {
+	if w.ReaperBatchSize != nil && *w.ReaperBatchSize == 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "ReaperBatchSize", Value: *w.ReaperBatchSize, Msg: "must be greater than 0"})
+	}
+	if w.ReaperThreshold != nil && w.ReaperThreshold.Duration() == 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "ReaperThreshold", Value: w.ReaperThreshold.String(),
+			Msg: "must be greater than 0, set ReaperInterval to 0 to disable the reaper"})
+	}
+	if w.ReaperThreshold == nil || w.FailedReaperThreshold == nil {
+		return
+	}
+	if failed := w.FailedReaperThreshold.Duration(); failed != 0 && failed < w.ReaperThreshold.Duration() {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "FailedReaperThreshold", Value: w.FailedReaperThreshold.String(),
+			Msg: "must be 0 or greater than or equal to ReaperThreshold"})
+	}
+	return
+}
+
 type Dispatcher struct {
 	SupportedVersion   *int
 	ReceiverBufferSize *int
@@ -1602,6 +1747,8 @@ type Capabilities struct {
 	ExternalRegistry ExternalRegistry         `toml:",omitempty"`
 	WorkflowRegistry WorkflowRegistry         `toml:",omitempty"`
 	GatewayConnector GatewayConnector         `toml:",omitempty"`
+
+	WorkflowExecutions WorkflowExecutions `toml:",omitempty"`
 }
 
 func (c *Capabilities) setFrom(f *Capabilities) {
@@ -1611,6 +1758,7 @@ func (c *Capabilities) setFrom(f *Capabilities) {
 	c.WorkflowRegistry.setFrom(&f.WorkflowRegistry)
 	c.Dispatcher.setFrom(&f.Dispatcher)
 	c.GatewayConnector.setFrom(&f.GatewayConnector)
+	c.WorkflowExecutions.setFrom(&f.WorkflowExecutions)
 }
 
 type ThresholdKeyShareSecrets struct {
@@ -1780,6 +1928,131 @@ func (b *Telemetry) ValidateConfig() (err error) {
 	return err
 }
 
+type Keystore struct {
+	RemoteSigner RemoteSigner `toml:",omitempty"`
+	PKCS11       PKCS11       `toml:",omitempty"`
+}
+
+func (k *Keystore) setFrom(f *Keystore) {
+	k.RemoteSigner.setFrom(&f.RemoteSigner)
+	k.PKCS11.setFrom(&f.PKCS11)
+}
+
+func (k *Keystore) ValidateConfig() (err error) {
+	if k.RemoteSigner.Enabled != nil && *k.RemoteSigner.Enabled && k.PKCS11.Enabled != nil && *k.PKCS11.Enabled {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "PKCS11.Enabled", Value: true, Msg: "must not be enabled along with RemoteSigner"})
+	}
+	return err
+}
+
+type RemoteSigner struct {
+	Enabled            *bool
+	Endpoint           *string
+	CACertFile         *string
+	ClientCertFile     *string
+	ClientKeyFile      *string
+	InsecureConnection *bool
+	Timeout            *commonconfig.Duration
+}
+
+func (r *RemoteSigner) setFrom(f *RemoteSigner) {
+	if v := f.Enabled; v != nil {
+		r.Enabled = v
+	}
+	if v := f.Endpoint; v != nil {
+		r.Endpoint = v
+	}
+	if v := f.CACertFile; v != nil {
+		r.CACertFile = v
+	}
+	if v := f.ClientCertFile; v != nil {
+		r.ClientCertFile = v
+	}
+	if v := f.ClientKeyFile; v != nil {
+		r.ClientKeyFile = v
+	}
+	if v := f.InsecureConnection; v != nil {
+		r.InsecureConnection = v
+	}
+	if v := f.Timeout; v != nil {
+		r.Timeout = v
+	}
+}
+
+func (r *RemoteSigner) ValidateConfig() (err error) {
+	if r.Enabled == nil || !*r.Enabled {
+		return nil
+	}
+	if r.Endpoint == nil || *r.Endpoint == "" {
+		err = multierr.Append(err, configutils.ErrMissing{Name: "Endpoint", Msg: "must be set when RemoteSigner is enabled"})
+	}
+	if r.InsecureConnection == nil || !*r.InsecureConnection {
+		if r.CACertFile == nil || *r.CACertFile == "" {
+			err = multierr.Append(err, configutils.ErrMissing{Name: "CACertFile", Msg: "must be set, unless InsecureConnection is used"})
+		}
+	}
+	hasClientCert, hasClientKey := r.ClientCertFile != nil && *r.ClientCertFile != "", r.ClientKeyFile != nil && *r.ClientKeyFile != ""
+	if hasClientCert && !hasClientKey {
+		err = multierr.Append(err, configutils.ErrMissing{Name: "ClientKeyFile", Msg: "must be set along with ClientCertFile"})
+	} else if hasClientKey && !hasClientCert {
+		err = multierr.Append(err, configutils.ErrMissing{Name: "ClientCertFile", Msg: "must be set along with ClientKeyFile"})
+	}
+	if r.Timeout != nil && r.Timeout.Duration() <= 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "Timeout", Value: r.Timeout.String(), Msg: "must be positive"})
+	}
+	return err
+}
+
+type PKCS11 struct {
+	Enabled    *bool
+	Library    *string
+	TokenLabel *string
+}
+
+func (p *PKCS11) setFrom(f *PKCS11) {
+	if v := f.Enabled; v != nil {
+		p.Enabled = v
+	}
+	if v := f.Library; v != nil {
+		p.Library = v
+	}
+	if v := f.TokenLabel; v != nil {
+		p.TokenLabel = v
+	}
+}
+
+func (p *PKCS11) ValidateConfig() (err error) {
+	if p.Enabled == nil || !*p.Enabled {
+		return nil
+	}
+	if p.Library == nil || *p.Library == "" {
+		err = multierr.Append(err, configutils.ErrMissing{Name: "Library", Msg: "must be set when PKCS11 is enabled"})
+	}
+	if p.TokenLabel == nil || *p.TokenLabel == "" {
+		err = multierr.Append(err, configutils.ErrMissing{Name: "TokenLabel", Msg: "must be set when PKCS11 is enabled"})
+	}
+	return err
+}
+
+type KeystoreSecrets struct {
+	PKCS11 PKCS11Secrets `toml:",omitempty"`
+}
+
+func (k *KeystoreSecrets) SetFrom(f *KeystoreSecrets) error {
+	k.PKCS11.setFrom(&f.PKCS11)
+	return nil
+}
+
+type PKCS11Secrets struct {
+	PIN *models.Secret
+}
+
+func (p *PKCS11Secrets) setFrom(f *PKCS11Secrets) {
+	if v := f.PIN; v != nil {
+		p.PIN = v
+	}
+}
+
 var hostnameRegex = regexp.MustCompile(`^[a-zA-Z0-9-]+(\.[a-zA-Z0-9-]+)*$`)
 
 // Validates uri is valid external or local URI
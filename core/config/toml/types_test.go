@@ -5,6 +5,7 @@ import (
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -579,3 +580,97 @@ func TestMercuryTLS_ValidateTLSCertPath(t *testing.T) {
 
 // ptr is a utility function for converting a value to a pointer to the value.
 func ptr[T any](t T) *T { return &t }
+
+func TestWorkflowExecutions_ValidateConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  WorkflowExecutions
+		wantErr string
+	}{
+		{
+			name: "valid",
+			config: WorkflowExecutions{
+				ReaperThreshold:       commonconfig.MustNewDuration(3 * time.Hour),
+				FailedReaperThreshold: commonconfig.MustNewDuration(24 * time.Hour),
+				ReaperBatchSize:       ptr[uint32](3000),
+			},
+		},
+		{
+			name: "failed executions deleted with the others",
+			config: WorkflowExecutions{
+				ReaperThreshold:       commonconfig.MustNewDuration(3 * time.Hour),
+				FailedReaperThreshold: commonconfig.MustNewDuration(0),
+			},
+		},
+		{
+			name: "failed executions deleted earlier",
+			config: WorkflowExecutions{
+				ReaperThreshold:       commonconfig.MustNewDuration(3 * time.Hour),
+				FailedReaperThreshold: commonconfig.MustNewDuration(time.Hour),
+			},
+			wantErr: "FailedReaperThreshold: invalid value (1h0m0s): must be 0 or greater than or equal to ReaperThreshold",
+		},
+		{
+			name:    "zero threshold",
+			config:  WorkflowExecutions{ReaperThreshold: commonconfig.MustNewDuration(0)},
+			wantErr: "ReaperThreshold: invalid value (0s): must be greater than 0, set ReaperInterval to 0 to disable the reaper",
+		},
+		{
+			name:    "zero batch size",
+			config:  WorkflowExecutions{ReaperBatchSize: ptr[uint32](0)},
+			wantErr: "ReaperBatchSize: invalid value (0): must be greater than 0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.ValidateConfig()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestKeystore_ValidateConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  Keystore
+		wantErr string
+	}{
+		{
+			name:   "disabled",
+			config: Keystore{RemoteSigner: RemoteSigner{Enabled: ptr(false)}, PKCS11: PKCS11{Enabled: ptr(false)}},
+		},
+		{
+			name:   "PKCS11",
+			config: Keystore{PKCS11: PKCS11{Enabled: ptr(true), Library: ptr("/usr/lib/softhsm/libsofthsm2.so"), TokenLabel: ptr("chainlink")}},
+		},
+		{
+			name:    "PKCS11 without library",
+			config:  Keystore{PKCS11: PKCS11{Enabled: ptr(true), Library: ptr(""), TokenLabel: ptr("chainlink")}},
+			wantErr: "PKCS11.Library: missing: must be set when PKCS11 is enabled",
+		},
+		{
+			name: "PKCS11 along with RemoteSigner",
+			config: Keystore{
+				RemoteSigner: RemoteSigner{Enabled: ptr(true), Endpoint: ptr("signer.example.com:9000"), InsecureConnection: ptr(true)},
+				PKCS11:       PKCS11{Enabled: ptr(true), Library: ptr("/usr/lib/softhsm/libsofthsm2.so"), TokenLabel: ptr("chainlink")},
+			},
+			wantErr: "PKCS11.Enabled: invalid value (true): must not be enabled along with RemoteSigner",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := commonconfig.Validate(&tt.config)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
@@ -55,6 +55,20 @@ type LDAP interface {
 	UpstreamSyncRateLimit() commonconfig.Duration
 }
 
+type OIDC interface {
+	SessionTimeout() commonconfig.Duration
+	Issuer() string
+	ClientID() string
+	ClientSecret() string
+	RedirectURL() string
+	EmailClaim() string
+	GroupsClaim() string
+	AdminUserGroup() string
+	EditUserGroup() string
+	RunUserGroup() string
+	ReadUserGroup() string
+}
+
 type WebServer interface {
 	AuthenticationMethod() string
 	AllowOrigins() string
@@ -74,4 +88,5 @@ type WebServer interface {
 	RateLimit() RateLimit
 	MFA() MFA
 	LDAP() LDAP
+	OIDC() OIDC
 }
@@ -37,6 +37,8 @@ import (
 
 	sqlutil "github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 
+	store "github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+
 	txmgr "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 
 	types "github.com/smartcontractkit/chainlink/v2/evm/types"
@@ -45,6 +47,8 @@ import (
 
 	webhook "github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 
+	workflows "github.com/smartcontractkit/chainlink/v2/core/services/workflows"
+
 	zapcore "go.uber.org/zap/zapcore"
 )
 
@@ -1506,6 +1510,76 @@ func (_c *Application_SetLogLevel_Call) RunAndReturn(run func(zapcore.Level) err
 	return _c
 }
 
+// SimulateJobV2 provides a mock function with given fields: ctx, spec, vars, sim
+func (_m *Application) SimulateJobV2(ctx context.Context, spec pipeline.Spec, vars map[string]interface{}, sim *pipeline.Simulation) (*pipeline.Run, pipeline.TaskRunResults, error) {
+	ret := _m.Called(ctx, spec, vars, sim)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SimulateJobV2")
+	}
+
+	var r0 *pipeline.Run
+	var r1 pipeline.TaskRunResults
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.Spec, map[string]interface{}, *pipeline.Simulation) (*pipeline.Run, pipeline.TaskRunResults, error)); ok {
+		return rf(ctx, spec, vars, sim)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.Spec, map[string]interface{}, *pipeline.Simulation) *pipeline.Run); ok {
+		r0 = rf(ctx, spec, vars, sim)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pipeline.Run)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pipeline.Spec, map[string]interface{}, *pipeline.Simulation) pipeline.TaskRunResults); ok {
+		r1 = rf(ctx, spec, vars, sim)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(pipeline.TaskRunResults)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, pipeline.Spec, map[string]interface{}, *pipeline.Simulation) error); ok {
+		r2 = rf(ctx, spec, vars, sim)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Application_SimulateJobV2_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SimulateJobV2'
+type Application_SimulateJobV2_Call struct {
+	*mock.Call
+}
+
+// SimulateJobV2 is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spec pipeline.Spec
+//   - vars map[string]interface{}
+//   - sim *pipeline.Simulation
+func (_e *Application_Expecter) SimulateJobV2(ctx interface{}, spec interface{}, vars interface{}, sim interface{}) *Application_SimulateJobV2_Call {
+	return &Application_SimulateJobV2_Call{Call: _e.mock.On("SimulateJobV2", ctx, spec, vars, sim)}
+}
+
+func (_c *Application_SimulateJobV2_Call) Run(run func(ctx context.Context, spec pipeline.Spec, vars map[string]interface{}, sim *pipeline.Simulation)) *Application_SimulateJobV2_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pipeline.Spec), args[2].(map[string]interface{}), args[3].(*pipeline.Simulation))
+	})
+	return _c
+}
+
+func (_c *Application_SimulateJobV2_Call) Return(_a0 *pipeline.Run, _a1 pipeline.TaskRunResults, _a2 error) *Application_SimulateJobV2_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *Application_SimulateJobV2_Call) RunAndReturn(run func(context.Context, pipeline.Spec, map[string]interface{}, *pipeline.Simulation) (*pipeline.Run, pipeline.TaskRunResults, error)) *Application_SimulateJobV2_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Start provides a mock function with given fields: ctx
 func (_m *Application) Start(ctx context.Context) error {
 	ret := _m.Called(ctx)
@@ -1676,6 +1750,100 @@ func (_c *Application_WakeSessionReaper_Call) RunAndReturn(run func()) *Applicat
 	return _c
 }
 
+// WorkflowORM provides a mock function with no fields
+func (_m *Application) WorkflowORM() store.Store {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for WorkflowORM")
+	}
+
+	var r0 store.Store
+	if rf, ok := ret.Get(0).(func() store.Store); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.Store)
+		}
+	}
+
+	return r0
+}
+
+// Application_WorkflowORM_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WorkflowORM'
+type Application_WorkflowORM_Call struct {
+	*mock.Call
+}
+
+// WorkflowORM is a helper method to define mock.On call
+func (_e *Application_Expecter) WorkflowORM() *Application_WorkflowORM_Call {
+	return &Application_WorkflowORM_Call{Call: _e.mock.On("WorkflowORM")}
+}
+
+func (_c *Application_WorkflowORM_Call) Run(run func()) *Application_WorkflowORM_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_WorkflowORM_Call) Return(_a0 store.Store) *Application_WorkflowORM_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_WorkflowORM_Call) RunAndReturn(run func() store.Store) *Application_WorkflowORM_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WorkflowReplayer provides a mock function with no fields
+func (_m *Application) WorkflowReplayer() *workflows.ExecutionReplayer {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for WorkflowReplayer")
+	}
+
+	var r0 *workflows.ExecutionReplayer
+	if rf, ok := ret.Get(0).(func() *workflows.ExecutionReplayer); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*workflows.ExecutionReplayer)
+		}
+	}
+
+	return r0
+}
+
+// Application_WorkflowReplayer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WorkflowReplayer'
+type Application_WorkflowReplayer_Call struct {
+	*mock.Call
+}
+
+// WorkflowReplayer is a helper method to define mock.On call
+func (_e *Application_Expecter) WorkflowReplayer() *Application_WorkflowReplayer_Call {
+	return &Application_WorkflowReplayer_Call{Call: _e.mock.On("WorkflowReplayer")}
+}
+
+func (_c *Application_WorkflowReplayer_Call) Run(run func()) *Application_WorkflowReplayer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_WorkflowReplayer_Call) Return(_a0 *workflows.ExecutionReplayer) *Application_WorkflowReplayer_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_WorkflowReplayer_Call) RunAndReturn(run func() *workflows.ExecutionReplayer) *Application_WorkflowReplayer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewApplication creates a new instance of Application. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewApplication(t interface {
@@ -51,7 +51,12 @@ const (
 	KeyExported EventID = "KEY_EXPORTED"
 	KeyDeleted  EventID = "KEY_DELETED"
 
+	KeystorePasswordRotateAttemptFailedMismatch EventID = "KEYSTORE_PASSWORD_ROTATE_ATTEMPT_FAILED_MISMATCH"
+	KeystorePasswordRotated                     EventID = "KEYSTORE_PASSWORD_ROTATED"
+
 	EthTransactionCreated    EventID = "ETH_TRANSACTION_CREATED"
+	EthTransactionCancelled  EventID = "ETH_TRANSACTION_CANCELLED"
+	EthTransactionSpedUp     EventID = "ETH_TRANSACTION_SPED_UP"
 	CosmosTransactionCreated EventID = "COSMOS_TRANSACTION_CREATED"
 	SolanaTransactionCreated EventID = "SOLANA_TRANSACTION_CREATED"
 
@@ -79,6 +79,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/sessions"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions/ldapauth"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions/localauth"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/oidcauth"
 	"github.com/smartcontractkit/chainlink/v2/core/static"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/evm/types"
 	evmutils "github.com/smartcontractkit/chainlink/v2/evm/utils"
@@ -115,10 +116,14 @@ type Application interface {
 	BasicAdminUsersORM() sessions.BasicAdminUsersORM
 	AuthenticationProvider() sessions.AuthenticationProvider
 	TxmStorageService() txmgr.EvmTxStore
+	WorkflowORM() workflowstore.Store
+	WorkflowReplayer() *workflows.ExecutionReplayer
 	AddJobV2(ctx context.Context, job *job.Job) error
 	DeleteJob(ctx context.Context, jobID int32) error
 	RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta jsonserializable.JSONSerializable) (int64, error)
 	ResumeJobV2(ctx context.Context, taskID uuid.UUID, result pipeline.Result) error
+	// SimulateJobV2 executes a pipeline in-memory against the stubbed responses of sim, nothing is persisted or sent.
+	SimulateJobV2(ctx context.Context, spec pipeline.Spec, vars map[string]interface{}, sim *pipeline.Simulation) (*pipeline.Run, pipeline.TaskRunResults, error)
 	// Testing only
 	RunJobV2(ctx context.Context, jobID int32, meta map[string]interface{}) (int64, error)
 
@@ -153,6 +158,8 @@ type ChainlinkApplication struct {
 	localAdminUsersORM       sessions.BasicAdminUsersORM
 	authenticationProvider   sessions.AuthenticationProvider
 	txmStorageService        txmgr.EvmTxStore
+	workflowORM              workflowstore.Store
+	workflowReplayer         *workflows.ExecutionReplayer
 	FeedsService             feeds.Service
 	webhookJobRunner         webhook.JobRunner
 	Config                   GeneralConfig
@@ -165,6 +172,7 @@ type ChainlinkApplication struct {
 	logger                   logger.SugaredLogger
 	AuditLogger              audit.AuditLogger
 	closeLogger              func() error
+	closeSigner              func() error
 	ds                       sqlutil.DataSource
 	secretGenerator          SecretGenerator
 	profiler                 *pyroscope.Profiler
@@ -184,6 +192,7 @@ type ApplicationOpts struct {
 	RelayerChainInteroperators *CoreRelayerChainInteroperators
 	AuditLogger                audit.AuditLogger
 	CloseLogger                func() error
+	CloseSigner                func() error
 	ExternalInitiatorManager   webhook.ExternalInitiatorManager
 	Version                    string
 	RestrictedHTTPClient       *http.Client
@@ -290,6 +299,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 	if err != nil {
 		return nil, fmt.Errorf("could not instantiate workflow rate limiter: %w", err)
 	}
+	workflowReplayer := workflows.NewExecutionReplayer(workflowstore.NewDBStore(opts.DS, globalLogger, clockwork.NewRealClock()))
 
 	var gatewayConnectorWrapper *gatewayconnector.ServiceWrapper
 	if cfg.Capabilities().GatewayConnector().DonID() != "" {
@@ -403,6 +413,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 							MaxConfigSize:  uint64(cfg.Capabilities().WorkflowRegistry().MaxConfigSize()),
 						},
 					),
+					syncer.WithExecutionReplayer(workflowReplayer),
 				)
 
 				globalLogger.Debugw("Creating WorkflowRegistrySyncer")
@@ -433,7 +444,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 	// we need to initialize in case we serve OCR2 LOOPs
 	loopRegistry := opts.LoopRegistry
 	if loopRegistry == nil {
-		beholderAuthHeaders, csaPubKeyHex, err := keystore.BuildBeholderAuth(keyStore)
+		beholderAuthHeaders, csaPubKeyHex, err := keystore.BuildBeholderAuth(context.Background(), keyStore)
 		if err != nil {
 			return nil, fmt.Errorf("could not build Beholder auth: %w", err)
 		}
@@ -522,11 +533,29 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		syncer := ldapauth.NewLDAPServerStateSyncer(opts.DS, cfg.WebServer().LDAP(), globalLogger)
 		srvcs = append(srvcs, syncer)
 		sessionReaper = utils.NewSleeperTaskCtx(syncer)
+	case sessions.OIDCAuth:
+		var err error
+		authenticationProvider, err = oidcauth.NewOIDCAuthenticator(
+			opts.DS, cfg.WebServer().OIDC(), cfg.Insecure().DevWebServer(), globalLogger, auditLogger,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "NewApplication: failed to initialize OIDC Authentication module")
+		}
+		sessionReaper = oidcauth.NewSessionReaper(opts.DS, cfg.WebServer().OIDC(), globalLogger)
 	case sessions.LocalAuth:
 		authenticationProvider = localauth.NewORM(opts.DS, cfg.WebServer().SessionTimeout().Duration(), globalLogger, auditLogger)
 		sessionReaper = localauth.NewSessionReaper(opts.DS, cfg.WebServer(), globalLogger)
 	default:
-		return nil, errors.Errorf("NewApplication: Unexpected 'AuthenticationMethod': %s supported values: %s, %s", authMethod, sessions.LocalAuth, sessions.LDAPAuth)
+		return nil, errors.Errorf("NewApplication: Unexpected 'AuthenticationMethod': %s supported values: %s, %s, %s", authMethod, sessions.LocalAuth, sessions.LDAPAuth, sessions.OIDCAuth)
+	}
+
+	workflowExecutions := cfg.Capabilities().WorkflowExecutions()
+	workflowRetention := workflowstore.RetentionConfig{
+		ReaperInterval:           workflowExecutions.ReaperInterval(),
+		ReaperThreshold:          workflowExecutions.ReaperThreshold(),
+		FailedReaperThreshold:    workflowExecutions.FailedReaperThreshold(),
+		MaxExecutionsPerWorkflow: workflowExecutions.MaxExecutionsPerWorkflow(),
+		ReaperBatchSize:          workflowExecutions.ReaperBatchSize(),
 	}
 
 	var (
@@ -537,7 +566,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		jobORM         = job.NewORM(opts.DS, pipelineORM, bridgeORM, keyStore, globalLogger)
 		txmORM         = txmgr.NewTxStore(opts.DS, globalLogger)
 		streamRegistry = streams.NewRegistry(globalLogger, pipelineRunner)
-		workflowORM    = workflowstore.NewDBStore(opts.DS, globalLogger, clockwork.NewRealClock())
+		workflowORM    = workflowstore.NewDBStore(opts.DS, globalLogger, clockwork.NewRealClock(), workflowstore.WithRetention(workflowRetention))
 	)
 	srvcs = append(srvcs, workflowORM)
 
@@ -619,6 +648,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		opts.CapabilitiesRegistry,
 		workflowORM,
 		workflowRateLimiter,
+		workflowReplayer,
 	)
 
 	// Flux monitor requires ethereum just to boot, silence errors with a null delegate
@@ -794,6 +824,8 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		localAdminUsersORM:       localAdminUsersORM,
 		authenticationProvider:   authenticationProvider,
 		txmStorageService:        txmORM,
+		workflowORM:              workflowORM,
+		workflowReplayer:         workflowReplayer,
 		FeedsService:             feedsService,
 		Config:                   cfg,
 		webhookJobRunner:         webhookJobRunner,
@@ -804,6 +836,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		logger:                   globalLogger,
 		AuditLogger:              auditLogger,
 		closeLogger:              opts.CloseLogger,
+		closeSigner:              opts.CloseSigner,
 		secretGenerator:          opts.SecretGenerator,
 		profiler:                 profiler,
 		loopRegistry:             loopRegistry,
@@ -927,6 +960,10 @@ func (app *ChainlinkApplication) stop() (err error) {
 			app.logger.Debug("Closing Feeds Service...")
 			err = multierr.Append(err, app.FeedsService.Close())
 		}
+		if app.closeSigner != nil {
+			app.logger.Debug("Closing keystore signer...")
+			err = multierr.Append(err, app.closeSigner())
+		}
 
 		if app.profiler != nil {
 			err = multierr.Append(err, app.profiler.Stop())
@@ -992,6 +1029,14 @@ func (app *ChainlinkApplication) TxmStorageService() txmgr.EvmTxStore {
 	return app.txmStorageService
 }
 
+func (app *ChainlinkApplication) WorkflowORM() workflowstore.Store {
+	return app.workflowORM
+}
+
+func (app *ChainlinkApplication) WorkflowReplayer() *workflows.ExecutionReplayer {
+	return app.workflowReplayer
+}
+
 func (app *ChainlinkApplication) GetExternalInitiatorManager() webhook.ExternalInitiatorManager {
 	return app.ExternalInitiatorManager
 }
@@ -1100,6 +1145,19 @@ func (app *ChainlinkApplication) ResumeJobV2(
 	return app.pipelineRunner.ResumeRun(ctx, taskID, result.Value, result.Error)
 }
 
+// SimulateJobV2 implements the Application interface.
+func (app *ChainlinkApplication) SimulateJobV2(
+	ctx context.Context,
+	spec pipeline.Spec,
+	vars map[string]interface{},
+	sim *pipeline.Simulation,
+) (*pipeline.Run, pipeline.TaskRunResults, error) {
+	if sim == nil {
+		sim = pipeline.NewSimulation(nil)
+	}
+	return app.pipelineRunner.ExecuteRun(pipeline.WithSimulation(ctx, sim), spec, pipeline.NewVarsFrom(vars))
+}
+
 func (app *ChainlinkApplication) GetFeedsService() feeds.Service {
 	return app.FeedsService
 }
@@ -397,6 +397,10 @@ func (s *Secrets) SetFrom(f *Secrets) (err error) {
 		err = multierr.Append(err, commonconfig.NamedMultiErrorList(err2, "Threshold"))
 	}
 
+	if err2 := s.Keystore.SetFrom(&f.Keystore); err2 != nil {
+		err = multierr.Append(err, commonconfig.NamedMultiErrorList(err2, "Keystore"))
+	}
+
 	_, err = commonconfig.MultiErrorList(err)
 
 	return err
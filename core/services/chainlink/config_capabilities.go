@@ -1,6 +1,8 @@
 package chainlink
 
 import (
+	"time"
+
 	"github.com/smartcontractkit/chainlink-common/pkg/types"
 	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
@@ -29,6 +31,12 @@ func (c *capabilitiesConfig) WorkflowRegistry() config.CapabilitiesWorkflowRegis
 	}
 }
 
+func (c *capabilitiesConfig) WorkflowExecutions() config.CapabilitiesWorkflowExecutions {
+	return &capabilitiesWorkflowExecutions{
+		c: c.c.WorkflowExecutions,
+	}
+}
+
 func (c *capabilitiesConfig) RateLimit() config.EngineExecutionRateLimit {
 	return &engineExecutionRateLimit{
 		rl: c.c.RateLimit,
@@ -153,6 +161,30 @@ func (c *capabilitiesWorkflowRegistry) MaxConfigSize() utils.FileSize {
 	return *c.c.MaxConfigSize
 }
 
+type capabilitiesWorkflowExecutions struct {
+	c toml.WorkflowExecutions
+}
+
+func (c *capabilitiesWorkflowExecutions) ReaperInterval() time.Duration {
+	return c.c.ReaperInterval.Duration()
+}
+
+func (c *capabilitiesWorkflowExecutions) ReaperThreshold() time.Duration {
+	return c.c.ReaperThreshold.Duration()
+}
+
+func (c *capabilitiesWorkflowExecutions) FailedReaperThreshold() time.Duration {
+	return c.c.FailedReaperThreshold.Duration()
+}
+
+func (c *capabilitiesWorkflowExecutions) MaxExecutionsPerWorkflow() uint32 {
+	return *c.c.MaxExecutionsPerWorkflow
+}
+
+func (c *capabilitiesWorkflowExecutions) ReaperBatchSize() uint32 {
+	return *c.c.ReaperBatchSize
+}
+
 type gatewayConnector struct {
 	c toml.GatewayConnector
 }
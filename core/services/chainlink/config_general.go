@@ -542,4 +542,8 @@ func (g *generalConfig) Telemetry() coreconfig.Telemetry {
 	return &telemetryConfig{s: g.c.Telemetry}
 }
 
+func (g *generalConfig) Keystore() coreconfig.Keystore {
+	return &keystoreConfig{c: g.c.Keystore, s: g.secrets.Keystore}
+}
+
 var zeroSha256Hash = models.Sha256Hash{}
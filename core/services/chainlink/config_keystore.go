@@ -0,0 +1,101 @@
+package chainlink
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
+)
+
+var _ config.Keystore = (*keystoreConfig)(nil)
+
+type keystoreConfig struct {
+	c toml.Keystore
+	s toml.KeystoreSecrets
+}
+
+func (k *keystoreConfig) RemoteSigner() config.RemoteSigner {
+	return &remoteSignerConfig{c: k.c.RemoteSigner}
+}
+
+func (k *keystoreConfig) PKCS11() config.PKCS11 {
+	return &pkcs11Config{c: k.c.PKCS11, s: k.s.PKCS11}
+}
+
+var _ config.RemoteSigner = (*remoteSignerConfig)(nil)
+
+type remoteSignerConfig struct {
+	c toml.RemoteSigner
+}
+
+func (r *remoteSignerConfig) Enabled() bool {
+	return *r.c.Enabled
+}
+
+func (r *remoteSignerConfig) Endpoint() string {
+	if r.c.Endpoint == nil {
+		return ""
+	}
+	return *r.c.Endpoint
+}
+
+func (r *remoteSignerConfig) CACertFile() string {
+	if r.c.CACertFile == nil {
+		return ""
+	}
+	return *r.c.CACertFile
+}
+
+func (r *remoteSignerConfig) ClientCertFile() string {
+	if r.c.ClientCertFile == nil {
+		return ""
+	}
+	return *r.c.ClientCertFile
+}
+
+func (r *remoteSignerConfig) ClientKeyFile() string {
+	if r.c.ClientKeyFile == nil {
+		return ""
+	}
+	return *r.c.ClientKeyFile
+}
+
+func (r *remoteSignerConfig) InsecureConnection() bool {
+	return *r.c.InsecureConnection
+}
+
+func (r *remoteSignerConfig) Timeout() time.Duration {
+	return r.c.Timeout.Duration()
+}
+
+var _ config.PKCS11 = (*pkcs11Config)(nil)
+
+type pkcs11Config struct {
+	c toml.PKCS11
+	s toml.PKCS11Secrets
+}
+
+func (p *pkcs11Config) Enabled() bool {
+	return *p.c.Enabled
+}
+
+func (p *pkcs11Config) Library() string {
+	if p.c.Library == nil {
+		return ""
+	}
+	return *p.c.Library
+}
+
+func (p *pkcs11Config) TokenLabel() string {
+	if p.c.TokenLabel == nil {
+		return ""
+	}
+	return *p.c.TokenLabel
+}
+
+func (p *pkcs11Config) PIN() string {
+	if p.s.PIN == nil {
+		return ""
+	}
+	return string(*p.s.PIN)
+}
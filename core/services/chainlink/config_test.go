@@ -395,6 +395,18 @@ func TestConfig_Marshal(t *testing.T) {
 			UpstreamSyncInterval:        commoncfg.MustNewDuration(0 * time.Second),
 			UpstreamSyncRateLimit:       commoncfg.MustNewDuration(2 * time.Minute),
 		},
+		OIDC: toml.WebServerOIDC{
+			SessionTimeout: commoncfg.MustNewDuration(15 * time.Minute),
+			Issuer:         commoncfg.MustParseURL("https://accounts.example.com"),
+			ClientID:       ptr("chainlink-node"),
+			RedirectURL:    commoncfg.MustParseURL("https://my-chainlink-node.example.com:6688/oidc/callback"),
+			EmailClaim:     ptr("email"),
+			GroupsClaim:    ptr("groups"),
+			AdminUserGroup: ptr("NodeAdmins"),
+			EditUserGroup:  ptr("NodeEditors"),
+			RunUserGroup:   ptr("NodeRunners"),
+			ReadUserGroup:  ptr("NodeReadOnly"),
+		},
 		RateLimit: toml.WebServerRateLimit{
 			Authenticated:         ptr[int64](42),
 			AuthenticatedPeriod:   commoncfg.MustNewDuration(time.Second),
@@ -530,6 +542,13 @@ func TestConfig_Marshal(t *testing.T) {
 				{ID: ptr("example_gateway"), URL: ptr("wss://localhost:8081/node")},
 			},
 		},
+		WorkflowExecutions: toml.WorkflowExecutions{
+			ReaperInterval:           commoncfg.MustNewDuration(time.Minute),
+			ReaperThreshold:          commoncfg.MustNewDuration(24 * time.Hour),
+			FailedReaperThreshold:    commoncfg.MustNewDuration(7 * 24 * time.Hour),
+			MaxExecutionsPerWorkflow: ptr[uint32](1000),
+			ReaperBatchSize:          ptr[uint32](500),
+		},
 	}
 	full.Keeper = toml.Keeper{
 		DefaultTransactionQueueDepth: ptr[uint32](17),
@@ -580,6 +599,22 @@ func TestConfig_Marshal(t *testing.T) {
 		EmitterBatchProcessor: ptr(true),
 		EmitterExportTimeout:  commoncfg.MustNewDuration(1 * time.Second),
 	}
+	full.Keystore = toml.Keystore{
+		RemoteSigner: toml.RemoteSigner{
+			Enabled:            ptr(true),
+			Endpoint:           ptr("signer.example.com:9000"),
+			CACertFile:         ptr("cert-file"),
+			ClientCertFile:     ptr("client-cert-file"),
+			ClientKeyFile:      ptr("client-key-file"),
+			InsecureConnection: ptr(false),
+			Timeout:            commoncfg.MustNewDuration(10 * time.Second),
+		},
+		PKCS11: toml.PKCS11{
+			Enabled:    ptr(false),
+			Library:    ptr("/usr/lib/softhsm/libsofthsm2.so"),
+			TokenLabel: ptr("chainlink"),
+		},
+	}
 	full.EVM = []*evmcfg.EVMConfig{
 		{
 			ChainID: ubig.NewI(1),
@@ -615,6 +650,7 @@ func TestConfig_Marshal(t *testing.T) {
 					PriceDefault:       assets.NewWeiI(math.MaxInt64),
 					PriceMax:           assets.NewWei(mustHexToBig(t, "FFFFFFFFFFFF")),
 					PriceMin:           assets.NewWeiI(13),
+					BlobPriceMax:       assets.NewWeiI(14),
 
 					LimitJobType: evmcfg.GasLimitJobType{
 						OCR:    ptr[uint32](1001),
@@ -991,6 +1027,18 @@ UserAPITokenDuration = '240h0m0s'
 UpstreamSyncInterval = '0s'
 UpstreamSyncRateLimit = '2m0s'
 
+[WebServer.OIDC]
+SessionTimeout = '15m0s'
+Issuer = 'https://accounts.example.com'
+ClientID = 'chainlink-node'
+RedirectURL = 'https://my-chainlink-node.example.com:6688/oidc/callback'
+EmailClaim = 'email'
+GroupsClaim = 'groups'
+AdminUserGroup = 'NodeAdmins'
+EditUserGroup = 'NodeEditors'
+RunUserGroup = 'NodeRunners'
+ReadUserGroup = 'NodeReadOnly'
+
 [WebServer.MFA]
 RPID = 'test-rpid'
 RPOrigin = 'test-rp-origin'
@@ -1157,6 +1205,7 @@ Mode = 'SuggestedPrice'
 PriceDefault = '9.223372036854775807 ether'
 PriceMax = '281.474976710655 micro'
 PriceMin = '13 wei'
+BlobPriceMax = '14 wei'
 LimitDefault = 12
 LimitMax = 17
 LimitMultiplier = '1.234'
@@ -1415,6 +1464,33 @@ func TestConfig_full(t *testing.T) {
 		if got.EVM[c].Workflow.GasLimitDefault == nil {
 			got.EVM[c].Workflow.GasLimitDefault = ptr(uint64(400000))
 		}
+		if got.EVM[c].BalanceMonitor.AlertThreshold == nil {
+			got.EVM[c].BalanceMonitor.AlertThreshold = new(assets.Wei)
+		}
+		if got.EVM[c].BalanceMonitor.AlertWebhookURL == nil {
+			got.EVM[c].BalanceMonitor.AlertWebhookURL = new(commoncfg.URL)
+		}
+		if got.EVM[c].BalanceMonitor.FunderAddress == nil {
+			got.EVM[c].BalanceMonitor.FunderAddress = &addr
+		}
+		if got.EVM[c].BalanceMonitor.TopUpThreshold == nil {
+			got.EVM[c].BalanceMonitor.TopUpThreshold = new(assets.Wei)
+		}
+		if got.EVM[c].BalanceMonitor.TopUpTarget == nil {
+			got.EVM[c].BalanceMonitor.TopUpTarget = new(assets.Wei)
+		}
+		if got.EVM[c].GasEstimator.Composite.Estimators == nil {
+			got.EVM[c].GasEstimator.Composite.Estimators = []string{}
+		}
+		if got.EVM[c].GasEstimator.Composite.Policy == nil {
+			got.EVM[c].GasEstimator.Composite.Policy = ptr("")
+		}
+		if got.EVM[c].GasEstimator.Composite.OutlierPercent == nil {
+			got.EVM[c].GasEstimator.Composite.OutlierPercent = ptr[uint16](0)
+		}
+		if got.EVM[c].GasEstimator.Composite.Weights == nil {
+			got.EVM[c].GasEstimator.Composite.Weights = []uint32{}
+		}
 		for n := range got.EVM[c].Nodes {
 			if got.EVM[c].Nodes[n].WSURL == nil {
 				got.EVM[c].Nodes[n].WSURL = new(commoncfg.URL)
@@ -1435,6 +1511,15 @@ func TestConfig_full(t *testing.T) {
 		if got.EVM[c].Transactions.TransactionManagerV2.DualBroadcast == nil {
 			got.EVM[c].Transactions.TransactionManagerV2.DualBroadcast = ptr(false)
 		}
+		if got.EVM[c].Transactions.AccountAbstraction.Enabled == nil {
+			got.EVM[c].Transactions.AccountAbstraction = evmcfg.AccountAbstractionConfig{
+				Enabled:        ptr(false),
+				BundlerURL:     new(commoncfg.URL),
+				PaymasterURL:   new(commoncfg.URL),
+				EntryPoint:     new(types.EIP55Address),
+				AccountFactory: new(types.EIP55Address),
+			}
+		}
 		if got.EVM[c].Transactions.AutoPurge.Threshold == nil {
 			got.EVM[c].Transactions.AutoPurge.Threshold = ptr(uint32(0))
 		}
@@ -118,6 +118,10 @@ func (w *webServerConfig) LDAP() config.LDAP {
 	return &ldapConfig{c: w.c.LDAP, s: w.s.LDAP}
 }
 
+func (w *webServerConfig) OIDC() config.OIDC {
+	return &oidcConfig{c: w.c.OIDC, s: w.s.OIDC}
+}
+
 func (w *webServerConfig) AuthenticationMethod() string {
 	return *w.c.AuthenticationMethod
 }
@@ -313,3 +317,82 @@ func (l *ldapConfig) UpstreamSyncRateLimit() commonconfig.Duration {
 	}
 	return *l.c.UpstreamSyncRateLimit
 }
+
+type oidcConfig struct {
+	c toml.WebServerOIDC
+	s toml.WebServerOIDCSecrets
+}
+
+func (o *oidcConfig) SessionTimeout() commonconfig.Duration {
+	return *o.c.SessionTimeout
+}
+
+func (o *oidcConfig) Issuer() string {
+	if o.c.Issuer == nil || o.c.Issuer.IsZero() {
+		return ""
+	}
+	return o.c.Issuer.URL().String()
+}
+
+func (o *oidcConfig) ClientID() string {
+	if o.c.ClientID == nil {
+		return ""
+	}
+	return *o.c.ClientID
+}
+
+func (o *oidcConfig) ClientSecret() string {
+	if o.s.ClientSecret == nil {
+		return ""
+	}
+	return string(*o.s.ClientSecret)
+}
+
+func (o *oidcConfig) RedirectURL() string {
+	if o.c.RedirectURL == nil || o.c.RedirectURL.IsZero() {
+		return ""
+	}
+	return o.c.RedirectURL.URL().String()
+}
+
+func (o *oidcConfig) EmailClaim() string {
+	if o.c.EmailClaim == nil {
+		return ""
+	}
+	return *o.c.EmailClaim
+}
+
+func (o *oidcConfig) GroupsClaim() string {
+	if o.c.GroupsClaim == nil {
+		return ""
+	}
+	return *o.c.GroupsClaim
+}
+
+func (o *oidcConfig) AdminUserGroup() string {
+	if o.c.AdminUserGroup == nil {
+		return ""
+	}
+	return *o.c.AdminUserGroup
+}
+
+func (o *oidcConfig) EditUserGroup() string {
+	if o.c.EditUserGroup == nil {
+		return ""
+	}
+	return *o.c.EditUserGroup
+}
+
+func (o *oidcConfig) RunUserGroup() string {
+	if o.c.RunUserGroup == nil {
+		return ""
+	}
+	return *o.c.RunUserGroup
+}
+
+func (o *oidcConfig) ReadUserGroup() string {
+	if o.c.ReadUserGroup == nil {
+		return ""
+	}
+	return *o.c.ReadUserGroup
+}
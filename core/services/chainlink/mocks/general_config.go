@@ -927,6 +927,53 @@ func (_c *GeneralConfig_Keeper_Call) RunAndReturn(run func() config.Keeper) *Gen
 	return _c
 }
 
+// Keystore provides a mock function with no fields
+func (_m *GeneralConfig) Keystore() config.Keystore {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Keystore")
+	}
+
+	var r0 config.Keystore
+	if rf, ok := ret.Get(0).(func() config.Keystore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.Keystore)
+		}
+	}
+
+	return r0
+}
+
+// GeneralConfig_Keystore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Keystore'
+type GeneralConfig_Keystore_Call struct {
+	*mock.Call
+}
+
+// Keystore is a helper method to define mock.On call
+func (_e *GeneralConfig_Expecter) Keystore() *GeneralConfig_Keystore_Call {
+	return &GeneralConfig_Keystore_Call{Call: _e.mock.On("Keystore")}
+}
+
+func (_c *GeneralConfig_Keystore_Call) Run(run func()) *GeneralConfig_Keystore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GeneralConfig_Keystore_Call) Return(_a0 config.Keystore) *GeneralConfig_Keystore_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GeneralConfig_Keystore_Call) RunAndReturn(run func() config.Keystore) *GeneralConfig_Keystore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Log provides a mock function with no fields
 func (_m *GeneralConfig) Log() config.Log {
 	ret := _m.Called()
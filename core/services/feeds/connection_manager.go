@@ -1,6 +1,7 @@
 package feeds
 
 import (
+	"crypto"
 	"crypto/ed25519"
 	"sync"
 
@@ -54,8 +55,8 @@ type ConnectOpts struct {
 	// URI is the URI of the feeds manager
 	URI string
 
-	// Privkey defines the local CSA private key
-	Privkey []byte
+	// Signer signs with the local CSA key
+	Signer crypto.Signer
 
 	// Pubkey defines the Feeds Manager Service's public key
 	Pubkey []byte
@@ -95,7 +96,7 @@ func (mgr *connectionsManager) Connect(opts ConnectOpts) {
 		mgr.lggr.Infow("Connecting to Feeds Manager...", "feedsManagerID", opts.FeedsManagerID)
 
 		clientConn, err := wsrpc.DialWithContext(ctx, opts.URI,
-			wsrpc.WithTransportCreds(opts.Privkey, ed25519.PublicKey(opts.Pubkey)),
+			wsrpc.WithTransportSigner(opts.Signer, ed25519.PublicKey(opts.Pubkey)),
 			wsrpc.WithBlock(),
 			wsrpc.WithLogger(mgr.lggr),
 		)
@@ -241,14 +241,14 @@ func (s *service) RegisterManager(ctx context.Context, params RegisterManagerPar
 		return 0, err
 	}
 
-	privkey, err := s.getCSAPrivateKey()
+	signer, err := s.getCSASigner()
 	if err != nil {
 		return 0, err
 	}
 
 	// Establish a connection
 	mgr.ID = id
-	s.connectFeedManager(ctx, mgr, privkey)
+	s.connectFeedManager(ctx, mgr, signer)
 
 	return id, nil
 }
@@ -1102,7 +1102,7 @@ func (s *service) UpdateSpecDefinition(ctx context.Context, id int64, defn strin
 // Start starts the service.
 func (s *service) Start(ctx context.Context) error {
 	return s.StartOnce("FeedsService", func() error {
-		privkey, err := s.getCSAPrivateKey()
+		signer, err := s.getCSASigner()
 		if err != nil {
 			return err
 		}
@@ -1121,12 +1121,12 @@ func (s *service) Start(ctx context.Context) error {
 			s.lggr.Infof("starting connection to %d feeds managers", len(mgrs))
 			for _, mgr := range mgrs {
 				if mgr.DisabledAt == nil {
-					s.connectFeedManager(ctx, mgr, privkey)
+					s.connectFeedManager(ctx, mgr, signer)
 				}
 			}
 		} else {
 			if mgrs[0].DisabledAt == nil {
-				s.connectFeedManager(ctx, mgrs[0], privkey)
+				s.connectFeedManager(ctx, mgrs[0], signer)
 			}
 		}
 
@@ -1149,11 +1149,11 @@ func (s *service) Close() error {
 }
 
 // connectFeedManager connects to a feeds manager
-func (s *service) connectFeedManager(ctx context.Context, mgr FeedsManager, privkey []byte) {
+func (s *service) connectFeedManager(ctx context.Context, mgr FeedsManager, signer *keystore.CSASigner) {
 	s.connMgr.Connect(ConnectOpts{
 		FeedsManagerID: mgr.ID,
 		URI:            mgr.URI,
-		Privkey:        privkey,
+		Signer:         signer,
 		Pubkey:         mgr.PublicKey,
 		Handlers: &RPCHandlers{
 			feedsManagerID: mgr.ID,
@@ -1169,17 +1169,17 @@ func (s *service) connectFeedManager(ctx context.Context, mgr FeedsManager, priv
 	})
 }
 
-// getCSAPrivateKey gets the server's CSA private key
-func (s *service) getCSAPrivateKey() (privkey []byte, err error) {
+// getCSASigner gets the signer of the server's CSA key
+func (s *service) getCSASigner() (*keystore.CSASigner, error) {
 	// Fetch the server's public key
 	keys, err := s.csaKeyStore.GetAll()
 	if err != nil {
-		return privkey, err
+		return nil, err
 	}
 	if len(keys) < 1 {
-		return privkey, errors.New("CSA key does not exist")
+		return nil, errors.New("CSA key does not exist")
 	}
-	return keys[0].Raw(), nil
+	return keystore.NewCSASigner(s.csaKeyStore, keys[0]), nil
 }
 
 // getWorkflowPublicKey retrieves the server's Workflow public key.
@@ -1483,12 +1483,12 @@ func (s *service) restartConnection(ctx context.Context, mgr FeedsManager) error
 	}
 
 	// Establish a new connection
-	privkey, err := s.getCSAPrivateKey()
+	signer, err := s.getCSASigner()
 	if err != nil {
 		return err
 	}
 
-	s.connectFeedManager(ctx, mgr, privkey)
+	s.connectFeedManager(ctx, mgr, signer)
 
 	return nil
 }
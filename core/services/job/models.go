@@ -941,6 +941,22 @@ func (w *WorkflowSpec) SDKSpec(ctx context.Context) (sdk.WorkflowSpec, error) {
 	return spec, nil
 }
 
+// StepPolicies returns the execution policies of the steps of the workflow, indexed by step ref. They are set in the
+// step definitions of YAML specs, and with reserved step config fields in SDK workflows.
+func (w *WorkflowSpec) StepPolicies(ctx context.Context) (map[string]WorkflowStepPolicy, error) {
+	switch w.SpecType {
+	case YamlSpec, DefaultSpecType:
+		_, policies, err := ParseWorkflowSpecYaml(w.Workflow)
+		return policies, err
+	default:
+		spec, err := w.SDKSpec(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return SDKWorkflowStepPolicies(spec)
+	}
+}
+
 func (w *WorkflowSpec) RawSpec(ctx context.Context) ([]byte, error) {
 	if w.rawSpec != nil {
 		return w.rawSpec, nil
@@ -5,6 +5,8 @@ import (
 
 	"github.com/pelletier/go-toml"
 	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 )
 
 var (
@@ -75,3 +77,35 @@ func ValidateSpec(ts string) (Type, error) {
 
 	return jb.Type, nil
 }
+
+// SimulationSpec extracts the pipeline spec of a job for a simulated run. Only
+// the common job fields are validated since a simulation never starts the job.
+func SimulationSpec(ts string) (pipeline.Spec, error) {
+	var jb Job
+	tree, err := toml.Load(ts)
+	if err != nil {
+		return pipeline.Spec{}, err
+	}
+	err = tree.Unmarshal(&jb)
+	if err != nil {
+		return pipeline.Spec{}, err
+	}
+	if _, ok := jobTypes[jb.Type]; !ok {
+		return pipeline.Spec{}, ErrInvalidJobType
+	}
+	if jb.Pipeline.Source == "" {
+		return pipeline.Spec{}, ErrNoPipelineSpec
+	}
+
+	spec := pipeline.Spec{
+		DotDagSource:      jb.Pipeline.Source,
+		MaxTaskDuration:   jb.MaxTaskDuration,
+		ForwardingAllowed: jb.ForwardingAllowed,
+		JobName:           jb.Name.ValueOrZero(),
+		JobType:           string(jb.Type),
+	}
+	if jb.GasLimit.Valid {
+		spec.GasLimit = &jb.GasLimit.Uint32
+	}
+	return spec, nil
+}
@@ -112,3 +112,42 @@ ds [type=http]
 		})
 	}
 }
+
+func TestSimulationSpec(t *testing.T) {
+	t.Run("missing pipeline spec", func(t *testing.T) {
+		_, err := SimulationSpec(`
+type="webhook"
+schemaVersion=1
+`)
+		require.True(t, errors.Is(errors.Cause(err), ErrNoPipelineSpec))
+	})
+
+	t.Run("invalid job type", func(t *testing.T) {
+		_, err := SimulationSpec(`
+type="blah"
+schemaVersion=1
+observationSource="ds [type=http]"
+`)
+		require.True(t, errors.Is(errors.Cause(err), ErrInvalidJobType))
+	})
+
+	t.Run("happy path", func(t *testing.T) {
+		spec, err := SimulationSpec(`
+type="webhook"
+schemaVersion=1
+name="simulated"
+gasLimit=1000
+forwardingAllowed=true
+observationSource="""
+ds [type=http]
+"""
+`)
+		require.NoError(t, err)
+		require.Contains(t, spec.DotDagSource, "ds [type=http]")
+		require.Equal(t, "simulated", spec.JobName)
+		require.Equal(t, "webhook", spec.JobType)
+		require.True(t, spec.ForwardingAllowed)
+		require.NotNil(t, spec.GasLimit)
+		require.Equal(t, uint32(1000), *spec.GasLimit)
+	})
+}
@@ -0,0 +1,293 @@
+package job
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/workflows"
+	"github.com/smartcontractkit/chainlink-common/pkg/workflows/sdk"
+)
+
+const (
+	// MaxWorkflowStepTimeout is the maximum timeout of a workflow step
+	MaxWorkflowStepTimeout = 10 * time.Minute
+	// MaxWorkflowStepRetries is the maximum number of times a failed workflow step is retried
+	MaxWorkflowStepRetries = 10
+	// MaxWorkflowStepBackoff is the maximum delay between two attempts of a workflow step
+	MaxWorkflowStepBackoff = time.Minute
+	// DefaultWorkflowStepBackoff is the delay before the first retry of a workflow step without backoff
+	DefaultWorkflowStepBackoff = time.Second
+)
+
+// WorkflowStepPolicy is the execution policy of a workflow step, set with the optional timeout, maxRetries and backoff
+// fields of its definition in a YAML workflow spec, e.g.
+//
+//	consensus:
+//	  - id: "offchain_reporting@1.0.0"
+//	    ref: "evm_median"
+//	    timeout: 30s
+//	    maxRetries: 3
+//	    backoff: 2s
+//
+// Steps of SDK workflows set them with reserved fields of their config instead, see SDKWorkflowStepPolicies.
+//
+// Targets can set a timeout, but aren't retried since retrying a write could send it more than once.
+type WorkflowStepPolicy struct {
+	// Timeout of each attempt of the step, 0 for the default step timeout of the engine
+	Timeout time.Duration
+	// MaxRetries is the number of times the step is retried after failing
+	MaxRetries int
+	// Backoff is the delay before the first retry, doubled for each retry after that
+	Backoff time.Duration
+}
+
+const (
+	stepFieldTimeout    = "timeout"
+	stepFieldMaxRetries = "maxRetries"
+	stepFieldBackoff    = "backoff"
+
+	// ReservedStepConfigMaxRetries is the step config field setting the number of retries of a step of an SDK workflow
+	ReservedStepConfigMaxRetries = "cre_step_max_retries"
+	// ReservedStepConfigBackoff is the step config field setting the backoff of a step of an SDK workflow, in seconds
+	ReservedStepConfigBackoff = "cre_step_backoff"
+)
+
+// ParseWorkflowSpecYaml parses a YAML workflow spec, along with the execution policies of its steps indexed by step
+// ref, or by ID for steps without a ref. The policy fields are not part of the workflow spec schema, so they are
+// removed from the spec before it is validated.
+func ParseWorkflowSpecYaml(workflow string) (sdk.WorkflowSpec, map[string]WorkflowStepPolicy, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(workflow), &doc); err != nil || len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		// let the workflow spec parser report the error
+		spec, err := workflows.ParseWorkflowSpecYaml(workflow)
+		return spec, nil, err
+	}
+
+	policies := map[string]WorkflowStepPolicy{}
+	root := doc.Content[0]
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		section, steps := root.Content[i].Value, root.Content[i+1]
+		if steps.Kind != yaml.SequenceNode {
+			continue
+		}
+		switch section {
+		case "triggers", "actions", "consensus", "targets":
+		default:
+			continue
+		}
+		for _, step := range steps.Content {
+			key, policy, err := extractStepPolicy(section, step)
+			if err != nil {
+				return sdk.WorkflowSpec{}, nil, fmt.Errorf("%w: %w", ErrInvalidWorkflowYAMLSpec, err)
+			}
+			if policy == nil {
+				continue
+			}
+			if _, ok := policies[key]; ok {
+				return sdk.WorkflowSpec{}, nil, fmt.Errorf("%w: duplicate step %s", ErrInvalidWorkflowYAMLSpec, key)
+			}
+			policies[key] = *policy
+		}
+	}
+	if len(policies) == 0 {
+		spec, err := workflows.ParseWorkflowSpecYaml(workflow)
+		return spec, nil, err
+	}
+
+	stripped, err := yaml.Marshal(&doc)
+	if err != nil {
+		return sdk.WorkflowSpec{}, nil, err
+	}
+	spec, err := workflows.ParseWorkflowSpecYaml(string(stripped))
+	if err != nil {
+		return sdk.WorkflowSpec{}, nil, err
+	}
+	return spec, policies, nil
+}
+
+// extractStepPolicy removes the policy fields from the definition of a step, and returns its policy along with its
+// ref, or nil if it has none
+func extractStepPolicy(section string, step *yaml.Node) (string, *WorkflowStepPolicy, error) {
+	if step.Kind != yaml.MappingNode {
+		return "", nil, nil
+	}
+	var ref, id string
+	var policy *WorkflowStepPolicy
+	var content []*yaml.Node
+	for i := 0; i+1 < len(step.Content); i += 2 {
+		key, value := step.Content[i], step.Content[i+1]
+		switch key.Value {
+		case "ref":
+			ref = value.Value
+		case "id":
+			if value.Kind == yaml.ScalarNode {
+				id = value.Value
+			}
+		}
+		switch key.Value {
+		case stepFieldTimeout, stepFieldMaxRetries, stepFieldBackoff:
+			if policy == nil {
+				policy = &WorkflowStepPolicy{Backoff: DefaultWorkflowStepBackoff}
+			}
+			if err := decodeStepPolicyField(policy, key.Value, value); err != nil {
+				return "", nil, err
+			}
+		default:
+			content = append(content, key, value)
+		}
+	}
+	if policy == nil {
+		return "", nil, nil
+	}
+	step.Content = content
+
+	if ref == "" {
+		ref = id
+	}
+	if ref == "" {
+		return "", nil, errors.New("steps with a timeout, maxRetries or backoff must have a ref or a string id")
+	}
+	switch section {
+	case "triggers":
+		return "", nil, fmt.Errorf("trigger %s: triggers don't have a timeout, maxRetries or backoff", ref)
+	case "targets":
+		if policy.MaxRetries > 0 {
+			return "", nil, fmt.Errorf("target %s: targets can't be retried, as a retried write could be sent more than once", ref)
+		}
+	}
+	return ref, policy, nil
+}
+
+func decodeStepPolicyField(policy *WorkflowStepPolicy, field string, value *yaml.Node) error {
+	if field == stepFieldMaxRetries {
+		if err := value.Decode(&policy.MaxRetries); err != nil {
+			return fmt.Errorf("invalid %s: %w", field, err)
+		}
+		if policy.MaxRetries < 0 || policy.MaxRetries > MaxWorkflowStepRetries {
+			return fmt.Errorf("invalid %s %d: must be between 0 and %d", field, policy.MaxRetries, MaxWorkflowStepRetries)
+		}
+		return nil
+	}
+
+	var s string
+	if err := value.Decode(&s); err != nil {
+		return fmt.Errorf("invalid %s: %w", field, err)
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return fmt.Errorf("invalid %s: %w", field, err)
+	}
+	switch field {
+	case stepFieldTimeout:
+		if d <= 0 || d > MaxWorkflowStepTimeout {
+			return fmt.Errorf("invalid %s %s: must be greater than 0 and at most %s", field, d, MaxWorkflowStepTimeout)
+		}
+		policy.Timeout = d
+	case stepFieldBackoff:
+		if d < 0 || d > MaxWorkflowStepBackoff {
+			return fmt.Errorf("invalid %s %s: must be between 0 and %s", field, d, MaxWorkflowStepBackoff)
+		}
+		policy.Backoff = d
+	}
+	return nil
+}
+
+// SDKWorkflowStepPolicies returns the execution policies of the steps of an SDK workflow, indexed by step ref, or by ID
+// for steps without a ref. They are set with the following reserved fields of the step config:
+//
+//	cre_step_max_retries: number of times a failed step is retried, up to 10
+//	cre_step_backoff:     delay before the first retry in seconds, doubled for each retry after that, up to 60
+//
+// The timeout of a step is set with the reserved cre_step_timeout field, which is read by the engine.
+func SDKWorkflowStepPolicies(spec sdk.WorkflowSpec) (map[string]WorkflowStepPolicy, error) {
+	policies := map[string]WorkflowStepPolicy{}
+	for _, section := range []struct {
+		name  string
+		steps []sdk.StepDefinition
+	}{{"actions", spec.Actions}, {"consensus", spec.Consensus}, {"targets", spec.Targets}} {
+		for _, step := range section.steps {
+			ref := step.Ref
+			if ref == "" {
+				ref = step.ID
+			}
+			policy, err := sdkStepPolicy(step.Config)
+			if err != nil {
+				return nil, fmt.Errorf("step %s: %w", ref, err)
+			}
+			if policy == nil {
+				continue
+			}
+			if section.name == "targets" && policy.MaxRetries > 0 {
+				return nil, fmt.Errorf("target %s: targets can't be retried, as a retried write could be sent more than once", ref)
+			}
+			policies[ref] = *policy
+		}
+	}
+	if len(policies) == 0 {
+		return nil, nil
+	}
+	return policies, nil
+}
+
+// sdkStepPolicy returns the policy set with the reserved fields of a step config, or nil if it has none
+func sdkStepPolicy(config map[string]any) (*WorkflowStepPolicy, error) {
+	maxRetries, hasMaxRetries := config[ReservedStepConfigMaxRetries]
+	backoff, hasBackoff := config[ReservedStepConfigBackoff]
+	if !hasMaxRetries && !hasBackoff {
+		return nil, nil
+	}
+
+	policy := &WorkflowStepPolicy{Backoff: DefaultWorkflowStepBackoff}
+	if hasMaxRetries {
+		n, err := sdkStepConfigInt(maxRetries)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", ReservedStepConfigMaxRetries, err)
+		}
+		if n < 0 || n > MaxWorkflowStepRetries {
+			return nil, fmt.Errorf("invalid %s %d: must be between 0 and %d", ReservedStepConfigMaxRetries, n, MaxWorkflowStepRetries)
+		}
+		policy.MaxRetries = int(n)
+	}
+	if hasBackoff {
+		n, err := sdkStepConfigInt(backoff)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", ReservedStepConfigBackoff, err)
+		}
+		d := time.Duration(n) * time.Second
+		if n < 0 || d > MaxWorkflowStepBackoff {
+			return nil, fmt.Errorf("invalid %s %d: must be between 0 and %d seconds", ReservedStepConfigBackoff, n, int(MaxWorkflowStepBackoff.Seconds()))
+		}
+		policy.Backoff = d
+	}
+	return policy, nil
+}
+
+// sdkStepConfigInt returns the integer value of a step config field, which may have been decoded as any number type
+func sdkStepConfigInt(v any) (int64, error) {
+	switch n := v.(type) {
+	case int:
+		return int64(n), nil
+	case int32:
+		return int64(n), nil
+	case int64:
+		return n, nil
+	case uint32:
+		return int64(n), nil
+	case uint64:
+		if n > math.MaxInt64 {
+			return 0, fmt.Errorf("%d is too large", n)
+		}
+		return int64(n), nil
+	case float64:
+		if n != math.Trunc(n) || math.Abs(n) >= math.MaxInt64 {
+			return 0, fmt.Errorf("%v is not an integer", n)
+		}
+		return int64(n), nil
+	default:
+		return 0, fmt.Errorf("expected an integer, got %T", v)
+	}
+}
@@ -0,0 +1,152 @@
+package job_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	commonworkflows "github.com/smartcontractkit/chainlink-common/pkg/workflows"
+	"github.com/smartcontractkit/chainlink-common/pkg/workflows/sdk"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+)
+
+func TestParseWorkflowSpecYaml_StepPolicies(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no policies", func(t *testing.T) {
+		spec, policies, err := job.ParseWorkflowSpecYaml(anyYamlSpec)
+		require.NoError(t, err)
+		assert.Empty(t, policies)
+
+		expected, err := commonworkflows.ParseWorkflowSpecYaml(anyYamlSpec)
+		require.NoError(t, err)
+		assert.Equal(t, expected, spec)
+	})
+
+	t.Run("policies are removed from the spec", func(t *testing.T) {
+		withPolicies := strings.Replace(anyYamlSpec, `    ref: "evm_median"`, `    ref: "evm_median"
+    timeout: 30s
+    maxRetries: 3
+    backoff: 2s`, 1)
+		withPolicies = strings.Replace(withPolicies, `  - id: "write_polygon-testnet-mumbai@3.0.0"`, `  - id: "write_polygon-testnet-mumbai@3.0.0"
+    timeout: 45s`, 1)
+
+		spec, policies, err := job.ParseWorkflowSpecYaml(withPolicies)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]job.WorkflowStepPolicy{
+			"evm_median":                         {Timeout: 30 * time.Second, MaxRetries: 3, Backoff: 2 * time.Second},
+			"write_polygon-testnet-mumbai@3.0.0": {Timeout: 45 * time.Second, Backoff: job.DefaultWorkflowStepBackoff},
+		}, policies)
+
+		expected, err := commonworkflows.ParseWorkflowSpecYaml(anyYamlSpec)
+		require.NoError(t, err)
+		assert.Equal(t, expected, spec)
+	})
+
+	tests := []struct {
+		name    string
+		old     string
+		new     string
+		wantErr string
+	}{
+		{
+			name:    "retried target",
+			old:     `  - id: "write_polygon-testnet-mumbai@3.0.0"`,
+			new:     "  - id: \"write_polygon-testnet-mumbai@3.0.0\"\n    maxRetries: 1",
+			wantErr: "targets can't be retried",
+		},
+		{
+			name:    "trigger",
+			old:     `  - id: "mercury-trigger@1.0.0"`,
+			new:     "  - id: \"mercury-trigger@1.0.0\"\n    timeout: 1s",
+			wantErr: "triggers don't have a timeout",
+		},
+		{
+			name:    "too many retries",
+			old:     `    ref: "evm_median"`,
+			new:     "    ref: \"evm_median\"\n    maxRetries: 11",
+			wantErr: "invalid maxRetries 11",
+		},
+		{
+			name:    "timeout too large",
+			old:     `    ref: "evm_median"`,
+			new:     "    ref: \"evm_median\"\n    timeout: 1h",
+			wantErr: "invalid timeout 1h0m0s",
+		},
+		{
+			name:    "invalid backoff",
+			old:     `    ref: "evm_median"`,
+			new:     "    ref: \"evm_median\"\n    backoff: soon",
+			wantErr: "invalid backoff",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, _, err := job.ParseWorkflowSpecYaml(strings.Replace(anyYamlSpec, tc.old, tc.new, 1))
+			require.ErrorIs(t, err, job.ErrInvalidWorkflowYAMLSpec)
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestSDKWorkflowStepPolicies(t *testing.T) {
+	t.Parallel()
+
+	spec := sdk.WorkflowSpec{
+		Triggers: []sdk.StepDefinition{{ID: "mercury-trigger@1.0.0", Config: map[string]any{}}},
+		Actions: []sdk.StepDefinition{
+			{ID: "fetch@1.0.0", Ref: "fetch", Config: map[string]any{job.ReservedStepConfigMaxRetries: float64(3), job.ReservedStepConfigBackoff: int64(2)}},
+			{ID: "other@1.0.0", Ref: "other", Config: map[string]any{"url": "https://example.com"}},
+		},
+		Consensus: []sdk.StepDefinition{{ID: "offchain_reporting@1.0.0", Config: map[string]any{job.ReservedStepConfigMaxRetries: uint64(1)}}},
+		Targets:   []sdk.StepDefinition{{ID: "write@1.0.0", Config: map[string]any{job.ReservedStepConfigMaxRetries: 0}}},
+	}
+	policies, err := job.SDKWorkflowStepPolicies(spec)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]job.WorkflowStepPolicy{
+		"fetch":                    {MaxRetries: 3, Backoff: 2 * time.Second},
+		"offchain_reporting@1.0.0": {MaxRetries: 1, Backoff: job.DefaultWorkflowStepBackoff},
+		"write@1.0.0":              {Backoff: job.DefaultWorkflowStepBackoff},
+	}, policies)
+
+	policies, err = job.SDKWorkflowStepPolicies(sdk.WorkflowSpec{Actions: []sdk.StepDefinition{{Ref: "fetch"}}})
+	require.NoError(t, err)
+	assert.Empty(t, policies)
+
+	tests := []struct {
+		name    string
+		spec    sdk.WorkflowSpec
+		wantErr string
+	}{
+		{
+			name:    "retried target",
+			spec:    sdk.WorkflowSpec{Targets: []sdk.StepDefinition{{ID: "write@1.0.0", Config: map[string]any{job.ReservedStepConfigMaxRetries: 1}}}},
+			wantErr: "targets can't be retried",
+		},
+		{
+			name:    "too many retries",
+			spec:    sdk.WorkflowSpec{Actions: []sdk.StepDefinition{{Ref: "fetch", Config: map[string]any{job.ReservedStepConfigMaxRetries: 11}}}},
+			wantErr: "invalid cre_step_max_retries 11",
+		},
+		{
+			name:    "backoff too large",
+			spec:    sdk.WorkflowSpec{Actions: []sdk.StepDefinition{{Ref: "fetch", Config: map[string]any{job.ReservedStepConfigBackoff: 61}}}},
+			wantErr: "invalid cre_step_backoff 61",
+		},
+		{
+			name:    "invalid backoff",
+			spec:    sdk.WorkflowSpec{Actions: []sdk.StepDefinition{{Ref: "fetch", Config: map[string]any{job.ReservedStepConfigBackoff: "2s"}}}},
+			wantErr: "invalid cre_step_backoff: expected an integer",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := job.SDKWorkflowStepPolicies(tc.spec)
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
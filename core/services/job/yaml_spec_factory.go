@@ -5,7 +5,6 @@ import (
 	"crypto/sha256"
 	"fmt"
 
-	"github.com/smartcontractkit/chainlink-common/pkg/workflows"
 	"github.com/smartcontractkit/chainlink-common/pkg/workflows/sdk"
 )
 
@@ -14,7 +13,7 @@ type YAMLSpecFactory struct{}
 var _ WorkflowSpecFactory = (*YAMLSpecFactory)(nil)
 
 func (y YAMLSpecFactory) Spec(_ context.Context, workflow, _ string) (sdk.WorkflowSpec, []byte, string, error) {
-	spec, err := workflows.ParseWorkflowSpecYaml(workflow)
+	spec, _, err := ParseWorkflowSpecYaml(workflow)
 	return spec, []byte(workflow), fmt.Sprintf("%x", sha256.Sum256([]byte(workflow))), err
 }
 
@@ -1,19 +1,32 @@
 package keystore
 
 import (
+	"context"
 	"encoding/hex"
+	"fmt"
+)
 
-	"github.com/smartcontractkit/chainlink-common/pkg/beholder"
+// beholderAuthHeaderKey and beholderAuthHeaderVersion are the header name and format version of beholder.BuildAuthHeaders
+const (
+	beholderAuthHeaderKey     = "X-Beholder-Node-Auth-Token"
+	beholderAuthHeaderVersion = "1"
 )
 
-func BuildBeholderAuth(keyStore Master) (authHeaders map[string]string, pubKeyHex string, err error) {
+// BuildBeholderAuth returns the auth headers built by beholder.BuildAuthHeaders, but has the CSA keystore sign the
+// public key so that a CSA key held by the Signer can be used.
+func BuildBeholderAuth(ctx context.Context, keyStore Master) (authHeaders map[string]string, pubKeyHex string, err error) {
 	csaKeys, err := keyStore.CSA().GetAll()
 	if err != nil {
 		return nil, "", err
 	}
 	csaKey := csaKeys[0]
-	csaPrivKey := csaKey.Raw().Bytes()
-	authHeaders = beholder.BuildAuthHeaders(csaPrivKey)
+	signature, err := keyStore.CSA().Sign(ctx, csaKey.ID(), csaKey.PublicKey)
+	if err != nil {
+		return nil, "", err
+	}
 	pubKeyHex = hex.EncodeToString(csaKey.PublicKey)
+	authHeaders = map[string]string{
+		beholderAuthHeaderKey: fmt.Sprintf("%s:%s:%x", beholderAuthHeaderVersion, pubKeyHex, signature),
+	}
 	return
 }
@@ -0,0 +1,33 @@
+package keystore_test
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/beholder"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/csakey"
+	ksmocks "github.com/smartcontractkit/chainlink/v2/core/services/keystore/mocks"
+)
+
+func Test_BuildBeholderAuth(t *testing.T) {
+	key := cltest.DefaultCSAKey
+	csa := ksmocks.NewCSA(t)
+	csa.On("GetAll").Return([]csakey.KeyV2{key}, nil)
+	csa.On("Sign", mock.Anything, key.ID(), []byte(key.PublicKey)).Return(ed25519.Sign(key.PrivateKey(), key.PublicKey), nil)
+	keyStore := ksmocks.NewMaster(t)
+	keyStore.On("CSA").Return(csa)
+
+	authHeaders, pubKeyHex, err := keystore.BuildBeholderAuth(testutils.Context(t), keyStore)
+	require.NoError(t, err)
+	assert.Equal(t, beholder.BuildAuthHeaders(key.PrivateKey()), authHeaders)
+	assert.Equal(t, hex.EncodeToString(key.PublicKey), pubKeyHex)
+}
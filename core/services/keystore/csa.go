@@ -2,7 +2,10 @@ package keystore
 
 import (
 	"context"
+	"crypto"
+	"crypto/ed25519"
 	"fmt"
+	"io"
 
 	"github.com/pkg/errors"
 
@@ -22,6 +25,7 @@ type CSA interface {
 	Import(ctx context.Context, keyJSON []byte, password string) (csakey.KeyV2, error)
 	Export(id string, password string) ([]byte, error)
 	EnsureKey(ctx context.Context) error
+	Sign(ctx context.Context, id string, msg []byte) (signature []byte, err error)
 }
 
 type csa struct {
@@ -45,6 +49,8 @@ func (ks *csa) Get(id string) (csakey.KeyV2, error) {
 	return ks.getByID(id)
 }
 
+// GetAll returns the CSA keys of the key ring and the ones held by the Signer. The private key of the latter is not
+// available, so they must be used with Sign or NewCSASigner.
 func (ks *csa) GetAll() (keys []csakey.KeyV2, _ error) {
 	ks.lock.RLock()
 	defer ks.lock.RUnlock()
@@ -54,6 +60,9 @@ func (ks *csa) GetAll() (keys []csakey.KeyV2, _ error) {
 	for _, key := range ks.keyRing.CSA {
 		keys = append(keys, key)
 	}
+	for _, key := range ks.signerKeys.CSA {
+		keys = append(keys, key)
+	}
 	return keys, nil
 }
 
@@ -66,7 +75,7 @@ func (ks *csa) Create(ctx context.Context) (csakey.KeyV2, error) {
 	// Ensure you can only have one CSA at a time. This is a temporary
 	// restriction until we are able to handle multiple CSA keys in the
 	// communication channel
-	if len(ks.keyRing.CSA) > 0 {
+	if ks.count() > 0 {
 		return csakey.KeyV2{}, ErrCSAKeyExists
 	}
 	key, err := csakey.NewV2()
@@ -82,7 +91,7 @@ func (ks *csa) Add(ctx context.Context, key csakey.KeyV2) error {
 	if ks.isLocked() {
 		return ErrLocked
 	}
-	if len(ks.keyRing.CSA) > 0 {
+	if ks.count() > 0 {
 		return ErrCSAKeyExists
 	}
 	return ks.safeAddKey(ctx, key)
@@ -98,6 +107,9 @@ func (ks *csa) Delete(ctx context.Context, id string) (csakey.KeyV2, error) {
 	if err != nil {
 		return csakey.KeyV2{}, err
 	}
+	if _, ok := ks.signerKeys.signerID(id); ok {
+		return csakey.KeyV2{}, ErrSignerKey
+	}
 
 	err = ks.safeRemoveKey(ctx, key)
 
@@ -130,6 +142,9 @@ func (ks *csa) Export(id string, password string) ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
+	if _, ok := ks.signerKeys.signerID(id); ok {
+		return nil, ErrSignerKey
+	}
 	return key.ToEncryptedJSON(password, ks.scryptParams)
 }
 
@@ -141,7 +156,7 @@ func (ks *csa) EnsureKey(ctx context.Context) error {
 		return ErrLocked
 	}
 
-	if len(ks.keyRing.CSA) > 0 {
+	if ks.count() > 0 {
 		return nil
 	}
 
@@ -155,10 +170,82 @@ func (ks *csa) EnsureKey(ctx context.Context) error {
 	return ks.safeAddKey(ctx, key)
 }
 
+// Sign signs the message with the CSA key, or has the Signer sign it if it holds the key
+func (ks *csa) Sign(ctx context.Context, id string, msg []byte) ([]byte, error) {
+	key, signerID, err := ks.getSigningKey(id)
+	if err != nil {
+		return nil, err
+	}
+	if signerID == "" {
+		return ed25519.Sign(key.PrivateKey(), msg), nil
+	}
+	signature, err := ks.signer.Sign(ctx, signerID, msg)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sign with signer")
+	}
+	// check the signature, so a misbehaving signer can't have the node authenticate with another key
+	if !ed25519.Verify(key.PublicKey, msg, signature) {
+		return nil, errors.Errorf("signature from signer does not verify against CSA key %s", key.ID())
+	}
+	return signature, nil
+}
+
+// getSigningKey returns the CSA key, and its ID in the Signer if it holds it. Signing is done after the lock is
+// released, so that the keystore isn't blocked while the Signer is called remotely.
+func (ks *csa) getSigningKey(id string) (key csakey.KeyV2, signerID string, err error) {
+	ks.lock.RLock()
+	defer ks.lock.RUnlock()
+	if ks.isLocked() {
+		return key, "", ErrLocked
+	}
+	key, err = ks.getByID(id)
+	if err != nil {
+		return key, "", err
+	}
+	signerID, _ = ks.signerKeys.signerID(id)
+	return key, signerID, nil
+}
+
+// count returns the number of CSA keys, including the ones held by the Signer
+// caller must hold lock!
+func (ks *csa) count() int {
+	return len(ks.keyRing.CSA) + len(ks.signerKeys.CSA)
+}
+
 func (ks *csa) getByID(id string) (csakey.KeyV2, error) {
 	key, found := ks.keyRing.CSA[id]
+	if !found {
+		key, found = ks.signerKeys.CSA[id]
+	}
 	if !found {
 		return csakey.KeyV2{}, KeyNotFoundError{ID: id, KeyType: "CSA"}
 	}
 	return key, nil
 }
+
+// CSASigner signs with a CSA key through the CSA keystore, so the transports authenticated by the CSA key work with the
+// keys held by the Signer as well as with the ones of the key ring.
+type CSASigner struct {
+	ks  CSA
+	key csakey.KeyV2
+}
+
+var _ crypto.Signer = &CSASigner{}
+
+// NewCSASigner returns a crypto.Signer of the CSA key
+func NewCSASigner(ks CSA, key csakey.KeyV2) *CSASigner {
+	return &CSASigner{ks: ks, key: key}
+}
+
+func (s *CSASigner) Public() crypto.PublicKey {
+	return s.key.PublicKey
+}
+
+// Sign signs the message, as ed25519 keys sign the message rather than its digest. The TLS handshakes calling it are
+// not context aware, so its requests are only bounded by the timeout of the Signer.
+func (s *CSASigner) Sign(_ io.Reader, msg []byte, opts crypto.SignerOpts) ([]byte, error) {
+	if opts.HashFunc() != crypto.Hash(0) {
+		return nil, errors.New("ed25519: cannot sign hashed message")
+	}
+	return s.ks.Sign(context.Background(), s.key.ID(), msg)
+}
@@ -117,6 +117,9 @@ func (ks *eth) getAll(ctx context.Context) (keys []ethkey.KeyV2) {
 	for _, key := range ks.keyRing.Eth {
 		keys = append(keys, key)
 	}
+	for _, key := range ks.signerKeys.Eth {
+		keys = append(keys, key)
+	}
 	sort.Slice(keys, func(i, j int) bool { return keys[i].Cmp(keys[j]) < 0 })
 	return
 }
@@ -128,7 +131,7 @@ func (ks *eth) Create(ctx context.Context, chainIDs ...*big.Int) (ethkey.KeyV2,
 	if ks.isLocked() {
 		return ethkey.KeyV2{}, ErrLocked
 	}
-	key, err := ethkey.NewV2()
+	key, err := ks.newKey(ctx)
 	if err != nil {
 		return ethkey.KeyV2{}, err
 	}
@@ -156,7 +159,7 @@ func (ks *eth) EnsureKeys(ctx context.Context, chainIDs ...*big.Int) (err error)
 		if len(keys) > 0 {
 			continue
 		}
-		newKey, err := ethkey.NewV2()
+		newKey, err := ks.newKey(ctx)
 		if err != nil {
 			return err
 		}
@@ -181,7 +184,7 @@ func (ks *eth) Import(ctx context.Context, keyJSON []byte, password string, chai
 		return ethkey.KeyV2{}, errors.Wrap(err, "EthKeyStore#ImportKey failed to decrypt key")
 	}
 	key := ethkey.FromPrivateKey(dKey.PrivateKey)
-	if _, found := ks.keyRing.Eth[key.ID()]; found {
+	if _, err = ks.getByID(key.ID()); err == nil {
 		return ethkey.KeyV2{}, ErrKeyExists
 	}
 	err = ks.add(ctx, key, chainIDs...)
@@ -202,15 +205,17 @@ func (ks *eth) Export(ctx context.Context, id string, password string) ([]byte,
 	if err != nil {
 		return nil, err
 	}
+	if _, ok := ks.signerKeys.signerID(id); ok {
+		return nil, ErrSignerKey
+	}
 	return key.ToEncryptedJSON(password, ks.scryptParams)
 }
 
 func (ks *eth) Add(ctx context.Context, address common.Address, chainID *big.Int) error {
 	ks.lock.Lock()
 	defer ks.lock.Unlock()
-	_, found := ks.keyRing.Eth[address.Hex()]
-	if !found {
-		return ErrKeyNotFound
+	if _, err := ks.getByID(address.Hex()); err != nil {
+		return err
 	}
 	return ks.addKey(ctx, nil, address, chainID)
 }
@@ -238,9 +243,8 @@ func (ks *eth) addKey(ctx context.Context, ds sqlutil.DataSource, address common
 func (ks *eth) Enable(ctx context.Context, address common.Address, chainID *big.Int) error {
 	ks.lock.Lock()
 	defer ks.lock.Unlock()
-	_, found := ks.keyRing.Eth[address.Hex()]
-	if !found {
-		return ErrKeyNotFound
+	if _, err := ks.getByID(address.Hex()); err != nil {
+		return err
 	}
 	return ks.enable(ctx, address, chainID)
 }
@@ -267,8 +271,7 @@ func (ks *eth) enable(ctx context.Context, address common.Address, chainID *big.
 func (ks *eth) Disable(ctx context.Context, address common.Address, chainID *big.Int) error {
 	ks.lock.Lock()
 	defer ks.lock.Unlock()
-	_, found := ks.keyRing.Eth[address.Hex()]
-	if !found {
+	if _, err := ks.getByID(address.Hex()); err != nil {
 		return errors.Errorf("no key exists with ID %s", address.Hex())
 	}
 	return ks.disable(ctx, address, chainID)
@@ -302,6 +305,9 @@ func (ks *eth) Delete(ctx context.Context, id string) (ethkey.KeyV2, error) {
 	if err != nil {
 		return ethkey.KeyV2{}, err
 	}
+	if _, ok := ks.signerKeys.signerID(id); ok {
+		return ethkey.KeyV2{}, ErrSignerKey
+	}
 	err = ks.safeRemoveKey(ctx, key, func(ds sqlutil.DataSource) error {
 		_, err2 := ds.ExecContext(ctx, `DELETE FROM evm.key_states WHERE address = $1`, key.Address)
 		return err2
@@ -332,16 +338,18 @@ func (ks *eth) SubscribeToKeyChanges(ctx context.Context) (ch chan struct{}, uns
 }
 
 func (ks *eth) SignTx(ctx context.Context, address common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
-	ks.lock.RLock()
-	defer ks.lock.RUnlock()
-	if ks.isLocked() {
-		return nil, ErrLocked
-	}
-	key, err := ks.getByID(address.String())
+	key, signerID, err := ks.getSigningKey(address)
 	if err != nil {
 		return nil, err
 	}
 	signer := types.LatestSignerForChainID(chainID)
+	if signerID != "" {
+		signature, err := signDigest(ctx, ks.signer, signerID, key.Address, signer.Hash(tx).Bytes())
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to sign tx")
+		}
+		return tx.WithSignature(signer, signature)
+	}
 	return types.SignTx(tx, signer, key.ToEcdsaPrivKey())
 }
 
@@ -416,14 +424,7 @@ func (ks *eth) CheckEnabled(ctx context.Context, address common.Address, chainID
 	if ks.isLocked() {
 		return ErrLocked
 	}
-	var found bool
-	for _, k := range ks.keyRing.Eth {
-		if k.Address == address {
-			found = true
-			break
-		}
-	}
-	if !found {
+	if _, err := ks.getByID(address.Hex()); err != nil {
 		return errors.Errorf("no eth key exists with address %s", address.String())
 	}
 	states := ks.keyStates.KeyIDChainID[address.String()]
@@ -559,15 +560,13 @@ func (ks *eth) XXXTestingOnlyAdd(ctx context.Context, key ethkey.KeyV2) {
 // SignMessage signs the provided message using the private key associated with the given address,
 // following the EIP-191 specific identifier (e.g., keccak256("\x19Ethereum Signed Message:\n"${message length}${message}))
 func (ks *eth) SignMessage(ctx context.Context, address common.Address, data []byte) ([]byte, error) {
-	ks.lock.RLock()
-	defer ks.lock.RUnlock()
-	if ks.isLocked() {
-		return nil, ErrLocked
-	}
-	key, err := ks.getByID(address.Hex())
+	key, signerID, err := ks.getSigningKey(address)
 	if err != nil {
 		return nil, err
 	}
+	if signerID != "" {
+		return signDigest(ctx, ks.signer, signerID, key.Address, accounts.TextHash(data))
+	}
 	signature, err := crypto.Sign(accounts.TextHash(data), key.ToEcdsaPrivKey())
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to sign data")
@@ -575,9 +574,28 @@ func (ks *eth) SignMessage(ctx context.Context, address common.Address, data []b
 	return signature, nil
 }
 
+// getSigningKey returns the key of the address, and its ID in the Signer if it holds it. Signing is done after the lock
+// is released, so that the keystore isn't blocked while the Signer is called remotely.
+func (ks *eth) getSigningKey(address common.Address) (key ethkey.KeyV2, signerID string, err error) {
+	ks.lock.RLock()
+	defer ks.lock.RUnlock()
+	if ks.isLocked() {
+		return key, "", ErrLocked
+	}
+	key, err = ks.getByID(address.Hex())
+	if err != nil {
+		return key, "", err
+	}
+	signerID, _ = ks.signerKeys.signerID(key.ID())
+	return key, signerID, nil
+}
+
 // caller must hold lock!
 func (ks *eth) getByID(id string) (ethkey.KeyV2, error) {
 	key, found := ks.keyRing.Eth[id]
+	if !found {
+		key, found = ks.signerKeys.Eth[id]
+	}
 	if !found {
 		return ethkey.KeyV2{}, ErrKeyNotFound
 	}
@@ -597,7 +615,7 @@ func (ks *eth) keysForChain(chainID *big.Int, includeDisabled bool) (keys []ethk
 	}
 	for keyID, state := range states {
 		if includeDisabled || !state.Disabled {
-			k := ks.keyRing.Eth[keyID]
+			k, _ := ks.getByID(keyID)
 			keys = append(keys, k)
 		}
 	}
@@ -605,16 +623,35 @@ func (ks *eth) keysForChain(chainID *big.Int, includeDisabled bool) (keys []ethk
 	return keys
 }
 
+// newKey generates a new key, with the signer if it creates keys
+// caller must hold lock!
+func (ks *eth) newKey(ctx context.Context) (ethkey.KeyV2, error) {
+	if !ks.createsSignerKeys() {
+		return ethkey.NewV2()
+	}
+	id, err := ks.createSignerKey(ctx, SignerKeyTypeEth)
+	if err != nil {
+		return ethkey.KeyV2{}, err
+	}
+	return ks.signerKeys.Eth[id], nil
+}
+
 // caller must hold lock!
 func (ks *eth) add(ctx context.Context, key ethkey.KeyV2, chainIDs ...*big.Int) (err error) {
-	err = ks.safeAddKey(ctx, key, func(tx sqlutil.DataSource) (serr error) {
+	addStates := func(tx sqlutil.DataSource) (serr error) {
 		for _, chainID := range chainIDs {
 			if serr = ks.addKey(ctx, tx, key.Address, chainID); serr != nil {
 				return serr
 			}
 		}
 		return nil
-	})
+	}
+	if _, ok := ks.signerKeys.signerID(key.ID()); ok {
+		// keys held by the signer are not in the key ring, only their states are saved
+		err = sqlutil.TransactDataSource(ctx, ks.ds, nil, addStates)
+	} else {
+		err = ks.safeAddKey(ctx, key, addStates)
+	}
 	if len(chainIDs) > 0 {
 		ks.notify()
 	}
@@ -19,7 +19,7 @@ func mustNewEthKey(t *testing.T) *ethkey.KeyV2 {
 }
 
 func ExposedNewMaster(t *testing.T, ds sqlutil.DataSource) *master {
-	return newMaster(ds, utils.FastScryptParams, logger.TestLogger(t))
+	return newMaster(ds, utils.FastScryptParams, nil, logger.TestLogger(t))
 }
 
 func (m *master) ExportedSave(ctx context.Context) error {
@@ -31,6 +31,7 @@ func (m *master) ExportedSave(ctx context.Context) error {
 func (m *master) ResetXXXTestOnly() {
 	m.keyRing = newKeyRing()
 	m.keyStates = newKeyStates()
+	m.signerKeys = signerKeys{}
 	m.password = ""
 }
 
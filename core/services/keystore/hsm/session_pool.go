@@ -0,0 +1,144 @@
+package hsm
+
+import (
+	"context"
+	"sync"
+
+	"github.com/miekg/pkcs11"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// maxSessions is the maximum number of sessions open with the token, as tokens limit how many a client may open
+const maxSessions = 8
+
+// sessionPool is a pool of logged in sessions with the token, which are each used by a single operation at a time.
+//
+// Sessions which became invalid, such as when the token was reset or briefly disconnected, are closed and the operation
+// is retried once with a new session. When the token was removed, all the idle sessions are closed as well, and the
+// token is looked up again by the next session to open.
+type sessionPool struct {
+	lggr logger.Logger
+	// open opens a new session and logs into the token
+	open func() (pkcs11.SessionHandle, error)
+	// close closes a session, ignoring errors as it may already be invalid
+	close func(pkcs11.SessionHandle)
+	// reset forgets the slot of the token, after it was removed
+	reset func()
+
+	sem  chan struct{}
+	mu   sync.Mutex
+	idle []pkcs11.SessionHandle
+}
+
+func newSessionPool(lggr logger.Logger, size int, open func() (pkcs11.SessionHandle, error), closeFn func(pkcs11.SessionHandle), reset func()) *sessionPool {
+	return &sessionPool{
+		lggr:  lggr,
+		open:  open,
+		close: closeFn,
+		reset: reset,
+		sem:   make(chan struct{}, size),
+	}
+}
+
+// withSession calls fn with a session of the pool, and retries it once with a new session if the session was invalid,
+// as the idle sessions may be invalid as well.
+func (p *sessionPool) withSession(ctx context.Context, fn func(pkcs11.SessionHandle) error) error {
+	for retried := false; ; retried = true {
+		session, err := p.get(ctx, retried)
+		if err != nil {
+			return err
+		}
+		err = fn(session)
+		if !isSessionError(err) {
+			p.put(session)
+			return err
+		}
+		p.discard(session, err)
+		if retried {
+			return err
+		}
+		p.lggr.Warnw("PKCS#11 session is invalid, retrying with a new session", "err", err)
+	}
+}
+
+// get returns an idle session, or opens a new one if there is none or it must be new, waiting for one to be put back
+// if the pool is full
+func (p *sessionPool) get(ctx context.Context, fresh bool) (pkcs11.SessionHandle, error) {
+	select {
+	case p.sem <- struct{}{}:
+	case <-ctx.Done():
+		return 0, errors.Wrap(ctx.Err(), "waiting for a PKCS#11 session")
+	}
+	p.mu.Lock()
+	if n := len(p.idle); n > 0 && !fresh {
+		session := p.idle[n-1]
+		p.idle = p.idle[:n-1]
+		p.mu.Unlock()
+		return session, nil
+	}
+	p.mu.Unlock()
+
+	session, err := p.open()
+	if err != nil {
+		<-p.sem
+		return 0, err
+	}
+	return session, nil
+}
+
+// put puts back the session got from the pool
+func (p *sessionPool) put(session pkcs11.SessionHandle) {
+	p.mu.Lock()
+	p.idle = append(p.idle, session)
+	p.mu.Unlock()
+	<-p.sem
+}
+
+// discard closes the invalid session got from the pool, and all the idle sessions if the token was removed
+func (p *sessionPool) discard(session pkcs11.SessionHandle, err error) {
+	p.close(session)
+	if isTokenRemoved(err) {
+		p.mu.Lock()
+		for _, idle := range p.idle {
+			p.close(idle)
+		}
+		p.idle = nil
+		p.reset()
+		p.mu.Unlock()
+	}
+	<-p.sem
+}
+
+// closeIdle closes the idle sessions of the pool
+func (p *sessionPool) closeIdle() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, idle := range p.idle {
+		p.close(idle)
+	}
+	p.idle = nil
+}
+
+// isSessionError returns whether the error is due to the session being invalid, rather than to the operation
+func isSessionError(err error) bool {
+	var rv pkcs11.Error
+	if !errors.As(err, &rv) {
+		return false
+	}
+	switch rv {
+	case pkcs11.CKR_SESSION_HANDLE_INVALID, pkcs11.CKR_SESSION_CLOSED, pkcs11.CKR_USER_NOT_LOGGED_IN:
+		return true
+	}
+	return isTokenRemoved(err)
+}
+
+// isTokenRemoved returns whether the error is due to the token being removed, which invalidates all its sessions
+func isTokenRemoved(err error) bool {
+	var rv pkcs11.Error
+	if !errors.As(err, &rv) {
+		return false
+	}
+	return rv == pkcs11.CKR_DEVICE_REMOVED || rv == pkcs11.CKR_TOKEN_NOT_PRESENT
+}
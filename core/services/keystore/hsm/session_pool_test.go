@@ -0,0 +1,176 @@
+package hsm
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/miekg/pkcs11"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// testToken stands in for a token, opening numbered sessions
+type testToken struct {
+	mu      sync.Mutex
+	next    pkcs11.SessionHandle
+	open    map[pkcs11.SessionHandle]bool
+	resets  int
+	openErr error
+}
+
+func newTestSessionPool(t *testing.T, size int) (*sessionPool, *testToken) {
+	token := &testToken{open: map[pkcs11.SessionHandle]bool{}}
+	pool := newSessionPool(logger.TestLogger(t), size, func() (pkcs11.SessionHandle, error) {
+		token.mu.Lock()
+		defer token.mu.Unlock()
+		if token.openErr != nil {
+			return 0, token.openErr
+		}
+		token.next++
+		token.open[token.next] = true
+		return token.next, nil
+	}, func(session pkcs11.SessionHandle) {
+		token.mu.Lock()
+		defer token.mu.Unlock()
+		delete(token.open, session)
+	}, func() {
+		token.mu.Lock()
+		defer token.mu.Unlock()
+		token.resets++
+	})
+	return pool, token
+}
+
+func (t *testToken) openSessions() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return len(t.open)
+}
+
+func TestSessionPool(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	t.Run("reuses idle sessions", func(t *testing.T) {
+		pool, token := newTestSessionPool(t, 2)
+		var used []pkcs11.SessionHandle
+		for i := 0; i < 3; i++ {
+			require.NoError(t, pool.withSession(ctx, func(session pkcs11.SessionHandle) error {
+				used = append(used, session)
+				return nil
+			}))
+		}
+		assert.Equal(t, []pkcs11.SessionHandle{1, 1, 1}, used)
+		assert.Equal(t, 1, token.openSessions())
+
+		pool.closeIdle()
+		assert.Zero(t, token.openSessions())
+	})
+
+	t.Run("returns operation errors", func(t *testing.T) {
+		pool, token := newTestSessionPool(t, 2)
+		calls := 0
+		err := pool.withSession(ctx, func(pkcs11.SessionHandle) error {
+			calls++
+			return errors.Wrap(pkcs11.Error(pkcs11.CKR_KEY_HANDLE_INVALID), "failed to sign")
+		})
+		require.ErrorContains(t, err, "failed to sign")
+		assert.Equal(t, 1, calls)
+		assert.Equal(t, 1, token.openSessions(), "session must be kept")
+	})
+
+	t.Run("retries with a new session", func(t *testing.T) {
+		pool, token := newTestSessionPool(t, 2)
+		var used []pkcs11.SessionHandle
+		require.NoError(t, pool.withSession(ctx, func(session pkcs11.SessionHandle) error {
+			used = append(used, session)
+			if len(used) == 1 {
+				return errors.Wrap(pkcs11.Error(pkcs11.CKR_SESSION_HANDLE_INVALID), "failed to sign")
+			}
+			return nil
+		}))
+		assert.Equal(t, []pkcs11.SessionHandle{1, 2}, used)
+		assert.Equal(t, 1, token.openSessions(), "invalid session must be closed")
+		assert.Zero(t, token.resets)
+
+		// the retry doesn't use the idle sessions, which may be invalid as well
+		require.NoError(t, pool.withSession(ctx, func(pkcs11.SessionHandle) error {
+			return pool.withSession(ctx, func(pkcs11.SessionHandle) error { return nil })
+		}))
+		used = nil
+		require.NoError(t, pool.withSession(ctx, func(session pkcs11.SessionHandle) error {
+			used = append(used, session)
+			if len(used) == 1 {
+				return pkcs11.Error(pkcs11.CKR_SESSION_CLOSED)
+			}
+			return nil
+		}))
+		assert.Equal(t, []pkcs11.SessionHandle{2, 4}, used)
+
+		calls := 0
+		err := pool.withSession(ctx, func(pkcs11.SessionHandle) error {
+			calls++
+			return pkcs11.Error(pkcs11.CKR_USER_NOT_LOGGED_IN)
+		})
+		require.Error(t, err)
+		assert.Equal(t, 2, calls, "must only retry once")
+	})
+
+	t.Run("reconnects to removed token", func(t *testing.T) {
+		pool, token := newTestSessionPool(t, 2)
+		// leave two idle sessions
+		require.NoError(t, pool.withSession(ctx, func(pkcs11.SessionHandle) error {
+			return pool.withSession(ctx, func(pkcs11.SessionHandle) error { return nil })
+		}))
+		require.Equal(t, 2, token.openSessions())
+
+		var used []pkcs11.SessionHandle
+		require.NoError(t, pool.withSession(ctx, func(session pkcs11.SessionHandle) error {
+			used = append(used, session)
+			if len(used) == 1 {
+				return pkcs11.Error(pkcs11.CKR_DEVICE_REMOVED)
+			}
+			return nil
+		}))
+		assert.Equal(t, pkcs11.SessionHandle(3), used[1], "idle sessions of the removed token must not be used")
+		assert.Equal(t, 1, token.openSessions())
+		assert.Equal(t, 1, token.resets)
+	})
+
+	t.Run("fails to open sessions", func(t *testing.T) {
+		pool, token := newTestSessionPool(t, 1)
+		token.openErr = errors.New("failed to log into PKCS#11 token")
+		require.ErrorContains(t, pool.withSession(ctx, func(pkcs11.SessionHandle) error { return nil }), "failed to log into PKCS#11 token")
+
+		token.openErr = nil
+		require.NoError(t, pool.withSession(ctx, func(pkcs11.SessionHandle) error { return nil }), "pool must not leak failed sessions")
+	})
+
+	t.Run("waits for sessions when full", func(t *testing.T) {
+		pool, _ := newTestSessionPool(t, 1)
+		release := make(chan struct{})
+		done := make(chan error)
+		go func() {
+			done <- pool.withSession(ctx, func(pkcs11.SessionHandle) error {
+				<-release
+				return nil
+			})
+		}()
+		// wait for the pool to be full
+		require.Eventually(t, func() bool { return len(pool.sem) == 1 }, time.Second, time.Millisecond)
+
+		timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+		require.ErrorIs(t, pool.withSession(timeoutCtx, func(pkcs11.SessionHandle) error { return nil }), context.DeadlineExceeded)
+
+		close(release)
+		require.NoError(t, <-done)
+		require.NoError(t, pool.withSession(ctx, func(pkcs11.SessionHandle) error { return nil }))
+	})
+}
@@ -0,0 +1,551 @@
+package hsm
+
+import (
+	"bytes"
+	"context"
+	cryptorand "crypto/rand"
+	"encoding/asn1"
+	"encoding/hex"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/miekg/pkcs11"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+	"golang.org/x/crypto/curve25519"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+)
+
+// PKCS#11 v3.0 constants of the Edwards curves, which are not defined by the pkcs11 package
+const (
+	ckmECEdwardsKeyPairGen = 0x00001055
+	ckmEdDSA               = 0x00001057
+)
+
+// Labels of the objects of the keys in the token
+const (
+	labelEth          = "chainlink-eth"
+	labelOCR2Onchain  = "chainlink-ocr2-onchain"
+	labelOCR2Offchain = "chainlink-ocr2-offchain"
+	labelOCR2Config   = "chainlink-ocr2-config"
+)
+
+const (
+	idSize                = 16
+	secp256k1PublicKeyLen = 65
+	ed25519PublicKeyLen   = 32
+)
+
+var (
+	oidSecp256k1 = asn1.ObjectIdentifier{1, 3, 132, 0, 10}
+	oidEd25519   = asn1.ObjectIdentifier{1, 3, 101, 112}
+
+	secp256k1N     = crypto.S256().Params().N
+	secp256k1HalfN = new(big.Int).Rsh(secp256k1N, 1)
+)
+
+var _ keystore.SignerKeyCreator = &Signer{}
+
+// Signer is a keystore.SignerKeyCreator holding Eth keys and EVM OCR2 key bundles in a PKCS#11 token, such as an HSM.
+//
+// The secp256k1 Eth keys and OCR2 onchain keys, and the ed25519 OCR2 offchain keys, are generated by the token as
+// sensitive and non-extractable keys, and only sign in the token. Tokens rarely support X25519, so the config
+// encryption key of OCR2 key bundles, which only decrypts the shared secrets of OCR2 configs, is generated by the node
+// and stored in a private data object of the token, which can only be read after login.
+//
+// The objects of a key share a random CKA_ID, which is the ID of the key in the signer, and are told apart by their
+// CKA_LABEL.
+//
+// Operations run concurrently, each with a session of a pool, which reopens sessions and logs in again when the token
+// invalidated them.
+type Signer struct {
+	lggr       logger.Logger
+	ctx        *pkcs11.Ctx
+	tokenLabel string
+	pin        string
+	sessions   *sessionPool
+
+	slotMu  sync.Mutex
+	slot    uint
+	hasSlot bool
+
+	keysMu sync.RWMutex
+	keys   map[string]keystore.SignerKey
+}
+
+// NewSigner loads the PKCS#11 library and logs into the configured token
+func NewSigner(cfg config.PKCS11, lggr logger.Logger) (*Signer, error) {
+	if cfg.PIN() == "" {
+		return nil, errors.New("Keystore.PKCS11.PIN secret is required")
+	}
+	ctx := pkcs11.New(cfg.Library())
+	if ctx == nil {
+		return nil, errors.Errorf("failed to load PKCS#11 library %s", cfg.Library())
+	}
+	s := &Signer{
+		lggr:       lggr.Named("HSM"),
+		ctx:        ctx,
+		tokenLabel: cfg.TokenLabel(),
+		pin:        cfg.PIN(),
+		keys:       map[string]keystore.SignerKey{},
+	}
+	s.sessions = newSessionPool(s.lggr, maxSessions, s.openSession, s.closeSession, s.resetSlot)
+	if err := s.ctx.Initialize(); err != nil && !errors.Is(err, pkcs11.Error(pkcs11.CKR_CRYPTOKI_ALREADY_INITIALIZED)) {
+		ctx.Destroy()
+		return nil, errors.Wrap(err, "failed to initialize PKCS#11 library")
+	}
+	// log in with a first session, to fail early on an invalid token or PIN
+	if err := s.sessions.withSession(context.Background(), func(pkcs11.SessionHandle) error { return nil }); err != nil {
+		err = multierr.Combine(err, s.ctx.Finalize())
+		ctx.Destroy()
+		return nil, err
+	}
+	s.lggr.Infow("Logged into PKCS#11 token", "token", s.tokenLabel, "slot", s.slot)
+	return s, nil
+}
+
+// openSession opens a session with the token, looking it up first if its slot is unknown, and logs into it. Logging
+// in applies to all the sessions with the token, so that it is only required by the first one.
+func (s *Signer) openSession() (pkcs11.SessionHandle, error) {
+	s.slotMu.Lock()
+	defer s.slotMu.Unlock()
+	if !s.hasSlot {
+		slot, err := s.findSlot(s.tokenLabel)
+		if err != nil {
+			return 0, err
+		}
+		s.slot, s.hasSlot = slot, true
+	}
+	session, err := s.ctx.OpenSession(s.slot, pkcs11.CKF_SERIAL_SESSION|pkcs11.CKF_RW_SESSION)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to open session with PKCS#11 token %q", s.tokenLabel)
+	}
+	if err = s.ctx.Login(session, pkcs11.CKU_USER, s.pin); err != nil && !errors.Is(err, pkcs11.Error(pkcs11.CKR_USER_ALREADY_LOGGED_IN)) {
+		return 0, multierr.Combine(errors.Wrapf(err, "failed to log into PKCS#11 token %q", s.tokenLabel), s.ctx.CloseSession(session))
+	}
+	return session, nil
+}
+
+func (s *Signer) closeSession(session pkcs11.SessionHandle) {
+	if err := s.ctx.CloseSession(session); err != nil {
+		s.lggr.Debugw("Failed to close PKCS#11 session", "err", err)
+	}
+}
+
+// resetSlot has the token looked up again by the next session, as it may be in another slot once reconnected
+func (s *Signer) resetSlot() {
+	s.slotMu.Lock()
+	defer s.slotMu.Unlock()
+	s.lggr.Warnw("PKCS#11 token was removed, looking it up again", "token", s.tokenLabel, "slot", s.slot)
+	s.hasSlot = false
+}
+
+func (s *Signer) findSlot(tokenLabel string) (uint, error) {
+	slots, err := s.ctx.GetSlotList(true)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to list PKCS#11 slots")
+	}
+	for _, slot := range slots {
+		info, err := s.ctx.GetTokenInfo(slot)
+		if err != nil {
+			return 0, errors.Wrapf(err, "failed to get info of the token in PKCS#11 slot %d", slot)
+		}
+		if info.Label == tokenLabel {
+			return slot, nil
+		}
+	}
+	return 0, errors.Errorf("no PKCS#11 token labeled %q", tokenLabel)
+}
+
+// Close closes the sessions with the token, which logs out of it, and unloads the PKCS#11 library
+func (s *Signer) Close() error {
+	s.sessions.closeIdle()
+	var err error
+	s.slotMu.Lock()
+	if s.hasSlot {
+		err = s.ctx.CloseAllSessions(s.slot)
+	}
+	s.slotMu.Unlock()
+	err = multierr.Combine(err, s.ctx.Finalize())
+	s.ctx.Destroy()
+	return err
+}
+
+func (s *Signer) Keys(ctx context.Context) (list []keystore.SignerKey, err error) {
+	err = s.sessions.withSession(ctx, func(session pkcs11.SessionHandle) error {
+		list, err = s.keysOf(session)
+		return err
+	})
+	return
+}
+
+func (s *Signer) keysOf(session pkcs11.SessionHandle) ([]keystore.SignerKey, error) {
+	keys := map[string]keystore.SignerKey{}
+	ethKeys, err := s.findObjects(session, pkcs11.CKO_PUBLIC_KEY, labelEth, pkcs11.CKA_EC_POINT)
+	if err != nil {
+		return nil, err
+	}
+	for id, value := range ethKeys {
+		publicKey, err := ecPoint(value, secp256k1PublicKeyLen)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid public key of eth key %s", id)
+		}
+		keys[id] = keystore.SignerKey{ID: id, Type: keystore.SignerKeyTypeEth, PublicKey: publicKey}
+	}
+
+	onchainKeys, err := s.findObjects(session, pkcs11.CKO_PUBLIC_KEY, labelOCR2Onchain, pkcs11.CKA_EC_POINT)
+	if err != nil {
+		return nil, err
+	}
+	offchainKeys, err := s.findObjects(session, pkcs11.CKO_PUBLIC_KEY, labelOCR2Offchain, pkcs11.CKA_EC_POINT)
+	if err != nil {
+		return nil, err
+	}
+	configKeys, err := s.findObjects(session, pkcs11.CKO_DATA, labelOCR2Config, pkcs11.CKA_VALUE)
+	if err != nil {
+		return nil, err
+	}
+	for id, onchainValue := range onchainKeys {
+		offchainValue, hasOffchain := offchainKeys[id]
+		configValue, hasConfig := configKeys[id]
+		if !hasOffchain || !hasConfig {
+			s.lggr.Warnw("Ignoring incomplete OCR2 key bundle", "id", id)
+			continue
+		}
+		key := keystore.SignerKey{ID: id, Type: keystore.SignerKeyTypeOCR2}
+		if key.PublicKey, err = ecPoint(onchainValue, secp256k1PublicKeyLen); err != nil {
+			return nil, errors.Wrapf(err, "invalid onchain public key of OCR2 key bundle %s", id)
+		}
+		if key.OffchainPublicKey, err = ecPoint(offchainValue, ed25519PublicKeyLen); err != nil {
+			return nil, errors.Wrapf(err, "invalid offchain public key of OCR2 key bundle %s", id)
+		}
+		if key.ConfigPublicKey, err = curve25519.X25519(configValue, curve25519.Basepoint); err != nil {
+			return nil, errors.Wrapf(err, "invalid config key of OCR2 key bundle %s", id)
+		}
+		keys[id] = key
+	}
+
+	s.keysMu.Lock()
+	s.keys = keys
+	s.keysMu.Unlock()
+	list := make([]keystore.SignerKey, 0, len(keys))
+	for _, key := range keys {
+		list = append(list, key)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].ID < list[j].ID })
+	return list, nil
+}
+
+func (s *Signer) CreateKey(ctx context.Context, keyType keystore.SignerKeyType) (key keystore.SignerKey, err error) {
+	err = s.sessions.withSession(ctx, func(session pkcs11.SessionHandle) error {
+		key, err = s.createKey(session, keyType)
+		return err
+	})
+	if err != nil {
+		return key, err
+	}
+	s.keysMu.Lock()
+	s.keys[key.ID] = key
+	s.keysMu.Unlock()
+	s.lggr.Infow("Created key in PKCS#11 token", "id", key.ID, "type", keyType)
+	return key, nil
+}
+
+func (s *Signer) createKey(session pkcs11.SessionHandle, keyType keystore.SignerKeyType) (key keystore.SignerKey, err error) {
+	id := make([]byte, idSize)
+	if _, err = cryptorand.Read(id); err != nil {
+		return key, err
+	}
+	key = keystore.SignerKey{ID: hex.EncodeToString(id), Type: keyType}
+	defer func() {
+		if err != nil {
+			// remove the objects of the partially created key
+			err = multierr.Combine(err, s.destroyObjects(session, id))
+		}
+	}()
+
+	switch keyType {
+	case keystore.SignerKeyTypeEth:
+		key.PublicKey, err = s.generateKeyPair(session, id, labelEth, pkcs11.CKM_EC_KEY_PAIR_GEN, oidSecp256k1, secp256k1PublicKeyLen)
+		if err != nil {
+			return key, err
+		}
+	case keystore.SignerKeyTypeOCR2:
+		key.PublicKey, err = s.generateKeyPair(session, id, labelOCR2Onchain, pkcs11.CKM_EC_KEY_PAIR_GEN, oidSecp256k1, secp256k1PublicKeyLen)
+		if err != nil {
+			return key, err
+		}
+		key.OffchainPublicKey, err = s.generateKeyPair(session, id, labelOCR2Offchain, ckmECEdwardsKeyPairGen, oidEd25519, ed25519PublicKeyLen)
+		if err != nil {
+			return key, err
+		}
+		key.ConfigPublicKey, err = s.generateConfigKey(session, id)
+		if err != nil {
+			return key, err
+		}
+	default:
+		return key, errors.Errorf("unsupported key type %q", keyType)
+	}
+	return key, nil
+}
+
+// generateKeyPair generates a non-extractable key pair on the curve, and returns its public key
+func (s *Signer) generateKeyPair(session pkcs11.SessionHandle, id []byte, label string, mechanism uint, curve asn1.ObjectIdentifier, publicKeyLen int) ([]byte, error) {
+	params, err := asn1.Marshal(curve)
+	if err != nil {
+		return nil, err
+	}
+	publicTemplate := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+		pkcs11.NewAttribute(pkcs11.CKA_VERIFY, true),
+		pkcs11.NewAttribute(pkcs11.CKA_EC_PARAMS, params),
+		pkcs11.NewAttribute(pkcs11.CKA_ID, id),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+	}
+	privateTemplate := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+		pkcs11.NewAttribute(pkcs11.CKA_PRIVATE, true),
+		pkcs11.NewAttribute(pkcs11.CKA_SIGN, true),
+		pkcs11.NewAttribute(pkcs11.CKA_SENSITIVE, true),
+		pkcs11.NewAttribute(pkcs11.CKA_EXTRACTABLE, false),
+		pkcs11.NewAttribute(pkcs11.CKA_ID, id),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+	}
+	publicKey, _, err := s.ctx.GenerateKeyPair(session, []*pkcs11.Mechanism{pkcs11.NewMechanism(mechanism, nil)}, publicTemplate, privateTemplate)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to generate %s key pair", label)
+	}
+	attributes, err := s.ctx.GetAttributeValue(session, publicKey, []*pkcs11.Attribute{pkcs11.NewAttribute(pkcs11.CKA_EC_POINT, nil)})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get %s public key", label)
+	}
+	return ecPoint(attributes[0].Value, publicKeyLen)
+}
+
+// generateConfigKey generates an X25519 config encryption key, stored in a private data object, and returns its public
+// key
+func (s *Signer) generateConfigKey(session pkcs11.SessionHandle, id []byte) ([]byte, error) {
+	var scalar [curve25519.ScalarSize]byte
+	if _, err := cryptorand.Read(scalar[:]); err != nil {
+		return nil, err
+	}
+	publicKey, err := curve25519.X25519(scalar[:], curve25519.Basepoint)
+	if err != nil {
+		return nil, err
+	}
+	_, err = s.ctx.CreateObject(session, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_DATA),
+		pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+		pkcs11.NewAttribute(pkcs11.CKA_PRIVATE, true),
+		pkcs11.NewAttribute(pkcs11.CKA_MODIFIABLE, false),
+		pkcs11.NewAttribute(pkcs11.CKA_ID, id),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, labelOCR2Config),
+		pkcs11.NewAttribute(pkcs11.CKA_VALUE, scalar[:]),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to store config encryption key")
+	}
+	return publicKey, nil
+}
+
+func (s *Signer) Sign(ctx context.Context, id string, data []byte) ([]byte, error) {
+	key, ok := s.key(id)
+	if !ok {
+		return nil, errors.Errorf("unknown key %s", id)
+	}
+	var label string
+	switch key.Type {
+	case keystore.SignerKeyTypeEth:
+		label = labelEth
+	case keystore.SignerKeyTypeOCR2:
+		label = labelOCR2Onchain
+	default:
+		return nil, errors.Errorf("unsupported type %q of key %s", key.Type, id)
+	}
+	if len(data) != 32 {
+		return nil, errors.Errorf("invalid digest length %d", len(data))
+	}
+	signature, err := s.sign(ctx, id, label, pkcs11.CKM_ECDSA, data)
+	if err != nil {
+		return nil, err
+	}
+	return ethSignature(data, signature, key.PublicKey)
+}
+
+func (s *Signer) OffchainSign(ctx context.Context, id string, msg []byte) ([]byte, error) {
+	if key, ok := s.key(id); !ok || key.Type != keystore.SignerKeyTypeOCR2 {
+		return nil, errors.Errorf("unknown OCR2 key bundle %s", id)
+	}
+	return s.sign(ctx, id, labelOCR2Offchain, ckmEdDSA, msg)
+}
+
+func (s *Signer) ConfigDiffieHellman(ctx context.Context, id string, point [curve25519.PointSize]byte) (sharedPoint [curve25519.PointSize]byte, err error) {
+	if key, ok := s.key(id); !ok || key.Type != keystore.SignerKeyTypeOCR2 {
+		return sharedPoint, errors.Errorf("unknown OCR2 key bundle %s", id)
+	}
+	decodedID, err := hex.DecodeString(id)
+	if err != nil {
+		return sharedPoint, err
+	}
+	var scalar []byte
+	err = s.sessions.withSession(ctx, func(session pkcs11.SessionHandle) error {
+		handle, err := s.findObject(session, pkcs11.CKO_DATA, decodedID, labelOCR2Config)
+		if err != nil {
+			return err
+		}
+		attributes, err := s.ctx.GetAttributeValue(session, handle, []*pkcs11.Attribute{pkcs11.NewAttribute(pkcs11.CKA_VALUE, nil)})
+		if err != nil {
+			return errors.Wrap(err, "failed to read config encryption key")
+		}
+		scalar = attributes[0].Value
+		return nil
+	})
+	if err != nil {
+		return sharedPoint, err
+	}
+	shared, err := curve25519.X25519(scalar, point[:])
+	if err != nil {
+		return sharedPoint, err
+	}
+	copy(sharedPoint[:], shared)
+	return sharedPoint, nil
+}
+
+func (s *Signer) key(id string) (keystore.SignerKey, bool) {
+	s.keysMu.RLock()
+	defer s.keysMu.RUnlock()
+	key, ok := s.keys[id]
+	return key, ok
+}
+
+// sign signs the data in the token with the private key of the key
+func (s *Signer) sign(ctx context.Context, id string, label string, mechanism uint, data []byte) (signature []byte, err error) {
+	decodedID, err := hex.DecodeString(id)
+	if err != nil {
+		return nil, err
+	}
+	err = s.sessions.withSession(ctx, func(session pkcs11.SessionHandle) error {
+		handle, err := s.findObject(session, pkcs11.CKO_PRIVATE_KEY, decodedID, label)
+		if err != nil {
+			return err
+		}
+		if err = s.ctx.SignInit(session, []*pkcs11.Mechanism{pkcs11.NewMechanism(mechanism, nil)}, handle); err != nil {
+			return errors.Wrapf(err, "failed to sign with %s key %s", label, id)
+		}
+		signature, err = s.ctx.Sign(session, data)
+		if err != nil {
+			return errors.Wrapf(err, "failed to sign with %s key %s", label, id)
+		}
+		return nil
+	})
+	return
+}
+
+// findObject returns the object of the class with the ID and label
+func (s *Signer) findObject(session pkcs11.SessionHandle, class uint, id []byte, label string) (pkcs11.ObjectHandle, error) {
+	handles, err := s.find(session, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, class),
+		pkcs11.NewAttribute(pkcs11.CKA_ID, id),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+	})
+	if err != nil {
+		return 0, err
+	}
+	if len(handles) == 0 {
+		return 0, errors.Errorf("no %s object with ID %x", label, id)
+	}
+	return handles[0], nil
+}
+
+// findObjects returns the attribute of the objects of the class with the label, indexed by their hex encoded ID
+func (s *Signer) findObjects(session pkcs11.SessionHandle, class uint, label string, attribute uint) (map[string][]byte, error) {
+	handles, err := s.find(session, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, class),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+	})
+	if err != nil {
+		return nil, err
+	}
+	objects := map[string][]byte{}
+	for _, handle := range handles {
+		attributes, err := s.ctx.GetAttributeValue(session, handle, []*pkcs11.Attribute{
+			pkcs11.NewAttribute(pkcs11.CKA_ID, nil),
+			pkcs11.NewAttribute(attribute, nil),
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get attributes of %s object", label)
+		}
+		objects[hex.EncodeToString(attributes[0].Value)] = attributes[1].Value
+	}
+	return objects, nil
+}
+
+// destroyObjects destroys all the objects with the ID
+func (s *Signer) destroyObjects(session pkcs11.SessionHandle, id []byte) (err error) {
+	handles, err := s.find(session, []*pkcs11.Attribute{pkcs11.NewAttribute(pkcs11.CKA_ID, id)})
+	if err != nil {
+		return err
+	}
+	for _, handle := range handles {
+		err = multierr.Combine(err, s.ctx.DestroyObject(session, handle))
+	}
+	return err
+}
+
+func (s *Signer) find(session pkcs11.SessionHandle, template []*pkcs11.Attribute) (handles []pkcs11.ObjectHandle, err error) {
+	if err = s.ctx.FindObjectsInit(session, template); err != nil {
+		return nil, errors.Wrap(err, "failed to find objects")
+	}
+	defer func() {
+		err = multierr.Combine(err, s.ctx.FindObjectsFinal(session))
+	}()
+	for {
+		found, _, err := s.ctx.FindObjects(session, 100)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to find objects")
+		}
+		if len(found) == 0 {
+			return handles, nil
+		}
+		handles = append(handles, found...)
+	}
+}
+
+// ecPoint returns the point of a CKA_EC_POINT attribute, which most tokens DER encode as an OCTET STRING
+func ecPoint(value []byte, size int) ([]byte, error) {
+	var point []byte
+	if rest, err := asn1.Unmarshal(value, &point); err == nil && len(rest) == 0 && len(point) == size {
+		return point, nil
+	}
+	if len(value) == size {
+		return value, nil
+	}
+	return nil, errors.Errorf("invalid EC point of %d bytes", len(value))
+}
+
+// ethSignature converts the [R || S] ECDSA signature of the token into an [R || S || V] signature, with the low S value
+// required by Ethereum and the recovery ID of the public key
+func ethSignature(digest []byte, signature []byte, publicKey []byte) ([]byte, error) {
+	if len(signature) != 64 {
+		return nil, errors.Errorf("invalid ECDSA signature length %d", len(signature))
+	}
+	sValue := new(big.Int).SetBytes(signature[32:])
+	if sValue.Cmp(secp256k1HalfN) > 0 {
+		sValue.Sub(secp256k1N, sValue)
+	}
+	ethSig := make([]byte, crypto.SignatureLength)
+	copy(ethSig, signature[:32])
+	sValue.FillBytes(ethSig[32:64])
+	for v := byte(0); v < 2; v++ {
+		ethSig[crypto.RecoveryIDOffset] = v
+		if recovered, err := crypto.Ecrecover(digest, ethSig); err == nil && bytes.Equal(recovered, publicKey) {
+			return ethSig, nil
+		}
+	}
+	return nil, errors.New("ECDSA signature does not recover the public key")
+}
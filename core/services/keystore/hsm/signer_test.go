@@ -0,0 +1,250 @@
+package hsm
+
+import (
+	"crypto/ed25519"
+	cryptorand "crypto/rand"
+	"encoding/asn1"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/miekg/pkcs11"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/curve25519"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+)
+
+const (
+	tokenLabel = "chainlink-test"
+	tokenPIN   = "1234"
+	tokenSOPIN = "5678"
+)
+
+type pkcs11Config struct {
+	library string
+	pin     string
+}
+
+func (c pkcs11Config) Enabled() bool      { return true }
+func (c pkcs11Config) Library() string    { return c.library }
+func (c pkcs11Config) TokenLabel() string { return tokenLabel }
+func (c pkcs11Config) PIN() string        { return c.pin }
+
+// softHSMLibrary returns the path of the SoftHSM library, set with SOFTHSM2_LIB or found in the usual locations, and
+// skips the test if it is not installed
+func softHSMLibrary(t *testing.T) string {
+	paths := []string{
+		os.Getenv("SOFTHSM2_LIB"),
+		"/usr/lib/softhsm/libsofthsm2.so",
+		"/usr/lib/x86_64-linux-gnu/softhsm/libsofthsm2.so",
+		"/usr/local/lib/softhsm/libsofthsm2.so",
+		"/opt/homebrew/lib/softhsm/libsofthsm2.so",
+	}
+	for _, path := range paths {
+		if path == "" {
+			continue
+		}
+		if _, err := os.Stat(path); err == nil {
+			return path
+		}
+	}
+	t.Skip("SoftHSM is not installed, set SOFTHSM2_LIB to the path of libsofthsm2.so")
+	return ""
+}
+
+// newSoftHSMToken initializes a SoftHSM token in a temporary directory
+func newSoftHSMToken(t *testing.T) pkcs11Config {
+	library := softHSMLibrary(t)
+	dir := t.TempDir()
+	conf := filepath.Join(dir, "softhsm2.conf")
+	require.NoError(t, os.WriteFile(conf, []byte(fmt.Sprintf("directories.tokendir = %s\nobjectstore.backend = file\nlog.level = ERROR\n", dir)), 0600))
+	t.Setenv("SOFTHSM2_CONF", conf)
+
+	ctx := pkcs11.New(library)
+	require.NotNil(t, ctx)
+	defer ctx.Destroy()
+	require.NoError(t, ctx.Initialize())
+	defer func() { require.NoError(t, ctx.Finalize()) }()
+
+	slots, err := ctx.GetSlotList(true)
+	require.NoError(t, err)
+	require.NotEmpty(t, slots)
+	require.NoError(t, ctx.InitToken(slots[0], tokenSOPIN, tokenLabel))
+
+	s := &Signer{ctx: ctx}
+	slot, err := s.findSlot(tokenLabel)
+	require.NoError(t, err)
+	session, err := ctx.OpenSession(slot, pkcs11.CKF_SERIAL_SESSION|pkcs11.CKF_RW_SESSION)
+	require.NoError(t, err)
+	require.NoError(t, ctx.Login(session, pkcs11.CKU_SO, tokenSOPIN))
+	require.NoError(t, ctx.InitPIN(session, tokenPIN))
+	require.NoError(t, ctx.Logout(session))
+	require.NoError(t, ctx.CloseSession(session))
+
+	return pkcs11Config{library: library, pin: tokenPIN}
+}
+
+func TestSigner(t *testing.T) {
+	cfg := newSoftHSMToken(t)
+	ctx := testutils.Context(t)
+
+	signer, err := NewSigner(cfg, logger.TestLogger(t))
+	require.NoError(t, err)
+
+	ethKey, err := signer.CreateKey(ctx, keystore.SignerKeyTypeEth)
+	require.NoError(t, err)
+	_, err = crypto.UnmarshalPubkey(ethKey.PublicKey)
+	require.NoError(t, err)
+
+	ocr2Key, err := signer.CreateKey(ctx, keystore.SignerKeyTypeOCR2)
+	require.NoError(t, err)
+
+	_, err = signer.CreateKey(ctx, keystore.SignerKeyTypeCSA)
+	assert.Error(t, err)
+
+	t.Run("sign", func(t *testing.T) {
+		for _, key := range []keystore.SignerKey{ethKey, ocr2Key} {
+			// several signatures to cover both recovery IDs and high S values
+			for i := 0; i < 8; i++ {
+				digest := testutils.MustRandBytes(32)
+				signature, err := signer.Sign(ctx, key.ID, digest)
+				require.NoError(t, err)
+				publicKey, err := crypto.Ecrecover(digest, signature)
+				require.NoError(t, err)
+				assert.Equal(t, key.PublicKey, publicKey)
+				assert.True(t, crypto.ValidateSignatureValues(signature[64], new(big.Int).SetBytes(signature[:32]), new(big.Int).SetBytes(signature[32:64]), true))
+			}
+		}
+		_, err := signer.Sign(ctx, ethKey.ID, []byte("not a digest"))
+		assert.Error(t, err)
+		_, err = signer.Sign(ctx, "unknown", testutils.MustRandBytes(32))
+		assert.Error(t, err)
+	})
+
+	t.Run("offchain sign", func(t *testing.T) {
+		msg := []byte("message")
+		signature, err := signer.OffchainSign(ctx, ocr2Key.ID, msg)
+		require.NoError(t, err)
+		assert.True(t, ed25519.Verify(ocr2Key.OffchainPublicKey, msg, signature))
+
+		_, err = signer.OffchainSign(ctx, ethKey.ID, msg)
+		assert.Error(t, err)
+	})
+
+	t.Run("config diffie hellman", func(t *testing.T) {
+		var scalar [curve25519.ScalarSize]byte
+		_, err := cryptorand.Read(scalar[:])
+		require.NoError(t, err)
+		point, err := curve25519.X25519(scalar[:], curve25519.Basepoint)
+		require.NoError(t, err)
+		expected, err := curve25519.X25519(scalar[:], ocr2Key.ConfigPublicKey)
+		require.NoError(t, err)
+
+		shared, err := signer.ConfigDiffieHellman(ctx, ocr2Key.ID, [curve25519.PointSize]byte(point))
+		require.NoError(t, err)
+		assert.Equal(t, expected, shared[:])
+	})
+
+	t.Run("sign concurrently", func(t *testing.T) {
+		var wg sync.WaitGroup
+		for i := 0; i < 2*maxSessions; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := signer.Sign(ctx, ethKey.ID, testutils.MustRandBytes(32))
+				assert.NoError(t, err)
+			}()
+		}
+		wg.Wait()
+	})
+
+	t.Run("reopen sessions closed by the token", func(t *testing.T) {
+		require.NoError(t, signer.ctx.CloseAllSessions(signer.slot))
+		_, err := signer.Sign(ctx, ethKey.ID, testutils.MustRandBytes(32))
+		require.NoError(t, err)
+		_, err = signer.Keys(ctx)
+		require.NoError(t, err)
+	})
+
+	t.Run("keys are held by the token", func(t *testing.T) {
+		require.NoError(t, signer.Close())
+		signer, err = NewSigner(cfg, logger.TestLogger(t))
+		require.NoError(t, err)
+		t.Cleanup(func() { assert.NoError(t, signer.Close()) })
+
+		keys, err := signer.Keys(ctx)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []keystore.SignerKey{ethKey, ocr2Key}, keys)
+
+		_, err = signer.Sign(ctx, ethKey.ID, testutils.MustRandBytes(32))
+		assert.NoError(t, err)
+	})
+}
+
+func TestNewSigner_invalid(t *testing.T) {
+	cfg := newSoftHSMToken(t)
+
+	_, err := NewSigner(pkcs11Config{library: cfg.library, pin: "wrong"}, logger.TestLogger(t))
+	assert.ErrorContains(t, err, "failed to log into PKCS#11 token")
+	_, err = NewSigner(pkcs11Config{library: cfg.library}, logger.TestLogger(t))
+	assert.ErrorContains(t, err, "PIN secret is required")
+	_, err = NewSigner(pkcs11Config{library: filepath.Join(t.TempDir(), "missing.so"), pin: tokenPIN}, logger.TestLogger(t))
+	assert.ErrorContains(t, err, "failed to load PKCS#11 library")
+}
+
+func Test_ethSignature(t *testing.T) {
+	t.Parallel()
+
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	publicKey := crypto.FromECDSAPub(&privateKey.PublicKey)
+	digest := testutils.MustRandBytes(32)
+	expected, err := crypto.Sign(digest, privateKey)
+	require.NoError(t, err)
+
+	signature, err := ethSignature(digest, expected[:64], publicKey)
+	require.NoError(t, err)
+	assert.Equal(t, expected, signature)
+
+	// tokens may return the high S value of the signature
+	highS := make([]byte, 64)
+	copy(highS, expected[:32])
+	new(big.Int).Sub(secp256k1N, new(big.Int).SetBytes(expected[32:64])).FillBytes(highS[32:])
+	signature, err = ethSignature(digest, highS, publicKey)
+	require.NoError(t, err)
+	assert.Equal(t, expected, signature)
+
+	other, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	_, err = ethSignature(digest, expected[:64], crypto.FromECDSAPub(&other.PublicKey))
+	assert.Error(t, err)
+	_, err = ethSignature(digest, expected, publicKey)
+	assert.Error(t, err)
+}
+
+func Test_ecPoint(t *testing.T) {
+	t.Parallel()
+
+	point := testutils.MustRandBytes(ed25519PublicKeyLen)
+	encoded, err := asn1.Marshal(point)
+	require.NoError(t, err)
+
+	decoded, err := ecPoint(encoded, ed25519PublicKeyLen)
+	require.NoError(t, err)
+	assert.Equal(t, point, decoded)
+
+	decoded, err = ecPoint(point, ed25519PublicKeyLen)
+	require.NoError(t, err)
+	assert.Equal(t, point, decoded)
+
+	_, err = ecPoint(point[1:], ed25519PublicKeyLen)
+	assert.Error(t, err)
+}
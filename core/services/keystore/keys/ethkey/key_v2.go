@@ -66,6 +66,17 @@ func FromPrivateKey(privKey *ecdsa.PrivateKey) (key KeyV2) {
 	}
 }
 
+// FromPublicKey returns a key holding only the public key, for keys held outside of the keystore. It can't sign nor be
+// exported.
+func FromPublicKey(pubKey ecdsa.PublicKey) (key KeyV2) {
+	address := crypto.PubkeyToAddress(pubKey)
+	eip55 := types.EIP55AddressFromAddress(address)
+	return KeyV2{
+		Address:      address,
+		EIP55Address: eip55,
+	}
+}
+
 func (key KeyV2) ID() string {
 	return key.Address.Hex()
 }
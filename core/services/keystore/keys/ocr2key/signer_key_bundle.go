@@ -0,0 +1,179 @@
+package ocr2key
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/blake2b"
+	"golang.org/x/crypto/curve25519"
+	"golang.org/x/crypto/nacl/box"
+	"golang.org/x/crypto/salsa20/salsa"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2/types"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+)
+
+// ErrSignerKeyBundle is returned by the operations requiring the private keys of a bundle held by a BundleSigner
+var ErrSignerKeyBundle = errors.New("the keys of this OCR2 key bundle are held by a signer")
+
+// BundleSigner signs with the keys of an EVM key bundle which are held outside of the node.
+type BundleSigner interface {
+	// SignDigest signs the 32 byte digest with the secp256k1 onchain key, returning a 65 byte [R || S || V] signature
+	SignDigest(digest []byte) ([]byte, error)
+	// OffchainSign signs the message with the ed25519 offchain key
+	OffchainSign(msg []byte) ([]byte, error)
+	// ConfigDiffieHellman multiplies the point by the X25519 config encryption key
+	ConfigDiffieHellman(point [curve25519.PointSize]byte) ([curve25519.PointSize]byte, error)
+}
+
+var _ KeyBundle = &signerKeyBundle{}
+
+type signerKeyBundle struct {
+	id                models.Sha256Hash
+	signingAddress    [20]byte
+	offchainPublicKey ocrtypes.OffchainPublicKey
+	configPublicKey   ocrtypes.ConfigEncryptionPublicKey
+	signer            BundleSigner
+	// verifier only verifies signatures, it has no private key
+	verifier evmKeyring
+}
+
+// NewSignerKeyBundle returns an EVM key bundle from the public keys of a bundle held by the signer, which performs all
+// its operations requiring a private key. Its ID is derived from the public keys, so it is stable across restarts.
+func NewSignerKeyBundle(onchainPublicKey *ecdsa.PublicKey, offchainPublicKey ed25519.PublicKey, configPublicKey [curve25519.PointSize]byte, signer BundleSigner) (KeyBundle, error) {
+	if onchainPublicKey == nil {
+		return nil, errors.New("onchain public key is required")
+	}
+	if len(offchainPublicKey) != ed25519.PublicKeySize {
+		return nil, errors.Errorf("invalid offchain public key length %d", len(offchainPublicKey))
+	}
+	kb := &signerKeyBundle{
+		signingAddress:  crypto.PubkeyToAddress(*onchainPublicKey),
+		configPublicKey: configPublicKey,
+		signer:          signer,
+	}
+	copy(kb.offchainPublicKey[:], offchainPublicKey)
+
+	h := sha256.New()
+	h.Write(kb.signingAddress[:])
+	h.Write(kb.offchainPublicKey[:])
+	h.Write(kb.configPublicKey[:])
+	copy(kb.id[:], h.Sum(nil))
+	return kb, nil
+}
+
+func (kb *signerKeyBundle) ID() string {
+	return hex.EncodeToString(kb.id[:])
+}
+
+func (kb *signerKeyBundle) ChainType() chaintype.ChainType {
+	return chaintype.EVM
+}
+
+func (kb *signerKeyBundle) PublicKey() ocrtypes.OnchainPublicKey {
+	return kb.signingAddress[:]
+}
+
+// OnChainPublicKey returns public component of the keypair used on chain
+func (kb *signerKeyBundle) OnChainPublicKey() string {
+	return hex.EncodeToString(kb.signingAddress[:])
+}
+
+func (kb *signerKeyBundle) Sign(reportCtx ocrtypes.ReportContext, report ocrtypes.Report) ([]byte, error) {
+	return kb.signer.SignDigest(kb.verifier.reportToSigData(reportCtx, report))
+}
+
+func (kb *signerKeyBundle) Sign3(digest types.ConfigDigest, seqNr uint64, r ocrtypes.Report) ([]byte, error) {
+	return kb.signer.SignDigest(kb.verifier.reportToSigData3(digest, seqNr, r))
+}
+
+func (kb *signerKeyBundle) Verify(publicKey ocrtypes.OnchainPublicKey, reportCtx ocrtypes.ReportContext, report ocrtypes.Report, signature []byte) bool {
+	return kb.verifier.Verify(publicKey, reportCtx, report, signature)
+}
+
+func (kb *signerKeyBundle) Verify3(publicKey ocrtypes.OnchainPublicKey, cd ocrtypes.ConfigDigest, seqNr uint64, r ocrtypes.Report, signature []byte) bool {
+	return kb.verifier.Verify3(publicKey, cd, seqNr, r, signature)
+}
+
+func (kb *signerKeyBundle) MaxSignatureLength() int {
+	return kb.verifier.MaxSignatureLength()
+}
+
+func (kb *signerKeyBundle) OffchainSign(msg []byte) ([]byte, error) {
+	return kb.signer.OffchainSign(msg)
+}
+
+func (kb *signerKeyBundle) ConfigDiffieHellman(point [curve25519.PointSize]byte) ([curve25519.PointSize]byte, error) {
+	return kb.signer.ConfigDiffieHellman(point)
+}
+
+func (kb *signerKeyBundle) OffchainPublicKey() ocrtypes.OffchainPublicKey {
+	return kb.offchainPublicKey
+}
+
+func (kb *signerKeyBundle) ConfigEncryptionPublicKey() ocrtypes.ConfigEncryptionPublicKey {
+	return kb.configPublicKey
+}
+
+// NaclBoxOpenAnonymous decrypts a message that was encrypted using the config encryption public key, deriving the box
+// key from the shared point computed by the signer, as box.OpenAnonymous does with the private key.
+func (kb *signerKeyBundle) NaclBoxOpenAnonymous(ciphertext []byte) ([]byte, error) {
+	if len(ciphertext) < box.AnonymousOverhead {
+		return nil, errors.New("ciphertext too short")
+	}
+	var ephemeralPublicKey [curve25519.PointSize]byte
+	copy(ephemeralPublicKey[:], ciphertext[:curve25519.PointSize])
+
+	sharedKey, err := kb.signer.ConfigDiffieHellman(ephemeralPublicKey)
+	if err != nil {
+		return nil, err
+	}
+	var zeros [16]byte
+	salsa.HSalsa20(&sharedKey, &zeros, &sharedKey, &salsa.Sigma)
+
+	h, err := blake2b.New(24, nil)
+	if err != nil {
+		return nil, err
+	}
+	h.Write(ephemeralPublicKey[:])
+	h.Write(kb.configPublicKey[:])
+	var nonce [24]byte
+	h.Sum(nonce[:0])
+
+	decrypted, ok := box.OpenAfterPrecomputation(nil, ciphertext[curve25519.PointSize:], &nonce, &sharedKey)
+	if !ok {
+		return nil, errors.New("decryption failed")
+	}
+	return decrypted, nil
+}
+
+// Marshal fails, as the private keys of the bundle are not available
+func (kb *signerKeyBundle) Marshal() ([]byte, error) {
+	return nil, ErrSignerKeyBundle
+}
+
+// Unmarshal fails, as the private keys of the bundle are not available
+func (kb *signerKeyBundle) Unmarshal([]byte) error {
+	return ErrSignerKeyBundle
+}
+
+// Raw returns nil, as the private keys of the bundle are not available
+func (kb *signerKeyBundle) Raw() Raw {
+	return nil
+}
+
+func (kb *signerKeyBundle) String() string {
+	return fmt.Sprintf("SignerKeyBundle{chainType: %s, id: %s}", kb.ChainType(), kb.ID())
+}
+
+func (kb *signerKeyBundle) GoString() string {
+	return kb.String()
+}
@@ -0,0 +1,99 @@
+package ocr2key
+
+import (
+	"crypto/ed25519"
+	cryptorand "crypto/rand"
+	"math/rand"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/curve25519"
+	"golang.org/x/crypto/nacl/box"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2/types"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
+)
+
+// localBundleSigner signs with the keys of a local bundle
+type localBundleSigner struct {
+	kb *keyBundle[*evmKeyring]
+}
+
+func (s localBundleSigner) SignDigest(digest []byte) ([]byte, error) {
+	return crypto.Sign(digest, &s.kb.keyring.privateKey)
+}
+
+func (s localBundleSigner) OffchainSign(msg []byte) ([]byte, error) {
+	return s.kb.OffchainSign(msg)
+}
+
+func (s localBundleSigner) ConfigDiffieHellman(point [curve25519.PointSize]byte) ([curve25519.PointSize]byte, error) {
+	return s.kb.ConfigDiffieHellman(point)
+}
+
+func TestSignerKeyBundle(t *testing.T) {
+	t.Parallel()
+
+	local := MustNewInsecure(cryptorand.Reader, chaintype.EVM).(*keyBundle[*evmKeyring])
+	offchainPublicKey := local.OffchainPublicKey()
+	kb, err := NewSignerKeyBundle(&local.keyring.privateKey.PublicKey, offchainPublicKey[:], local.ConfigEncryptionPublicKey(), localBundleSigner{local})
+	require.NoError(t, err)
+
+	assert.Equal(t, chaintype.EVM, kb.ChainType())
+	assert.Len(t, kb.ID(), 64)
+	assert.Equal(t, local.OnChainPublicKey(), kb.OnChainPublicKey())
+	assert.Equal(t, local.PublicKey(), kb.PublicKey())
+	assert.Equal(t, local.OffchainPublicKey(), kb.OffchainPublicKey())
+	assert.Equal(t, local.ConfigEncryptionPublicKey(), kb.ConfigEncryptionPublicKey())
+
+	again, err := NewSignerKeyBundle(&local.keyring.privateKey.PublicKey, offchainPublicKey[:], local.ConfigEncryptionPublicKey(), localBundleSigner{local})
+	require.NoError(t, err)
+	assert.Equal(t, kb.ID(), again.ID(), "ID is derived from the public keys")
+
+	t.Run("onchain", func(t *testing.T) {
+		reportCtx := ocrtypes.ReportContext{}
+		report := ocrtypes.Report(testutils.MustRandBytes(rand.Intn(1024)))
+		sig, err := kb.Sign(reportCtx, report)
+		require.NoError(t, err)
+		assert.True(t, local.Verify(kb.PublicKey(), reportCtx, report, sig))
+		assert.True(t, kb.Verify(kb.PublicKey(), reportCtx, report, sig))
+
+		digest, err := types.BytesToConfigDigest(testutils.MustRandBytes(32))
+		require.NoError(t, err)
+		seqNr := rand.Uint64()
+		sig, err = kb.Sign3(digest, seqNr, report)
+		require.NoError(t, err)
+		assert.True(t, local.Verify3(kb.PublicKey(), digest, seqNr, report, sig))
+	})
+
+	t.Run("offchain", func(t *testing.T) {
+		msg := testutils.MustRandBytes(32)
+		sig, err := kb.OffchainSign(msg)
+		require.NoError(t, err)
+		offchainPublicKey := kb.OffchainPublicKey()
+		assert.True(t, ed25519.Verify(offchainPublicKey[:], msg, sig))
+	})
+
+	t.Run("config encryption", func(t *testing.T) {
+		configPublicKey := [curve25519.PointSize]byte(kb.ConfigEncryptionPublicKey())
+		ciphertext, err := box.SealAnonymous(nil, []byte("shared secret"), &configPublicKey, cryptorand.Reader)
+		require.NoError(t, err)
+		plaintext, err := kb.NaclBoxOpenAnonymous(ciphertext)
+		require.NoError(t, err)
+		assert.Equal(t, "shared secret", string(plaintext))
+
+		_, err = kb.NaclBoxOpenAnonymous(ciphertext[:box.AnonymousOverhead-1])
+		assert.Error(t, err)
+	})
+
+	t.Run("private keys are not available", func(t *testing.T) {
+		_, err := kb.Marshal()
+		assert.ErrorIs(t, err, ErrSignerKeyBundle)
+		assert.Nil(t, kb.Raw())
+	})
+}
@@ -36,6 +36,16 @@ func (o *memoryORM) saveEncryptedKeyRing(ctx context.Context, kr *encryptedKeyRi
 	return
 }
 
+func (o *memoryORM) rotateEncryptedKeyRing(ctx context.Context, kr *encryptedKeyRing, verify func(encryptedKeyRing) error) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if err := verify(*kr); err != nil {
+		return err
+	}
+	o.keyRing = kr
+	return nil
+}
+
 func (o *memoryORM) getEncryptedKeyRing(ctx context.Context) (encryptedKeyRing, error) {
 	o.mu.RLock()
 	defer o.mu.RUnlock()
@@ -2,6 +2,7 @@ package keystore
 
 import (
 	"context"
+	"crypto/subtle"
 	"fmt"
 	"math/big"
 	"reflect"
@@ -30,6 +31,8 @@ var (
 	ErrLocked      = errors.New("Keystore is locked")
 	ErrKeyNotFound = errors.New("Key not found")
 	ErrKeyExists   = errors.New("Key already exists")
+	// ErrPasswordMismatch is returned when rotating the password of the keystore with a wrong current password
+	ErrPasswordMismatch = errors.New("Keystore password did not match")
 )
 
 // DefaultEVMChainIDFunc is a func for getting a default evm chain ID -
@@ -51,6 +54,8 @@ type Master interface {
 	Workflow() Workflow
 	Unlock(ctx context.Context, password string) error
 	IsEmpty(ctx context.Context) (bool, error)
+	// RotatePassword re-encrypts the key ring of the unlocked keystore with the new password and scrypt params
+	RotatePassword(ctx context.Context, oldPassword, newPassword string, scryptParams utils.ScryptParams) error
 }
 
 type master struct {
@@ -70,15 +75,21 @@ type master struct {
 }
 
 func New(ds sqlutil.DataSource, scryptParams utils.ScryptParams, lggr logger.Logger) Master {
-	return newMaster(ds, scryptParams, lggr)
+	return newMaster(ds, scryptParams, nil, lggr)
 }
 
-func newMaster(ds sqlutil.DataSource, scryptParams utils.ScryptParams, lggr logger.Logger) *master {
+// NewWithSigner returns a keystore which also provides the keys held by the signer, see Signer.
+func NewWithSigner(ds sqlutil.DataSource, scryptParams utils.ScryptParams, signer Signer, lggr logger.Logger) Master {
+	return newMaster(ds, scryptParams, signer, lggr)
+}
+
+func newMaster(ds sqlutil.DataSource, scryptParams utils.ScryptParams, signer Signer, lggr logger.Logger) *master {
 	orm := NewORM(ds, lggr)
 	km := &keyManager{
 		orm:          orm,
 		keystateORM:  orm,
 		scryptParams: scryptParams,
+		signer:       signer,
 		lock:         &sync.RWMutex{},
 		logger:       lggr.Named("KeyStore"),
 	}
@@ -152,6 +163,7 @@ type ORM interface {
 	isEmpty(context.Context) (bool, error)
 	saveEncryptedKeyRing(context.Context, *encryptedKeyRing, ...func(sqlutil.DataSource) error) error
 	getEncryptedKeyRing(context.Context) (encryptedKeyRing, error)
+	rotateEncryptedKeyRing(context.Context, *encryptedKeyRing, func(encryptedKeyRing) error) error
 }
 
 type keystateORM interface {
@@ -162,8 +174,10 @@ type keyManager struct {
 	orm          ORM
 	keystateORM  keystateORM
 	scryptParams utils.ScryptParams
+	signer       Signer
 	keyRing      *keyRing
 	keyStates    *keyStates
+	signerKeys   signerKeys
 	lock         *sync.RWMutex
 	password     string
 	logger       logger.Logger
@@ -191,8 +205,13 @@ func (km *keyManager) Unlock(ctx context.Context, password string) error {
 	if err != nil {
 		return errors.Wrap(err, "unable to decrypt encrypted key ring")
 	}
+	sk, err := km.loadSignerKeys(ctx, kr)
+	if err != nil {
+		return errors.Wrap(err, "unable to load keys held by the signer")
+	}
 	kr.logPubKeys(km.logger)
 	km.keyRing = kr
+	km.signerKeys = sk
 
 	ks, err := km.keystateORM.loadKeyStates(ctx)
 	if err != nil {
@@ -204,6 +223,45 @@ func (km *keyManager) Unlock(ctx context.Context, password string) error {
 	return nil
 }
 
+// RotatePassword re-encrypts the key ring with the new password and scrypt params. The encrypted key ring is replaced
+// and read back in a single transaction, which is rolled back unless it decrypts with the new password to the same
+// keys, so the keystore is never left encrypted with a password nobody knows. The node keeps running with the keys
+// already unlocked, and must be given the new password the next time it starts.
+func (km *keyManager) RotatePassword(ctx context.Context, oldPassword, newPassword string, scryptParams utils.ScryptParams) error {
+	km.lock.Lock()
+	defer km.lock.Unlock()
+	if km.isLocked() {
+		return ErrLocked
+	}
+	if subtle.ConstantTimeCompare([]byte(oldPassword), []byte(km.password)) != 1 {
+		return ErrPasswordMismatch
+	}
+	if newPassword == "" {
+		return errors.New("new password must not be empty")
+	}
+	ekr, err := km.keyRing.Encrypt(newPassword, scryptParams)
+	if err != nil {
+		return errors.Wrap(err, "unable to encrypt keyRing")
+	}
+	err = km.orm.rotateEncryptedKeyRing(ctx, &ekr, func(saved encryptedKeyRing) error {
+		kr, err := saved.Decrypt(newPassword)
+		if err != nil {
+			return errors.Wrap(err, "unable to decrypt the saved key ring with the new password")
+		}
+		if !km.keyRing.hasSameKeys(kr) {
+			return errors.New("saved key ring does not hold the same keys")
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "unable to rotate keystore password")
+	}
+	km.password = newPassword
+	km.scryptParams = scryptParams
+	km.logger.Info("Rotated keystore password")
+	return nil
+}
+
 // caller must hold lock!
 func (km *keyManager) save(ctx context.Context, callbacks ...func(sqlutil.DataSource) error) error {
 	ekb, err := km.keyRing.Encrypt(km.password, km.scryptParams)
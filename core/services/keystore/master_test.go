@@ -4,12 +4,14 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 func TestMasterKeystore_Unlock_Save(t *testing.T) {
@@ -64,3 +66,33 @@ func TestMasterKeystore_Unlock_Save(t *testing.T) {
 		require.NoError(t, keyStore.Unlock(ctx, cltest.Password))
 	})
 }
+
+func TestMasterKeystore_RotatePassword(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	keyStore := keystore.ExposedNewMaster(t, db)
+	const newPassword = "rotated-keystore-p4SsW0rD"
+
+	require.ErrorIs(t, keyStore.RotatePassword(ctx, cltest.Password, newPassword, utils.FastScryptParams), keystore.ErrLocked)
+
+	require.NoError(t, keyStore.Unlock(ctx, cltest.Password))
+	key, err := keyStore.CSA().Create(ctx)
+	require.NoError(t, err)
+
+	require.ErrorIs(t, keyStore.RotatePassword(ctx, "wrong password", newPassword, utils.FastScryptParams), keystore.ErrPasswordMismatch)
+	require.NoError(t, keyStore.RotatePassword(ctx, cltest.Password, newPassword, utils.FastScryptParams))
+
+	// keys created after the rotation are encrypted with the new password
+	key2, err := keyStore.CSA().Create(ctx)
+	require.NoError(t, err)
+
+	keyStore.ResetXXXTestOnly()
+	require.Error(t, keyStore.Unlock(ctx, cltest.Password))
+	require.NoError(t, keyStore.Unlock(ctx, newPassword))
+	keys, err := keyStore.CSA().GetAll()
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+	assert.ElementsMatch(t, []string{key.ID(), key2.ID()}, []string{keys[0].ID(), keys[1].ID()})
+}
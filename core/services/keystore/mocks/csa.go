@@ -459,6 +459,66 @@ func (_c *CSA_Import_Call) RunAndReturn(run func(context.Context, []byte, string
 	return _c
 }
 
+// Sign provides a mock function with given fields: ctx, id, msg
+func (_m *CSA) Sign(ctx context.Context, id string, msg []byte) ([]byte, error) {
+	ret := _m.Called(ctx, id, msg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Sign")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []byte) ([]byte, error)); ok {
+		return rf(ctx, id, msg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []byte) []byte); ok {
+		r0 = rf(ctx, id, msg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []byte) error); ok {
+		r1 = rf(ctx, id, msg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CSA_Sign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Sign'
+type CSA_Sign_Call struct {
+	*mock.Call
+}
+
+// Sign is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - msg []byte
+func (_e *CSA_Expecter) Sign(ctx interface{}, id interface{}, msg interface{}) *CSA_Sign_Call {
+	return &CSA_Sign_Call{Call: _e.mock.On("Sign", ctx, id, msg)}
+}
+
+func (_c *CSA_Sign_Call) Run(run func(ctx context.Context, id string, msg []byte)) *CSA_Sign_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]byte))
+	})
+	return _c
+}
+
+func (_c *CSA_Sign_Call) Return(signature []byte, err error) *CSA_Sign_Call {
+	_c.Call.Return(signature, err)
+	return _c
+}
+
+func (_c *CSA_Sign_Call) RunAndReturn(run func(context.Context, string, []byte) ([]byte, error)) *CSA_Sign_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewCSA creates a new instance of CSA. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewCSA(t interface {
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"reflect"
 	"sync"
 	"time"
 
@@ -252,6 +253,26 @@ func (kr *keyRing) raw() (rawKeys rawKeyRing) {
 	return rawKeys
 }
 
+// hasSameKeys returns true if both key rings hold keys with the same IDs
+func (kr *keyRing) hasSameKeys(other *keyRing) bool {
+	v, o := reflect.ValueOf(kr).Elem(), reflect.ValueOf(other).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		keys, otherKeys := v.Field(i), o.Field(i)
+		if keys.Kind() != reflect.Map {
+			continue
+		}
+		if keys.Len() != otherKeys.Len() {
+			return false
+		}
+		for _, id := range keys.MapKeys() {
+			if !otherKeys.MapIndex(id).IsValid() {
+				return false
+			}
+		}
+	}
+	return true
+}
+
 func (kr *keyRing) logPubKeys(lggr logger.Logger) {
 	lggr = lggr.Named("KeyRing")
 	var csaIDs []string
@@ -162,6 +162,26 @@ func TestKeyRing_Encrypt_Decrypt(t *testing.T) {
 	})
 }
 
+func TestKeyRing_hasSameKeys(t *testing.T) {
+	csa1, csa2 := csakey.MustNewV2XXXTestingOnly(big.NewInt(1)), csakey.MustNewV2XXXTestingOnly(big.NewInt(2))
+	kr := newKeyRing()
+	kr.CSA[csa1.ID()] = csa1
+	eth := mustNewEthKey(t)
+	kr.Eth[eth.ID()] = *eth
+
+	ekr, err := kr.Encrypt(password, utils.FastScryptParams)
+	require.NoError(t, err)
+	decrypted, err := ekr.Decrypt(password)
+	require.NoError(t, err)
+	require.True(t, kr.hasSameKeys(decrypted))
+
+	decrypted.CSA[csa2.ID()] = csa2
+	require.False(t, kr.hasSameKeys(decrypted))
+	delete(decrypted.CSA, csa1.ID())
+	require.False(t, kr.hasSameKeys(decrypted))
+	require.True(t, newKeyRing().hasSameKeys(newKeyRing()))
+}
+
 func TestResourceMutex_LockUnlock(t *testing.T) {
 	rm := &ResourceMutex{}
 
@@ -53,6 +53,9 @@ func (ks ocr2) GetAll() ([]ocr2key.KeyBundle, error) {
 	for _, key := range ks.keyRing.OCR2 {
 		keys = append(keys, key)
 	}
+	for _, key := range ks.signerKeys.OCR2 {
+		keys = append(keys, key)
+	}
 	return keys, nil
 }
 
@@ -97,6 +100,9 @@ func (ks ocr2) Delete(ctx context.Context, id string) error {
 	if err != nil {
 		return err
 	}
+	if _, ok := ks.signerKeys.signerID(id); ok {
+		return ErrSignerKey
+	}
 	err = ks.safeRemoveKey(ctx, key)
 	return err
 }
@@ -127,6 +133,9 @@ func (ks ocr2) Export(id string, password string) ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
+	if _, ok := ks.signerKeys.signerID(id); ok {
+		return nil, ErrSignerKey
+	}
 	return ocr2key.ToEncryptedJSON(key, password, ks.scryptParams)
 }
 
@@ -160,6 +169,9 @@ func (ks ocr2) EnsureKeys(ctx context.Context, enabledChains ...chaintype.ChainT
 
 func (ks ocr2) getByID(id string) (ocr2key.KeyBundle, error) {
 	key, found := ks.keyRing.OCR2[id]
+	if !found {
+		key, found = ks.signerKeys.OCR2[id]
+	}
 	if !found {
 		return nil, fmt.Errorf("unable to find OCR key with id %s", id)
 	}
@@ -173,6 +185,11 @@ func (ks ocr2) getAllOfType(chainType chaintype.ChainType) ([]ocr2key.KeyBundle,
 			keys = append(keys, key)
 		}
 	}
+	for _, key := range ks.signerKeys.OCR2 {
+		if key.ChainType() == chainType {
+			keys = append(keys, key)
+		}
+	}
 	return keys, nil
 }
 
@@ -180,6 +197,13 @@ func (ks ocr2) create(ctx context.Context, chainType chaintype.ChainType) (ocr2k
 	if !chaintype.IsSupportedChainType(chainType) {
 		return nil, chaintype.NewErrInvalidChainType(chainType)
 	}
+	if chainType == chaintype.EVM && ks.createsSignerKeys() {
+		id, err := ks.createSignerKey(ctx, SignerKeyTypeOCR2)
+		if err != nil {
+			return nil, err
+		}
+		return ks.signerKeys.OCR2[id], nil
+	}
 	key, err := ocr2key.New(chainType)
 	if err != nil {
 		return nil, err
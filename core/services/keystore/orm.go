@@ -52,6 +52,23 @@ func (orm ksORM) saveEncryptedKeyRing(ctx context.Context, kr *encryptedKeyRing,
 	})
 }
 
+// rotateEncryptedKeyRing replaces the encrypted key ring and has it verified as read back within the same transaction,
+// which is rolled back if verification fails
+func (orm ksORM) rotateEncryptedKeyRing(ctx context.Context, kr *encryptedKeyRing, verify func(encryptedKeyRing) error) error {
+	return sqlutil.TransactDataSource(ctx, orm.ds, nil, func(tx sqlutil.DataSource) error {
+		var saved encryptedKeyRing
+		err := tx.GetContext(ctx, &saved, `
+		UPDATE encrypted_key_rings
+		SET encrypted_keys = $1, updated_at = NOW()
+		RETURNING *
+	`, kr.EncryptedKeys)
+		if err != nil {
+			return errors.Wrap(err, "while saving keyring")
+		}
+		return verify(saved)
+	})
+}
+
 func (orm ksORM) getEncryptedKeyRing(ctx context.Context) (kr encryptedKeyRing, err error) {
 	err = orm.ds.GetContext(ctx, &kr, `SELECT * FROM encrypted_key_rings LIMIT 1`)
 	if errors.Is(err, sql.ErrNoRows) {
@@ -0,0 +1,135 @@
+package remotesigner
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/curve25519"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/remotesigner/pb"
+)
+
+var _ keystore.Signer = &Client{}
+
+// Client is a keystore.Signer delegating to a remote signer service over gRPC, see pb.SignerServer.
+type Client struct {
+	conn    *grpc.ClientConn
+	client  pb.SignerClient
+	timeout time.Duration
+}
+
+// NewClient returns a client of the remote signer at the configured endpoint. The connection is established lazily by
+// the first request.
+func NewClient(cfg config.RemoteSigner) (*Client, error) {
+	creds := insecure.NewCredentials()
+	if !cfg.InsecureConnection() {
+		tlsConfig, err := newTLSConfig(cfg)
+		if err != nil {
+			return nil, err
+		}
+		creds = credentials.NewTLS(tlsConfig)
+	}
+	conn, err := grpc.NewClient(cfg.Endpoint(), grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create remote signer client")
+	}
+	return &Client{
+		conn:    conn,
+		client:  pb.NewSignerClient(conn),
+		timeout: cfg.Timeout(),
+	}, nil
+}
+
+// newTLSConfig returns the TLS config verifying the remote signer with the CA certificate, and authenticating the node
+// with its client certificate for mutual TLS if one is configured.
+func newTLSConfig(cfg config.RemoteSigner) (*tls.Config, error) {
+	caCert, err := os.ReadFile(cfg.CACertFile())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load remote signer CA certificate")
+	}
+	rootCAs := x509.NewCertPool()
+	if !rootCAs.AppendCertsFromPEM(caCert) {
+		return nil, errors.Errorf("failed to parse remote signer CA certificate %s", cfg.CACertFile())
+	}
+	tlsConfig := &tls.Config{RootCAs: rootCAs, MinVersion: tls.VersionTLS12}
+	if cfg.ClientCertFile() != "" {
+		clientCert, err := tls.LoadX509KeyPair(cfg.ClientCertFile(), cfg.ClientKeyFile())
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to load remote signer client certificate")
+		}
+		tlsConfig.Certificates = []tls.Certificate{clientCert}
+	}
+	return tlsConfig, nil
+}
+
+// Close closes the connection to the remote signer
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+func (c *Client) Keys(ctx context.Context) ([]keystore.SignerKey, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	resp, err := c.client.ListKeys(ctx, &pb.ListKeysRequest{})
+	if err != nil {
+		return nil, errors.Wrap(err, "remote signer failed to list keys")
+	}
+	keys := make([]keystore.SignerKey, len(resp.Keys))
+	for i, key := range resp.Keys {
+		keyType, err := fromPBKeyType(key.Type)
+		if err != nil {
+			return nil, errors.Wrapf(err, "remote signer key %s", key.Id)
+		}
+		keys[i] = keystore.SignerKey{
+			ID:                key.Id,
+			Type:              keyType,
+			PublicKey:         key.PublicKey,
+			OffchainPublicKey: key.OffchainPublicKey,
+			ConfigPublicKey:   key.ConfigPublicKey,
+		}
+	}
+	return keys, nil
+}
+
+func (c *Client) Sign(ctx context.Context, id string, data []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	resp, err := c.client.Sign(ctx, &pb.SignRequest{KeyId: id, Data: data})
+	if err != nil {
+		return nil, errors.Wrapf(err, "remote signer failed to sign with key %s", id)
+	}
+	return resp.Signature, nil
+}
+
+func (c *Client) OffchainSign(ctx context.Context, id string, msg []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	resp, err := c.client.OffchainSign(ctx, &pb.SignRequest{KeyId: id, Data: msg})
+	if err != nil {
+		return nil, errors.Wrapf(err, "remote signer failed to sign with offchain key %s", id)
+	}
+	return resp.Signature, nil
+}
+
+func (c *Client) ConfigDiffieHellman(ctx context.Context, id string, point [curve25519.PointSize]byte) (sharedPoint [curve25519.PointSize]byte, err error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	resp, err := c.client.ConfigDiffieHellman(ctx, &pb.ConfigDiffieHellmanRequest{KeyId: id, Point: point[:]})
+	if err != nil {
+		return sharedPoint, errors.Wrapf(err, "remote signer failed to compute shared point with config key %s", id)
+	}
+	if len(resp.SharedPoint) != curve25519.PointSize {
+		return sharedPoint, errors.Errorf("remote signer returned a shared point of length %d", len(resp.SharedPoint))
+	}
+	copy(sharedPoint[:], resp.SharedPoint)
+	return sharedPoint, nil
+}
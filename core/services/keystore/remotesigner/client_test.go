@@ -0,0 +1,169 @@
+package remotesigner_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	cryptorand "crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/curve25519"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/remotesigner"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/remotesigner/remotesignertest"
+)
+
+type remoteSignerConfig struct {
+	endpoint       string
+	caCertFile     string
+	clientCertFile string
+	clientKeyFile  string
+}
+
+func (c remoteSignerConfig) Enabled() bool            { return true }
+func (c remoteSignerConfig) Endpoint() string         { return c.endpoint }
+func (c remoteSignerConfig) CACertFile() string       { return c.caCertFile }
+func (c remoteSignerConfig) ClientCertFile() string   { return c.clientCertFile }
+func (c remoteSignerConfig) ClientKeyFile() string    { return c.clientKeyFile }
+func (c remoteSignerConfig) InsecureConnection() bool { return c.caCertFile == "" }
+func (c remoteSignerConfig) Timeout() time.Duration   { return 10 * time.Second }
+
+func TestClient(t *testing.T) {
+	t.Parallel()
+
+	signer := remotesignertest.NewSigner()
+	ethKey, ethPrivateKey := signer.AddEthKey(t)
+	csaKey := signer.AddCSAKey(t)
+	ocr2Key := signer.AddOCR2Key(t)
+
+	client, err := remotesigner.NewClient(remoteSignerConfig{endpoint: remotesignertest.Serve(t, signer)})
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, client.Close()) })
+	ctx := testutils.Context(t)
+
+	keys, err := client.Keys(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []keystore.SignerKey{ethKey, csaKey, ocr2Key}, keys)
+
+	t.Run("sign digest", func(t *testing.T) {
+		digest := crypto.Keccak256([]byte("message"))
+		signature, err := client.Sign(ctx, ethKey.ID, digest)
+		require.NoError(t, err)
+		publicKey, err := crypto.SigToPub(digest, signature)
+		require.NoError(t, err)
+		assert.Equal(t, ethPrivateKey.PublicKey, *publicKey)
+	})
+
+	t.Run("sign message", func(t *testing.T) {
+		signature, err := client.Sign(ctx, csaKey.ID, []byte("message"))
+		require.NoError(t, err)
+		assert.True(t, ed25519.Verify(csaKey.PublicKey, []byte("message"), signature))
+
+		signature, err = client.OffchainSign(ctx, ocr2Key.ID, []byte("message"))
+		require.NoError(t, err)
+		assert.True(t, ed25519.Verify(ocr2Key.OffchainPublicKey, []byte("message"), signature))
+	})
+
+	t.Run("config diffie hellman", func(t *testing.T) {
+		var scalar [curve25519.ScalarSize]byte
+		_, err := cryptorand.Read(scalar[:])
+		require.NoError(t, err)
+		point, err := curve25519.X25519(scalar[:], curve25519.Basepoint)
+		require.NoError(t, err)
+
+		sharedPoint, err := client.ConfigDiffieHellman(ctx, ocr2Key.ID, [curve25519.PointSize]byte(point))
+		require.NoError(t, err)
+		expected, err := curve25519.X25519(scalar[:], ocr2Key.ConfigPublicKey)
+		require.NoError(t, err)
+		assert.Equal(t, expected, sharedPoint[:])
+	})
+
+	t.Run("unknown key", func(t *testing.T) {
+		_, err := client.Sign(ctx, "unknown", []byte("message"))
+		assert.ErrorContains(t, err, "unknown key")
+	})
+}
+
+func TestClient_mutualTLS(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	ca, caKey := newCertificate(t, nil, nil, &x509.Certificate{IsCA: true, KeyUsage: x509.KeyUsageCertSign, BasicConstraintsValid: true})
+	serverCert, serverKey := newCertificate(t, ca, caKey, &x509.Certificate{IPAddresses: []net.IP{net.IPv4(127, 0, 0, 1)}, ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}})
+	clientCert, clientKey := newCertificate(t, ca, caKey, &x509.Certificate{ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}})
+	caCertFile := writePEM(t, dir, "ca.pem", "CERTIFICATE", ca.Raw)
+	clientCertFile := writePEM(t, dir, "client.pem", "CERTIFICATE", clientCert.Raw)
+	clientKeyBytes, err := x509.MarshalPKCS8PrivateKey(clientKey)
+	require.NoError(t, err)
+	clientKeyFile := writePEM(t, dir, "client-key.pem", "PRIVATE KEY", clientKeyBytes)
+
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(ca)
+	signer := remotesignertest.NewSigner()
+	ethKey, _ := signer.AddEthKey(t)
+	endpoint := remotesignertest.ServeTLS(t, signer, &tls.Config{
+		Certificates: []tls.Certificate{{Certificate: [][]byte{serverCert.Raw}, PrivateKey: serverKey}},
+		ClientCAs:    clientCAs,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS12,
+	})
+	ctx := testutils.Context(t)
+
+	t.Run("with client certificate", func(t *testing.T) {
+		client, err := remotesigner.NewClient(remoteSignerConfig{endpoint: endpoint, caCertFile: caCertFile, clientCertFile: clientCertFile, clientKeyFile: clientKeyFile})
+		require.NoError(t, err)
+		t.Cleanup(func() { assert.NoError(t, client.Close()) })
+		keys, err := client.Keys(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []keystore.SignerKey{ethKey}, keys)
+	})
+
+	t.Run("without client certificate", func(t *testing.T) {
+		client, err := remotesigner.NewClient(remoteSignerConfig{endpoint: endpoint, caCertFile: caCertFile})
+		require.NoError(t, err)
+		t.Cleanup(func() { assert.NoError(t, client.Close()) })
+		_, err = client.Keys(ctx)
+		require.Error(t, err)
+	})
+
+	t.Run("invalid client certificate", func(t *testing.T) {
+		_, err := remotesigner.NewClient(remoteSignerConfig{endpoint: endpoint, caCertFile: caCertFile, clientCertFile: clientCertFile, clientKeyFile: caCertFile})
+		require.ErrorContains(t, err, "failed to load remote signer client certificate")
+	})
+}
+
+// newCertificate returns a certificate of the template signed by the parent, or self-signed if the parent is nil.
+func newCertificate(t *testing.T, parent *x509.Certificate, parentKey *ecdsa.PrivateKey, template *x509.Certificate) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), cryptorand.Reader)
+	require.NoError(t, err)
+	template.SerialNumber = big.NewInt(time.Now().UnixNano())
+	template.NotBefore = time.Now().Add(-time.Minute)
+	template.NotAfter = time.Now().Add(time.Hour)
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(cryptorand.Reader, template, parent, &key.PublicKey, parentKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert, key
+}
+
+func writePEM(t *testing.T, dir, name, blockType string, der []byte) string {
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0600))
+	return path
+}
@@ -0,0 +1,31 @@
+package remotesigner
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/remotesigner/pb"
+)
+
+var pbKeyTypes = map[keystore.SignerKeyType]pb.KeyType{
+	keystore.SignerKeyTypeEth:  pb.KeyType_KEY_TYPE_ETH,
+	keystore.SignerKeyTypeCSA:  pb.KeyType_KEY_TYPE_CSA,
+	keystore.SignerKeyTypeOCR2: pb.KeyType_KEY_TYPE_OCR2,
+}
+
+func toPBKeyType(keyType keystore.SignerKeyType) (pb.KeyType, error) {
+	t, ok := pbKeyTypes[keyType]
+	if !ok {
+		return pb.KeyType_KEY_TYPE_UNSPECIFIED, errors.Errorf("unsupported key type %q", keyType)
+	}
+	return t, nil
+}
+
+func fromPBKeyType(keyType pb.KeyType) (keystore.SignerKeyType, error) {
+	for t, pbType := range pbKeyTypes {
+		if pbType == keyType {
+			return t, nil
+		}
+	}
+	return "", errors.Errorf("unsupported key type %s", keyType)
+}
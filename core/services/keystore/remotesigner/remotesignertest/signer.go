@@ -0,0 +1,175 @@
+package remotesignertest
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	cryptorand "crypto/rand"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/curve25519"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/remotesigner"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/remotesigner/pb"
+)
+
+var _ keystore.Signer = &Signer{}
+
+// Signer is an in-memory keystore.Signer, standing in for a remote signer service in tests.
+type Signer struct {
+	mu   sync.RWMutex
+	keys []*key
+}
+
+type key struct {
+	keystore.SignerKey
+	onchain  *ecdsa.PrivateKey
+	csa      ed25519.PrivateKey
+	offchain ed25519.PrivateKey
+	config   [curve25519.ScalarSize]byte
+}
+
+// NewSigner returns a signer holding no keys
+func NewSigner() *Signer {
+	return &Signer{}
+}
+
+// AddEthKey generates an eth key held by the signer
+func (s *Signer) AddEthKey(t testing.TB) (keystore.SignerKey, *ecdsa.PrivateKey) {
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	k := &key{
+		SignerKey: keystore.SignerKey{Type: keystore.SignerKeyTypeEth, PublicKey: crypto.FromECDSAPub(&privateKey.PublicKey)},
+		onchain:   privateKey,
+	}
+	return s.add(k), privateKey
+}
+
+// AddCSAKey generates a CSA key held by the signer
+func (s *Signer) AddCSAKey(t testing.TB) keystore.SignerKey {
+	publicKey, privateKey, err := ed25519.GenerateKey(cryptorand.Reader)
+	require.NoError(t, err)
+	k := &key{
+		SignerKey: keystore.SignerKey{Type: keystore.SignerKeyTypeCSA, PublicKey: publicKey},
+		csa:       privateKey,
+	}
+	return s.add(k)
+}
+
+// AddOCR2Key generates an EVM OCR2 key bundle held by the signer
+func (s *Signer) AddOCR2Key(t testing.TB) keystore.SignerKey {
+	onchain, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	offchainPublicKey, offchain, err := ed25519.GenerateKey(cryptorand.Reader)
+	require.NoError(t, err)
+	k := &key{onchain: onchain, offchain: offchain}
+	_, err = cryptorand.Read(k.config[:])
+	require.NoError(t, err)
+	configPublicKey, err := curve25519.X25519(k.config[:], curve25519.Basepoint)
+	require.NoError(t, err)
+	k.SignerKey = keystore.SignerKey{
+		Type:              keystore.SignerKeyTypeOCR2,
+		PublicKey:         crypto.FromECDSAPub(&onchain.PublicKey),
+		OffchainPublicKey: offchainPublicKey,
+		ConfigPublicKey:   configPublicKey,
+	}
+	return s.add(k)
+}
+
+func (s *Signer) add(k *key) keystore.SignerKey {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	k.ID = fmt.Sprintf("%s-%d", k.Type, len(s.keys))
+	s.keys = append(s.keys, k)
+	return k.SignerKey
+}
+
+func (s *Signer) get(id string) (*key, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, k := range s.keys {
+		if k.ID == id {
+			return k, nil
+		}
+	}
+	return nil, errors.Errorf("unknown key %s", id)
+}
+
+func (s *Signer) Keys(context.Context) ([]keystore.SignerKey, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	keys := make([]keystore.SignerKey, len(s.keys))
+	for i, k := range s.keys {
+		keys[i] = k.SignerKey
+	}
+	return keys, nil
+}
+
+func (s *Signer) Sign(_ context.Context, id string, data []byte) ([]byte, error) {
+	k, err := s.get(id)
+	if err != nil {
+		return nil, err
+	}
+	if k.csa != nil {
+		return ed25519.Sign(k.csa, data), nil
+	}
+	return crypto.Sign(data, k.onchain)
+}
+
+func (s *Signer) OffchainSign(_ context.Context, id string, msg []byte) ([]byte, error) {
+	k, err := s.get(id)
+	if err != nil {
+		return nil, err
+	}
+	if k.offchain == nil {
+		return nil, errors.Errorf("key %s is not an OCR2 key bundle", id)
+	}
+	return ed25519.Sign(k.offchain, msg), nil
+}
+
+func (s *Signer) ConfigDiffieHellman(_ context.Context, id string, point [curve25519.PointSize]byte) (sharedPoint [curve25519.PointSize]byte, err error) {
+	k, err := s.get(id)
+	if err != nil {
+		return sharedPoint, err
+	}
+	if k.offchain == nil {
+		return sharedPoint, errors.Errorf("key %s is not an OCR2 key bundle", id)
+	}
+	p, err := curve25519.X25519(k.config[:], point[:])
+	if err != nil {
+		return sharedPoint, err
+	}
+	copy(sharedPoint[:], p)
+	return sharedPoint, nil
+}
+
+// Serve serves the signer over an insecure gRPC connection on a local port until the end of the test, and returns its
+// endpoint.
+func Serve(t testing.TB, signer keystore.Signer) string {
+	return serve(t, signer)
+}
+
+// ServeTLS serves the signer like Serve, over TLS with the config.
+func ServeTLS(t testing.TB, signer keystore.Signer, tlsConfig *tls.Config) string {
+	return serve(t, signer, grpc.Creds(credentials.NewTLS(tlsConfig)))
+}
+
+func serve(t testing.TB, signer keystore.Signer, opts ...grpc.ServerOption) string {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	srv := grpc.NewServer(opts...)
+	pb.RegisterSignerServer(srv, remotesigner.NewServer(signer))
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+	return lis.Addr().String()
+}
@@ -0,0 +1,74 @@
+package remotesigner
+
+import (
+	"context"
+
+	"golang.org/x/crypto/curve25519"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/remotesigner/pb"
+)
+
+var _ pb.SignerServer = &Server{}
+
+// Server serves the keys of a keystore.Signer over gRPC, such as to stand in for a remote signer service.
+type Server struct {
+	pb.UnimplementedSignerServer
+	signer keystore.Signer
+}
+
+// NewServer returns a server of the keys held by the signer, to register with pb.RegisterSignerServer.
+func NewServer(signer keystore.Signer) *Server {
+	return &Server{signer: signer}
+}
+
+func (s *Server) ListKeys(ctx context.Context, _ *pb.ListKeysRequest) (*pb.ListKeysResponse, error) {
+	keys, err := s.signer.Keys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.ListKeysResponse{Keys: make([]*pb.Key, len(keys))}
+	for i, key := range keys {
+		keyType, err := toPBKeyType(key.Type)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "key %s: %v", key.ID, err)
+		}
+		resp.Keys[i] = &pb.Key{
+			Id:                key.ID,
+			Type:              keyType,
+			PublicKey:         key.PublicKey,
+			OffchainPublicKey: key.OffchainPublicKey,
+			ConfigPublicKey:   key.ConfigPublicKey,
+		}
+	}
+	return resp, nil
+}
+
+func (s *Server) Sign(ctx context.Context, req *pb.SignRequest) (*pb.SignResponse, error) {
+	signature, err := s.signer.Sign(ctx, req.KeyId, req.Data)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SignResponse{Signature: signature}, nil
+}
+
+func (s *Server) OffchainSign(ctx context.Context, req *pb.SignRequest) (*pb.SignResponse, error) {
+	signature, err := s.signer.OffchainSign(ctx, req.KeyId, req.Data)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SignResponse{Signature: signature}, nil
+}
+
+func (s *Server) ConfigDiffieHellman(ctx context.Context, req *pb.ConfigDiffieHellmanRequest) (*pb.ConfigDiffieHellmanResponse, error) {
+	if len(req.Point) != curve25519.PointSize {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid point length %d", len(req.Point))
+	}
+	sharedPoint, err := s.signer.ConfigDiffieHellman(ctx, req.KeyId, [curve25519.PointSize]byte(req.Point))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ConfigDiffieHellmanResponse{SharedPoint: sharedPoint[:]}, nil
+}
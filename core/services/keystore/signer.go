@@ -0,0 +1,225 @@
+package keystore
+
+import (
+	"context"
+	"crypto/ed25519"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/curve25519"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/csakey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ocr2key"
+)
+
+// ErrSignerKey is returned by the operations requiring the private key of a key held by the Signer, such as exporting it
+var ErrSignerKey = errors.New("Key is held by the signer")
+
+// SignerKeyType is the keystore a key held by a Signer belongs to
+type SignerKeyType string
+
+const (
+	// SignerKeyTypeEth is a secp256k1 key of the Eth keystore
+	SignerKeyTypeEth SignerKeyType = "eth"
+	// SignerKeyTypeCSA is an ed25519 key of the CSA keystore
+	SignerKeyTypeCSA SignerKeyType = "csa"
+	// SignerKeyTypeOCR2 is an EVM key bundle of the OCR2 keystore, made of a secp256k1 onchain key, an ed25519 offchain
+	// key and an X25519 config encryption key
+	SignerKeyTypeOCR2 SignerKeyType = "ocr2"
+)
+
+// SignerKey is the public part of a key held by a Signer
+type SignerKey struct {
+	// ID identifies the key in the Signer
+	ID   string
+	Type SignerKeyType
+	// PublicKey is the uncompressed secp256k1 public key of Eth keys and OCR2 onchain keys, or the ed25519 public key of
+	// CSA keys
+	PublicKey []byte
+	// OffchainPublicKey is the ed25519 offchain public key of OCR2 key bundles
+	OffchainPublicKey []byte
+	// ConfigPublicKey is the X25519 config encryption public key of OCR2 key bundles
+	ConfigPublicKey []byte
+}
+
+// Signer holds keys outside of the keystore, such as a remote signer service, and signs with them on its behalf. The
+// keys it holds are listed by the Eth, CSA and OCR2 keystores along with their own keys, but can't be exported nor
+// deleted.
+type Signer interface {
+	// Keys returns the keys held by the signer
+	Keys(ctx context.Context) ([]SignerKey, error)
+	// Sign signs the data with a key: a 32 byte digest signed with secp256k1 by Eth and OCR2 onchain keys, returning a
+	// 65 byte [R || S || V] signature, or a message signed with ed25519 by CSA keys
+	Sign(ctx context.Context, id string, data []byte) ([]byte, error)
+	// OffchainSign signs the message with the ed25519 offchain key of an OCR2 key bundle
+	OffchainSign(ctx context.Context, id string, msg []byte) ([]byte, error)
+	// ConfigDiffieHellman multiplies the point by the X25519 config encryption key of an OCR2 key bundle
+	ConfigDiffieHellman(ctx context.Context, id string, point [curve25519.PointSize]byte) ([curve25519.PointSize]byte, error)
+}
+
+// SignerKeyCreator is a Signer which also creates keys, such as an HSM. When the Signer of the keystore is a
+// SignerKeyCreator, the Eth keystore and the OCR2 keystore for EVM create their new keys with it instead of in the key
+// ring.
+type SignerKeyCreator interface {
+	Signer
+	// CreateKey creates a key of the type, which can be an Eth key or an OCR2 key bundle
+	CreateKey(ctx context.Context, keyType SignerKeyType) (SignerKey, error)
+}
+
+// signerKeys are the keys held by the Signer, indexed by their ID in the keystore
+type signerKeys struct {
+	Eth  map[string]ethkey.KeyV2
+	CSA  map[string]csakey.KeyV2
+	OCR2 map[string]ocr2key.KeyBundle
+	// signerIDs maps the ID of the keys in the keystore to their ID in the Signer
+	signerIDs map[string]string
+}
+
+func newSignerKeys() signerKeys {
+	return signerKeys{
+		Eth:       map[string]ethkey.KeyV2{},
+		CSA:       map[string]csakey.KeyV2{},
+		OCR2:      map[string]ocr2key.KeyBundle{},
+		signerIDs: map[string]string{},
+	}
+}
+
+// signerID returns the ID in the Signer of the key, if it holds it
+func (sk signerKeys) signerID(id string) (string, bool) {
+	signerID, ok := sk.signerIDs[id]
+	return signerID, ok
+}
+
+// loadSignerKeys returns the keys held by the Signer, which must not be in the key ring
+func (km *keyManager) loadSignerKeys(ctx context.Context, kr *keyRing) (signerKeys, error) {
+	sk := newSignerKeys()
+	if km.signer == nil {
+		return sk, nil
+	}
+	keys, err := km.signer.Keys(ctx)
+	if err != nil {
+		return signerKeys{}, errors.Wrap(err, "failed to list the keys of the signer")
+	}
+	for _, key := range keys {
+		if _, err := sk.add(km.signer, kr, key); err != nil {
+			return signerKeys{}, err
+		}
+	}
+	km.logger.Infow(fmt.Sprintf("Loaded %d keys held by the signer", len(keys)), "eth", len(sk.Eth), "csa", len(sk.CSA), "ocr2", len(sk.OCR2))
+	return sk, nil
+}
+
+// add adds a key held by the signer, which must not be in the key ring, and returns its ID in the keystore
+func (sk signerKeys) add(signer Signer, kr *keyRing, key SignerKey) (string, error) {
+	var id string
+	switch key.Type {
+	case SignerKeyTypeEth:
+		publicKey, err := crypto.UnmarshalPubkey(key.PublicKey)
+		if err != nil {
+			return "", errors.Wrapf(err, "invalid public key of eth key %s", key.ID)
+		}
+		ethKey := ethkey.FromPublicKey(*publicKey)
+		id = ethKey.ID()
+		if _, found := kr.Eth[id]; found {
+			return "", errors.Wrapf(ErrKeyExists, "eth key %s held by the signer", id)
+		}
+		sk.Eth[id] = ethKey
+	case SignerKeyTypeCSA:
+		if len(key.PublicKey) != ed25519.PublicKeySize {
+			return "", errors.Errorf("invalid public key length %d of CSA key %s", len(key.PublicKey), key.ID)
+		}
+		csaKey := csakey.KeyV2{PublicKey: ed25519.PublicKey(key.PublicKey), Version: 2}
+		id = csaKey.ID()
+		if _, found := kr.CSA[id]; found {
+			return "", errors.Wrapf(ErrKeyExists, "CSA key %s held by the signer", id)
+		}
+		sk.CSA[id] = csaKey
+	case SignerKeyTypeOCR2:
+		publicKey, err := crypto.UnmarshalPubkey(key.PublicKey)
+		if err != nil {
+			return "", errors.Wrapf(err, "invalid onchain public key of OCR2 key bundle %s", key.ID)
+		}
+		if len(key.ConfigPublicKey) != curve25519.PointSize {
+			return "", errors.Errorf("invalid config public key length %d of OCR2 key bundle %s", len(key.ConfigPublicKey), key.ID)
+		}
+		bundle, err := ocr2key.NewSignerKeyBundle(publicKey, key.OffchainPublicKey, [curve25519.PointSize]byte(key.ConfigPublicKey), &bundleSigner{
+			signer:  signer,
+			id:      key.ID,
+			address: crypto.PubkeyToAddress(*publicKey),
+		})
+		if err != nil {
+			return "", errors.Wrapf(err, "invalid OCR2 key bundle %s", key.ID)
+		}
+		id = bundle.ID()
+		if _, found := kr.OCR2[id]; found {
+			return "", errors.Wrapf(ErrKeyExists, "OCR2 key bundle %s held by the signer", id)
+		}
+		sk.OCR2[id] = bundle
+	default:
+		return "", errors.Errorf("unsupported type %q of key %s held by the signer", key.Type, key.ID)
+	}
+	sk.signerIDs[id] = key.ID
+	return id, nil
+}
+
+// createsSignerKeys returns true if new keys are created by the Signer
+func (km *keyManager) createsSignerKeys() bool {
+	_, ok := km.signer.(SignerKeyCreator)
+	return ok
+}
+
+// createSignerKey has the Signer create a key, and returns its ID in the keystore
+// caller must hold lock!
+func (km *keyManager) createSignerKey(ctx context.Context, keyType SignerKeyType) (string, error) {
+	creator, ok := km.signer.(SignerKeyCreator)
+	if !ok {
+		return "", errors.New("signer does not create keys")
+	}
+	key, err := creator.CreateKey(ctx, keyType)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to create %s key with signer", keyType)
+	}
+	return km.signerKeys.add(km.signer, km.keyRing, key)
+}
+
+// signDigest has the Signer sign the digest with a secp256k1 key, and checks the signature recovers the address of the
+// key so a misbehaving signer can't have the node send transactions or reports signed by another key.
+func signDigest(ctx context.Context, signer Signer, id string, address common.Address, digest []byte) ([]byte, error) {
+	signature, err := signer.Sign(ctx, id, digest)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sign with signer")
+	}
+	publicKey, err := crypto.SigToPub(digest, signature)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid signature from signer")
+	}
+	if recovered := crypto.PubkeyToAddress(*publicKey); recovered != address {
+		return nil, errors.Errorf("signature from signer recovers address %s instead of %s", recovered, address)
+	}
+	return signature, nil
+}
+
+// bundleSigner signs with the keys of an OCR2 key bundle held by the Signer. The OCR keyrings are not context aware, so
+// its requests are only bounded by the timeout of the Signer.
+type bundleSigner struct {
+	signer  Signer
+	id      string
+	address common.Address
+}
+
+var _ ocr2key.BundleSigner = &bundleSigner{}
+
+func (s *bundleSigner) SignDigest(digest []byte) ([]byte, error) {
+	return signDigest(context.Background(), s.signer, s.id, s.address, digest)
+}
+
+func (s *bundleSigner) OffchainSign(msg []byte) ([]byte, error) {
+	return s.signer.OffchainSign(context.Background(), s.id, msg)
+}
+
+func (s *bundleSigner) ConfigDiffieHellman(point [curve25519.PointSize]byte) ([curve25519.PointSize]byte, error) {
+	return s.signer.ConfigDiffieHellman(context.Background(), s.id, point)
+}
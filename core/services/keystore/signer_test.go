@@ -0,0 +1,208 @@
+package keystore_test
+
+import (
+	"context"
+	gocrypto "crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/csakey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/remotesigner/remotesignertest"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+func Test_Signer(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	ctx := testutils.Context(t)
+
+	signer := remotesignertest.NewSigner()
+	_, ethPrivateKey := signer.AddEthKey(t)
+	csaKey := signer.AddCSAKey(t)
+	signer.AddOCR2Key(t)
+
+	keyStore := keystore.NewWithSigner(db, utils.FastScryptParams, signer, logger.TestLogger(t))
+	require.NoError(t, keyStore.Unlock(ctx, cltest.Password))
+	address := crypto.PubkeyToAddress(ethPrivateKey.PublicKey)
+
+	t.Run("eth", func(t *testing.T) {
+		ks := keyStore.Eth()
+		local, err := ks.Create(ctx)
+		require.NoError(t, err)
+		keys, err := ks.GetAll(ctx)
+		require.NoError(t, err)
+		require.Len(t, keys, 2)
+
+		chainID := testutils.FixtureChainID
+		require.NoError(t, ks.Enable(ctx, address, chainID))
+		enabled, err := ks.EnabledAddressesForChain(ctx, chainID)
+		require.NoError(t, err)
+		assert.Equal(t, []common.Address{address}, enabled)
+
+		tx := cltest.NewLegacyTransaction(0, testutils.NewAddress(), big.NewInt(1), 21000, big.NewInt(1), nil)
+		signed, err := ks.SignTx(ctx, address, tx, chainID)
+		require.NoError(t, err)
+		sender, err := types.Sender(types.LatestSignerForChainID(chainID), signed)
+		require.NoError(t, err)
+		assert.Equal(t, address, sender)
+
+		signature, err := ks.SignMessage(ctx, address, []byte("message"))
+		require.NoError(t, err)
+		publicKey, err := crypto.SigToPub(accounts.TextHash([]byte("message")), signature)
+		require.NoError(t, err)
+		assert.Equal(t, address, crypto.PubkeyToAddress(*publicKey))
+
+		_, err = ks.Export(ctx, address.Hex(), cltest.Password)
+		assert.ErrorIs(t, err, keystore.ErrSignerKey)
+		_, err = ks.Delete(ctx, address.Hex())
+		assert.ErrorIs(t, err, keystore.ErrSignerKey)
+		_, err = ks.Delete(ctx, local.ID())
+		assert.NoError(t, err)
+	})
+
+	t.Run("csa", func(t *testing.T) {
+		ks := keyStore.CSA()
+		key, err := ks.Get(hex.EncodeToString(csaKey.PublicKey))
+		require.NoError(t, err)
+
+		// the signer holds a CSA key, so none is created
+		require.NoError(t, ks.EnsureKey(ctx))
+		keys, err := ks.GetAll()
+		require.NoError(t, err)
+		assert.Equal(t, []csakey.KeyV2{key}, keys)
+
+		signature, err := ks.Sign(ctx, key.ID(), []byte("message"))
+		require.NoError(t, err)
+		assert.True(t, ed25519.Verify(key.PublicKey, []byte("message"), signature))
+
+		signature, err = keystore.NewCSASigner(ks, key).Sign(rand.Reader, []byte("message"), gocrypto.Hash(0))
+		require.NoError(t, err)
+		assert.True(t, ed25519.Verify(key.PublicKey, []byte("message"), signature))
+
+		_, err = ks.Export(key.ID(), cltest.Password)
+		assert.ErrorIs(t, err, keystore.ErrSignerKey)
+	})
+
+	t.Run("ocr2", func(t *testing.T) {
+		ks := keyStore.OCR2()
+		bundles, err := ks.GetAllOfType(chaintype.EVM)
+		require.NoError(t, err)
+		require.Len(t, bundles, 1)
+		bundle := bundles[0]
+
+		// the signer holds an EVM bundle, so none is created
+		require.NoError(t, ks.EnsureKeys(ctx, chaintype.EVM))
+		bundles, err = ks.GetAll()
+		require.NoError(t, err)
+		require.Len(t, bundles, 1)
+
+		report := ocrtypes.Report("report")
+		signature, err := bundle.Sign(ocrtypes.ReportContext{}, report)
+		require.NoError(t, err)
+		assert.True(t, bundle.Verify(bundle.PublicKey(), ocrtypes.ReportContext{}, report, signature))
+
+		_, err = ks.Export(bundle.ID(), cltest.Password)
+		assert.ErrorIs(t, err, keystore.ErrSignerKey)
+		assert.ErrorIs(t, ks.Delete(ctx, bundle.ID()), keystore.ErrSignerKey)
+	})
+
+	t.Run("signature of another key", func(t *testing.T) {
+		otherKey, _ := signer.AddEthKey(t)
+		keyStore := keystore.NewWithSigner(db, utils.FastScryptParams, &wrongKeySigner{signer, otherKey.ID}, logger.TestLogger(t))
+		require.NoError(t, keyStore.Unlock(ctx, cltest.Password))
+
+		_, err := keyStore.Eth().SignMessage(ctx, address, []byte("message"))
+		assert.ErrorContains(t, err, "signature from signer recovers address")
+
+		otherCSAKey := signer.AddCSAKey(t)
+		keyStore = keystore.NewWithSigner(db, utils.FastScryptParams, &wrongKeySigner{signer, otherCSAKey.ID}, logger.TestLogger(t))
+		require.NoError(t, keyStore.Unlock(ctx, cltest.Password))
+
+		_, err = keyStore.CSA().Sign(ctx, hex.EncodeToString(csaKey.PublicKey), []byte("message"))
+		assert.ErrorContains(t, err, "signature from signer does not verify")
+	})
+}
+
+func Test_SignerKeyCreator(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	ctx := testutils.Context(t)
+
+	signer := &keyCreatingSigner{Signer: remotesignertest.NewSigner(), t: t}
+	keyStore := keystore.NewWithSigner(db, utils.FastScryptParams, signer, logger.TestLogger(t))
+	require.NoError(t, keyStore.Unlock(ctx, cltest.Password))
+
+	chainID := testutils.FixtureChainID
+	ethKey, err := keyStore.Eth().Create(ctx, chainID)
+	require.NoError(t, err)
+	_, err = keyStore.Eth().Export(ctx, ethKey.ID(), cltest.Password)
+	assert.ErrorIs(t, err, keystore.ErrSignerKey, "eth key is created by the signer")
+	enabled, err := keyStore.Eth().EnabledAddressesForChain(ctx, chainID)
+	require.NoError(t, err)
+	assert.Equal(t, []common.Address{ethKey.Address}, enabled)
+
+	evmBundle, err := keyStore.OCR2().Create(ctx, chaintype.EVM)
+	require.NoError(t, err)
+	_, err = keyStore.OCR2().Export(evmBundle.ID(), cltest.Password)
+	assert.ErrorIs(t, err, keystore.ErrSignerKey, "EVM bundle is created by the signer")
+
+	solanaBundle, err := keyStore.OCR2().Create(ctx, chaintype.Solana)
+	require.NoError(t, err)
+	_, err = keyStore.OCR2().Export(solanaBundle.ID(), cltest.Password)
+	assert.NoError(t, err, "bundles of other chains are created in the key ring")
+
+	// the keys are loaded from the signer on unlock
+	keyStore = keystore.NewWithSigner(db, utils.FastScryptParams, signer, logger.TestLogger(t))
+	require.NoError(t, keyStore.Unlock(ctx, cltest.Password))
+	_, err = keyStore.Eth().Get(ctx, ethKey.ID())
+	assert.NoError(t, err)
+	enabled, err = keyStore.Eth().EnabledAddressesForChain(ctx, chainID)
+	require.NoError(t, err)
+	assert.Equal(t, []common.Address{ethKey.Address}, enabled)
+	_, err = keyStore.OCR2().Get(evmBundle.ID())
+	assert.NoError(t, err)
+}
+
+// keyCreatingSigner creates keys, as an HSM does
+type keyCreatingSigner struct {
+	*remotesignertest.Signer
+	t testing.TB
+}
+
+func (s *keyCreatingSigner) CreateKey(_ context.Context, keyType keystore.SignerKeyType) (keystore.SignerKey, error) {
+	switch keyType {
+	case keystore.SignerKeyTypeEth:
+		key, _ := s.AddEthKey(s.t)
+		return key, nil
+	case keystore.SignerKeyTypeOCR2:
+		return s.AddOCR2Key(s.t), nil
+	default:
+		return keystore.SignerKey{}, fmt.Errorf("unsupported key type %q", keyType)
+	}
+}
+
+// wrongKeySigner signs with another key than the requested one
+type wrongKeySigner struct {
+	*remotesignertest.Signer
+	keyID string
+}
+
+func (s *wrongKeySigner) Sign(ctx context.Context, _ string, data []byte) ([]byte, error) {
+	return s.Signer.Sign(ctx, s.keyID, data)
+}
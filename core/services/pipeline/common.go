@@ -109,6 +109,9 @@ const (
 type RunInfo struct {
 	IsRetryable bool
 	IsPending   bool
+	// HTTPStatusCode is the status code of the response received by http and
+	// bridge tasks, used to evaluate retryOn policies.
+	HTTPStatusCode int
 }
 
 // retryableMeta should be returned if the error is non-deterministic; i.e. a
@@ -207,6 +210,9 @@ type TaskRunResult struct {
 	Attempts   uint
 	CreatedAt  time.Time
 	FinishedAt null.Time
+	// AttemptHistory holds one entry for every attempt made, including the
+	// final one
+	AttemptHistory TaskRunAttempts
 	// runInfo is never persisted
 	runInfo RunInfo
 }
@@ -313,16 +319,19 @@ const (
 	TaskTypeHTTP             TaskType = "http"
 	TaskTypeHexDecode        TaskType = "hexdecode"
 	TaskTypeHexEncode        TaskType = "hexencode"
+	TaskTypeJQ               TaskType = "jq"
 	TaskTypeJSONParse        TaskType = "jsonparse"
 	TaskTypeLength           TaskType = "length"
 	TaskTypeLessThan         TaskType = "lessthan"
 	TaskTypeLookup           TaskType = "lookup"
 	TaskTypeLowercase        TaskType = "lowercase"
+	TaskTypeMap              TaskType = "map"
 	TaskTypeMean             TaskType = "mean"
 	TaskTypeMedian           TaskType = "median"
 	TaskTypeMerge            TaskType = "merge"
 	TaskTypeMode             TaskType = "mode"
 	TaskTypeMultiply         TaskType = "multiply"
+	TaskTypeScript           TaskType = "script"
 	TaskTypeSum              TaskType = "sum"
 	TaskTypeUppercase        TaskType = "uppercase"
 	TaskTypeVRF              TaskType = "vrf"
@@ -374,10 +383,14 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		task = &AnyTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeJSONParse:
 		task = &JSONParseTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeJQ:
+		task = &JQTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeMemo:
 		task = &MemoTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeMultiply:
 		task = &MultiplyTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeScript:
+		task = &ScriptTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeDivide:
 		task = &DivideTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeVRF:
@@ -414,6 +427,8 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		task = &LookupTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeLowercase:
 		task = &LowercaseTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeMap:
+		task = &MapTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeUppercase:
 		task = &UppercaseTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeConditional:
@@ -475,6 +490,20 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		}
 	}
 
+	base := task.Base()
+	if base.MinBackoff > 0 && base.MaxBackoff > 0 && base.MinBackoff > base.MaxBackoff {
+		return nil, pkgerrors.Errorf("minBackoff (%v) must not be greater than maxBackoff (%v)", base.MinBackoff, base.MaxBackoff)
+	}
+	if base.retryPolicy, err = ParseRetryPolicy(base.RetryOn); err != nil {
+		return nil, err
+	}
+
+	if mapTask, ok := task.(*MapTask); ok {
+		if err = mapTask.parseSubPipeline(); err != nil {
+			return nil, err
+		}
+	}
+
 	return task, nil
 }
 
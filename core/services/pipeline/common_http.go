@@ -61,7 +61,7 @@ func makeHTTPRequest(
 	responseBytes, statusCode, respHeaders, err = httpRequest.SendRequest()
 	finish = time.Now()
 	if ctx.Err() != nil {
-		err = errors.New("http request timed out or interrupted")
+		err = errors.Wrap(ctx.Err(), "http request timed out or interrupted")
 		return
 	}
 	if err != nil {
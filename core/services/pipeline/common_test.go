@@ -1,6 +1,8 @@
 package pipeline_test
 
 import (
+	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -100,6 +102,20 @@ func TestRetryUnmarshal(t *testing.T) {
 			time.Second,
 			time.Minute * 30,
 		},
+		{
+			"only minBackoff set",
+			`ds1 [type=http retries=3 minBackoff="2s"];`,
+			3,
+			time.Second * 2,
+			time.Minute,
+		},
+		{
+			"only maxBackoff set",
+			`ds1 [type=http retries=3 maxBackoff="10s"];`,
+			3,
+			time.Second * 5,
+			time.Second * 10,
+		},
 	}
 
 	for _, test := range tests {
@@ -114,6 +130,64 @@ func TestRetryUnmarshal(t *testing.T) {
 	}
 }
 
+func TestRetryUnmarshal_Invalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := pipeline.Parse(`ds1 [type=any retries=3 minBackoff="1m" maxBackoff="1s"];`)
+	require.ErrorContains(t, err, "minBackoff (1m0s) must not be greater than maxBackoff (1s)")
+
+	_, err = pipeline.Parse(`ds1 [type=any retries=3 retryOn="http5xx,sometimes"];`)
+	require.ErrorContains(t, err, `retryOn: unknown condition "sometimes"`)
+
+	_, err = pipeline.Parse(`ds1 [type=any retries=3 retryOn="http999"];`)
+	require.ErrorContains(t, err, `retryOn: invalid HTTP status code in "http999"`)
+
+	_, err = pipeline.Parse(`ds1 [type=any retries=3 retryOn="rpc:abc"];`)
+	require.ErrorContains(t, err, `retryOn: invalid JSON-RPC error code in "rpc:abc"`)
+}
+
+func TestRetryPolicy(t *testing.T) {
+	t.Parallel()
+
+	rpcErr := errors.Wrap(&rpcError{code: -32005}, "eth_call failed")
+
+	tests := []struct {
+		name    string
+		retryOn string
+		result  pipeline.Result
+		runInfo pipeline.RunInfo
+		want    bool
+	}{
+		{"success is never retried", "", pipeline.Result{Value: 1}, pipeline.RunInfo{}, false},
+		{"no policy retries any error", "", pipeline.Result{Error: pipeline.ErrTaskRunFailed}, pipeline.RunInfo{}, true},
+		{"any", "any", pipeline.Result{Error: pipeline.ErrTaskRunFailed}, pipeline.RunInfo{}, true},
+		{"http5xx matches 503", "http5xx", pipeline.Result{Error: pipeline.ErrTaskRunFailed}, pipeline.RunInfo{HTTPStatusCode: 503}, true},
+		{"http5xx ignores 404", "http5xx", pipeline.Result{Error: pipeline.ErrTaskRunFailed}, pipeline.RunInfo{HTTPStatusCode: 404}, false},
+		{"http4xx matches 404", "http4xx", pipeline.Result{Error: pipeline.ErrTaskRunFailed}, pipeline.RunInfo{HTTPStatusCode: 404}, true},
+		{"single status code", "http5xx, http429", pipeline.Result{Error: pipeline.ErrTaskRunFailed}, pipeline.RunInfo{HTTPStatusCode: 429}, true},
+		{"timeout matches deadline exceeded", "timeout", pipeline.Result{Error: errors.Wrap(context.DeadlineExceeded, "http request timed out or interrupted")}, pipeline.RunInfo{}, true},
+		{"timeout matches gateway timeout", "timeout", pipeline.Result{Error: pipeline.ErrTaskRunFailed}, pipeline.RunInfo{HTTPStatusCode: 504}, true},
+		{"timeout ignores other errors", "timeout", pipeline.Result{Error: pipeline.ErrTaskRunFailed}, pipeline.RunInfo{}, false},
+		{"rpc code matches", "rpc:-32005", pipeline.Result{Error: rpcErr}, pipeline.RunInfo{}, true},
+		{"rpc code mismatch", "rpc:-32000", pipeline.Result{Error: rpcErr}, pipeline.RunInfo{}, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			policy, err := pipeline.ParseRetryPolicy(test.retryOn)
+			require.NoError(t, err)
+			assert.Equal(t, test.want, policy.ShouldRetry(test.result, test.runInfo))
+		})
+	}
+}
+
+type rpcError struct {
+	code int
+}
+
+func (e *rpcError) Error() string  { return fmt.Sprintf("rpc error %d", e.code) }
+func (e *rpcError) ErrorCode() int { return e.code }
+
 func TestUnmarshalTaskFromMap(t *testing.T) {
 	t.Parallel()
 
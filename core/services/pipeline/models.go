@@ -275,11 +275,42 @@ type TaskRun struct {
 	FinishedAt    null.Time                         `json:"finishedAt"`
 	Index         int32                             `json:"index"`
 	DotID         string                            `json:"dotId"`
+	// Attempts is only recorded for task runs that were retried
+	Attempts TaskRunAttempts `json:"attempts,omitempty"`
 
 	// Used internally for sorting completed results
 	task Task
 }
 
+// TaskRunAttempt records a single attempt of a task run.
+type TaskRunAttempt struct {
+	Attempt    uint        `json:"attempt"`
+	Error      null.String `json:"error"`
+	CreatedAt  time.Time   `json:"createdAt"`
+	FinishedAt null.Time   `json:"finishedAt"`
+}
+
+type TaskRunAttempts []TaskRunAttempt
+
+func (a *TaskRunAttempts) Scan(value interface{}) error {
+	if value == nil {
+		*a = nil
+		return nil
+	}
+	bytes, ok := value.([]byte)
+	if !ok {
+		return errors.Errorf("TaskRunAttempts#Scan received a value of type %T", value)
+	}
+	return json.Unmarshal(bytes, a)
+}
+
+func (a TaskRunAttempts) Value() (driver.Value, error) {
+	if len(a) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(a)
+}
+
 func (tr TaskRun) GetID() string {
 	return fmt.Sprintf("%v", tr.ID)
 }
@@ -293,6 +324,14 @@ func (tr *TaskRun) SetID(value string) error {
 	return nil
 }
 
+// AttemptCount returns how many times the task ran.
+func (tr TaskRun) AttemptCount() int {
+	if len(tr.Attempts) == 0 {
+		return 1
+	}
+	return len(tr.Attempts)
+}
+
 func (tr TaskRun) GetDotID() string {
 	return tr.DotID
 }
@@ -0,0 +1,129 @@
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	pkgerrors "github.com/pkg/errors"
+)
+
+const (
+	RetryOnAny     = "any"
+	RetryOnTimeout = "timeout"
+	RetryOnHTTP4xx = "http4xx"
+	RetryOnHTTP5xx = "http5xx"
+
+	// retryOnHTTPPrefix and retryOnRPCPrefix select a single HTTP status code
+	// or JSON-RPC error code, e.g. http429 or rpc:-32005.
+	retryOnHTTPPrefix = "http"
+	retryOnRPCPrefix  = "rpc:"
+)
+
+type retryCondition func(result Result, runInfo RunInfo) bool
+
+// RetryPolicy decides which failed task runs are retried. It is configured
+// with the comma separated retryOn task attribute, e.g.
+//
+//	ds [type=http method=GET url="..." retries=5 minBackoff="1s" maxBackoff="30s" retryOn="http5xx,http429,timeout"]
+//
+// The supported conditions are:
+//
+//	any         any error (the default when retryOn is not set)
+//	timeout     the task, its context or a network call timed out
+//	http4xx     the http or bridge task got a 4xx response
+//	http5xx     the http or bridge task got a 5xx response
+//	http<code>  the http or bridge task got a response with the given status code
+//	rpc:<code>  an RPC call failed with the given JSON-RPC error code
+type RetryPolicy struct {
+	conditions []retryCondition
+}
+
+// ParseRetryPolicy parses the value of a retryOn attribute.
+func ParseRetryPolicy(s string) (RetryPolicy, error) {
+	var p RetryPolicy
+	for _, token := range strings.Split(s, ",") {
+		token = strings.ToLower(strings.TrimSpace(token))
+		if token == "" {
+			continue
+		}
+		cond, err := parseRetryCondition(token)
+		if err != nil {
+			return RetryPolicy{}, err
+		}
+		p.conditions = append(p.conditions, cond)
+	}
+	return p, nil
+}
+
+func parseRetryCondition(token string) (retryCondition, error) {
+	switch token {
+	case RetryOnAny:
+		return func(Result, RunInfo) bool { return true }, nil
+	case RetryOnTimeout:
+		return func(result Result, runInfo RunInfo) bool {
+			return isTimeoutError(result.Error) ||
+				runInfo.HTTPStatusCode == http.StatusRequestTimeout ||
+				runInfo.HTTPStatusCode == http.StatusGatewayTimeout
+		}, nil
+	case RetryOnHTTP4xx:
+		return httpStatusCondition(400, 499), nil
+	case RetryOnHTTP5xx:
+		return httpStatusCondition(500, 599), nil
+	}
+
+	switch {
+	case strings.HasPrefix(token, retryOnRPCPrefix):
+		code, err := strconv.Atoi(strings.TrimPrefix(token, retryOnRPCPrefix))
+		if err != nil {
+			return nil, pkgerrors.Errorf("retryOn: invalid JSON-RPC error code in %q", token)
+		}
+		return func(result Result, _ RunInfo) bool {
+			var rpcErr rpc.Error
+			return errors.As(result.Error, &rpcErr) && rpcErr.ErrorCode() == code
+		}, nil
+	case strings.HasPrefix(token, retryOnHTTPPrefix):
+		code, err := strconv.Atoi(strings.TrimPrefix(token, retryOnHTTPPrefix))
+		if err != nil || code < 100 || code > 599 {
+			return nil, pkgerrors.Errorf("retryOn: invalid HTTP status code in %q", token)
+		}
+		return httpStatusCondition(code, code), nil
+	default:
+		return nil, pkgerrors.Errorf("retryOn: unknown condition %q", token)
+	}
+}
+
+func httpStatusCondition(lower, upper int) retryCondition {
+	return func(_ Result, runInfo RunInfo) bool {
+		return runInfo.HTTPStatusCode >= lower && runInfo.HTTPStatusCode <= upper
+	}
+}
+
+func isTimeoutError(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, ErrTimeout) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// ShouldRetry reports whether a failed task run qualifies for another
+// attempt. A policy without conditions retries every error.
+func (p RetryPolicy) ShouldRetry(result Result, runInfo RunInfo) bool {
+	if result.Error == nil {
+		return false
+	}
+	if len(p.conditions) == 0 {
+		return true
+	}
+	for _, cond := range p.conditions {
+		if cond(result, runInfo) {
+			return true
+		}
+	}
+	return false
+}
@@ -328,8 +328,14 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 		return
 	}
 
+	r.initializeTasks(spec, pipeline.Tasks)
+
+	return pipeline, nil
+}
+
+func (r *runner) initializeTasks(spec Spec, tasks []Task) {
 	// initialize certain task params
-	for _, task := range pipeline.Tasks {
+	for _, task := range tasks {
 		task.Base().uuid = uuid.New()
 
 		switch task.Type() {
@@ -368,11 +374,15 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 			task.(*ETHTxTask).specGasLimit = spec.GasLimit
 			task.(*ETHTxTask).jobType = spec.JobType
 			task.(*ETHTxTask).forwardingAllowed = spec.ForwardingAllowed
+		case TaskTypeMap:
+			task.(*MapTask).runner = r
+			task.(*MapTask).spec = spec
+			if sub := task.(*MapTask).subPipeline; sub != nil {
+				r.initializeTasks(spec, sub.Tasks)
+			}
 		default:
 		}
 	}
-
-	return pipeline, nil
 }
 
 func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Vars) TaskRunResults {
@@ -384,36 +394,14 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 	scheduler := newScheduler(pipeline, run, vars, l)
 	go scheduler.Run()
 
-	// This is "just in case" for cleaning up any stray reports.
-	// Normally the scheduler loop doesn't stop until all in progress runs report back
-	reportCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
-	defer cancel()
-
 	if pipelineTimeout := r.config.MaxRunDuration(); pipelineTimeout != 0 {
+		var cancel context.CancelFunc
 		ctx, cancel = context.WithTimeout(ctx, pipelineTimeout)
 		defer cancel()
 	}
 
-	for taskRun := range scheduler.taskCh {
-		taskRun := taskRun
-		// execute
-		go recovery.WrapRecoverHandle(l, func() {
-			result := r.executeTaskRun(ctx, run.PipelineSpec, taskRun, l)
-
-			logTaskRunToPrometheus(result, run.PipelineSpec)
-
-			scheduler.report(reportCtx, result)
-		}, func(err interface{}) {
-			t := time.Now()
-			scheduler.report(reportCtx, TaskRunResult{
-				ID:         uuid.New(),
-				Task:       taskRun.task,
-				Result:     Result{Error: ErrRunPanicked{err}},
-				FinishedAt: null.TimeFrom(t),
-				CreatedAt:  t, // TODO: more accurate start time
-			})
-		})
-	}
+	// simulated runs don't belong to a job and must not skew its metrics
+	r.executeScheduled(ctx, scheduler, run.PipelineSpec, GetSimulation(ctx) == nil, l)
 
 	// if the run is suspended, awaiting resumption
 	run.Pending = scheduler.pending
@@ -434,6 +422,11 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 	run.PipelineTaskRuns = nil
 	for _, result := range scheduler.results {
 		output := result.Result.OutputDB()
+		var attempts TaskRunAttempts
+		if len(result.AttemptHistory) > 1 {
+			// only keep the history of retried task runs, it adds nothing otherwise
+			attempts = result.AttemptHistory
+		}
 		run.PipelineTaskRuns = append(run.PipelineTaskRuns, TaskRun{
 			ID:            result.ID,
 			PipelineRunID: run.ID,
@@ -444,6 +437,7 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 			DotID:         result.Task.DotID(),
 			CreatedAt:     result.CreatedAt,
 			FinishedAt:    result.FinishedAt,
+			Attempts:      attempts,
 			task:          result.Task,
 		})
 
@@ -535,6 +529,57 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 	return taskRunResults
 }
 
+// executeScheduled executes the task runs handed out by the scheduler until
+// it stops.
+func (r *runner) executeScheduled(ctx context.Context, scheduler *scheduler, spec Spec, reportMetrics bool, l logger.Logger) {
+	// This is "just in case" for cleaning up any stray reports.
+	// Normally the scheduler loop doesn't stop until all in progress runs report back
+	reportCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	defer cancel()
+
+	for taskRun := range scheduler.taskCh {
+		taskRun := taskRun
+		// execute
+		go recovery.WrapRecoverHandle(l, func() {
+			result := r.executeTaskRun(ctx, spec, taskRun, l)
+
+			if reportMetrics {
+				logTaskRunToPrometheus(result, spec)
+			}
+
+			scheduler.report(reportCtx, result)
+		}, func(err interface{}) {
+			t := time.Now()
+			scheduler.report(reportCtx, TaskRunResult{
+				ID:         uuid.New(),
+				Task:       taskRun.task,
+				Result:     Result{Error: ErrRunPanicked{err}},
+				FinishedAt: null.TimeFrom(t),
+				CreatedAt:  t, // TODO: more accurate start time
+			})
+		})
+	}
+}
+
+// runSubPipeline executes a pipeline embedded in a task of the given spec,
+// e.g. the sub-pipeline of a map task. The run is neither persisted nor
+// reported as a pipeline run of its own.
+func (r *runner) runSubPipeline(ctx context.Context, spec Spec, pipeline *Pipeline, vars Vars) TaskRunResults {
+	run := NewRun(spec, vars)
+	l := r.lggr.With("specID", spec.ID, "jobID", spec.JobID, "jobName", spec.JobName, "subPipeline", true)
+
+	scheduler := newScheduler(pipeline, run, vars, l)
+	go scheduler.Run()
+
+	r.executeScheduled(ctx, scheduler, spec, false, l)
+
+	trrs := make(TaskRunResults, 0, len(scheduler.results))
+	for _, result := range scheduler.results {
+		trrs = append(trrs, result)
+	}
+	return trrs
+}
+
 func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryTaskRun, l logger.Logger) TaskRunResult {
 	start := time.Now()
 	l = l.With("taskName", taskRun.task.DotID(),
@@ -562,7 +607,18 @@ func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryT
 		defer cancel()
 	}
 
-	result, runInfo := taskRun.task.Run(ctx, l, taskRun.vars, taskRun.inputs)
+	var result Result
+	var runInfo RunInfo
+	if sim := GetSimulation(ctx); sim != nil {
+		sim.recordInputs(taskRun.task.DotID(), taskRun.inputs)
+		if sim.intercepts(taskRun.task) {
+			result = sim.run(taskRun.task, taskRun.vars)
+		} else {
+			result, runInfo = taskRun.task.Run(ctx, l, taskRun.vars, taskRun.inputs)
+		}
+	} else {
+		result, runInfo = taskRun.task.Run(ctx, l, taskRun.vars, taskRun.inputs)
+	}
 	loggerFields := []interface{}{"runInfo", runInfo,
 		"resultValue", result.Value,
 		"resultError", result.Error,
@@ -135,10 +135,12 @@ func (s *scheduler) reconstructResults() {
 		}
 
 		s.results[task.ID()] = TaskRunResult{
-			Task:       task,
-			Result:     result,
-			CreatedAt:  r.CreatedAt,
-			FinishedAt: r.FinishedAt,
+			Task:           task,
+			Result:         result,
+			Attempts:       uint(len(r.Attempts)),
+			AttemptHistory: r.Attempts,
+			CreatedAt:      r.CreatedAt,
+			FinishedAt:     r.FinishedAt,
 		}
 
 		// store the result in vars
@@ -174,12 +176,22 @@ func (s *scheduler) Run() {
 
 		s.waiting--
 
-		// retrieve previous attempt count
-		result.Attempts = s.results[result.Task.ID()].Attempts
+		// retrieve previous attempts
+		previous := s.results[result.Task.ID()]
+		result.Attempts = previous.Attempts
+		result.AttemptHistory = previous.AttemptHistory
 
 		// only count as an attempt if the job actually ran. If we're exiting then it got cancelled
 		if !s.exiting {
 			result.Attempts++
+			if !result.runInfo.IsPending {
+				result.AttemptHistory = append(result.AttemptHistory, TaskRunAttempt{
+					Attempt:    result.Attempts,
+					Error:      result.Result.ErrorDB(),
+					CreatedAt:  result.CreatedAt,
+					FinishedAt: result.FinishedAt,
+				})
+			}
 		}
 
 		// store task run
@@ -219,8 +231,9 @@ func (s *scheduler) Run() {
 			continue
 		}
 
-		// if task hasn't reached it's max retry count yet, we schedule it again
-		if result.Attempts < uint(result.Task.TaskRetries()) && result.Result.Error != nil {
+		// if task hasn't reached it's max retry count yet and its retry policy
+		// covers the error, we schedule it again
+		if result.Attempts < uint(result.Task.TaskRetries()) && result.Task.Base().TaskRetryPolicy().ShouldRetry(result.Result, result.runInfo) {
 			// we immediately increase the in-flight counter so the pipeline doesn't terminate
 			// while we wait for the next retry
 			s.waiting++
@@ -230,6 +243,8 @@ func (s *scheduler) Run() {
 				Min:    result.Task.TaskMinBackoff(),
 				Max:    result.Task.TaskMaxBackoff(),
 			}
+			delay := backoff.ForAttempt(float64(result.Attempts - 1)) // we subtract 1 because backoff 0-indexes
+			s.logger.Debugw("retrying task run", "dot_id", result.Task.DotID(), "attempts", result.Attempts, "backoff", delay, "err", result.Result.Error)
 
 			go func(vars Vars) {
 				select {
@@ -242,7 +257,7 @@ func (s *scheduler) Run() {
 						CreatedAt:  now, // TODO: more accurate start time
 						FinishedAt: null.TimeFrom(now),
 					})
-				case <-time.After(backoff.ForAttempt(float64(result.Attempts - 1))): // we subtract 1 because backoff 0-indexes
+				case <-time.After(delay):
 					// schedule a new attempt
 					run := s.newMemoryTaskRun(result.Task, vars)
 					run.attempts = result.Attempts
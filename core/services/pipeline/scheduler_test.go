@@ -15,6 +15,7 @@ import (
 type event struct {
 	expected string
 	result   Result
+	runInfo  RunInfo
 }
 
 func TestScheduler(t *testing.T) {
@@ -74,6 +75,47 @@ func TestScheduler(t *testing.T) {
 				// a is marked as errored with the last error in sequence
 				require.Equal(t, uint(3), result.Attempts)
 				require.Equal(t, ErrTimeout, result.Result.Error)
+				// every attempt is recorded
+				require.Len(t, result.AttemptHistory, 3)
+				for i, attempt := range result.AttemptHistory {
+					require.Equal(t, uint(i+1), attempt.Attempt)
+				}
+				require.Equal(t, ErrTaskRunFailed.Error(), result.AttemptHistory[0].Error.String)
+				require.Equal(t, ErrTimeout.Error(), result.AttemptHistory[2].Error.String)
+			},
+		},
+		{
+			name: "retryOn: only retry errors covered by the policy",
+			spec: `
+			a [type=median retries=5 minBackoff="1us" maxBackoff="1us" retryOn="http5xx,timeout"]
+			b [type=median index=0]
+			a -> b`,
+			events: []event{
+				{
+					expected: "a",
+					result:   Result{Error: ErrTaskRunFailed},
+					runInfo:  RunInfo{HTTPStatusCode: 503},
+				},
+				{
+					expected: "a",
+					result:   Result{Error: ErrTimeout},
+				},
+				{
+					expected: "a",
+					result:   Result{Error: ErrTaskRunFailed},
+					runInfo:  RunInfo{HTTPStatusCode: 404},
+				},
+				// 404 is not covered by the policy, so `a` is not retried
+				{
+					expected: "b",
+					result:   Result{Value: 1},
+				},
+			},
+			assertion: func(t *testing.T, p Pipeline, results map[int]TaskRunResult) {
+				result := results[p.ByDotID("a").ID()]
+				require.Equal(t, uint(3), result.Attempts)
+				require.Len(t, result.AttemptHistory, 3)
+				require.Equal(t, ErrTaskRunFailed, result.Result.Error)
 			},
 		},
 		{
@@ -150,6 +192,7 @@ func TestScheduler(t *testing.T) {
 					Result:     event.result,
 					FinishedAt: null.TimeFrom(now),
 					CreatedAt:  now,
+					runInfo:    event.runInfo,
 				})
 			case <-time.After(time.Second):
 				t.Fatal("timed out waiting for task run")
@@ -0,0 +1,171 @@
+package pipeline
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+)
+
+var ErrSimulationStubMissing = errors.New("no stubbed response for task")
+
+// SimulatedResponse is returned in place of the network response of a task
+// during a simulated run.
+type SimulatedResponse struct {
+	Value interface{} `json:"value"`
+	Error string      `json:"error,omitempty"`
+}
+
+// SimulatedTx describes a transaction an ethtx task would have sent.
+type SimulatedTx struct {
+	DotID    string         `json:"dotID"`
+	To       common.Address `json:"to"`
+	Data     hexutil.Bytes  `json:"data"`
+	GasLimit *uint64        `json:"gasLimit,omitempty"`
+}
+
+// Simulation turns a pipeline run into a dry run. http, bridge and ethcall
+// tasks return the stub registered for their dot ID instead of performing any
+// I/O, and ethtx tasks are recorded without creating a transaction.
+//
+// A Simulation is attached to a run via WithSimulation and is safe for
+// concurrent use by the tasks of that run.
+type Simulation struct {
+	// Stubs maps task dot IDs to their canned response.
+	Stubs map[string]SimulatedResponse
+
+	mu     sync.Mutex
+	inputs map[string][]Result
+	txs    []SimulatedTx
+}
+
+func NewSimulation(stubs map[string]SimulatedResponse) *Simulation {
+	if stubs == nil {
+		stubs = make(map[string]SimulatedResponse)
+	}
+	return &Simulation{
+		Stubs:  stubs,
+		inputs: make(map[string][]Result),
+	}
+}
+
+const ctxSimulationKey contextKey = "simulation"
+
+// WithSimulation returns a context which causes runs executed with it to be
+// simulated by sim.
+func WithSimulation(ctx context.Context, sim *Simulation) context.Context {
+	if sim == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, ctxSimulationKey, sim)
+}
+
+func GetSimulation(ctx context.Context) *Simulation {
+	sim, ok := ctx.Value(ctxSimulationKey).(*Simulation)
+	if !ok {
+		return nil
+	}
+	return sim
+}
+
+// Inputs returns the inputs the task with the given dot ID received on its
+// last attempt.
+func (s *Simulation) Inputs(dotID string) []Result {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.inputs[dotID]
+}
+
+// Transactions returns the transactions recorded in place of ethtx tasks.
+func (s *Simulation) Transactions() []SimulatedTx {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]SimulatedTx(nil), s.txs...)
+}
+
+func (s *Simulation) recordInputs(dotID string, inputs []Result) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.inputs[dotID] = inputs
+}
+
+// intercepts reports whether the task must not be executed for real.
+func (s *Simulation) intercepts(task Task) bool {
+	switch task.Type() {
+	case TaskTypeHTTP, TaskTypeBridge, TaskTypeETHCall, TaskTypeETHTx:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s *Simulation) run(task Task, vars Vars) Result {
+	if t, ok := task.(*ETHTxTask); ok {
+		return s.recordTx(t, vars)
+	}
+
+	stub, ok := s.Stubs[task.DotID()]
+	if !ok {
+		return Result{Error: errors.Wrapf(ErrSimulationStubMissing, "%s (%s)", task.DotID(), task.Type())}
+	}
+	if stub.Error != "" {
+		return Result{Error: errors.New(stub.Error)}
+	}
+
+	switch task.Type() {
+	case TaskTypeETHCall:
+		// ethcall returns raw bytes, accept them hex encoded
+		if str, isString := stub.Value.(string); isString {
+			b, err := hexutil.Decode(str)
+			if err != nil {
+				return Result{Error: errors.Wrapf(err, "invalid stubbed response for %s", task.DotID())}
+			}
+			return Result{Value: b}
+		}
+	case TaskTypeHTTP, TaskTypeBridge:
+		// http and bridge return the response body as a string
+		if _, isString := stub.Value.(string); !isString {
+			b, err := json.Marshal(stub.Value)
+			if err != nil {
+				return Result{Error: errors.Wrapf(err, "invalid stubbed response for %s", task.DotID())}
+			}
+			return Result{Value: string(b)}
+		}
+	default:
+	}
+	return Result{Value: stub.Value}
+}
+
+func (s *Simulation) recordTx(t *ETHTxTask, vars Vars) Result {
+	var (
+		toAddr   AddressParam
+		data     BytesParam
+		gasLimit MaybeUint64Param
+	)
+	err := multierr.Combine(
+		errors.Wrap(ResolveParam(&toAddr, From(VarExpr(t.To, vars), NonemptyString(t.To))), "to"),
+		errors.Wrap(ResolveParam(&data, From(VarExpr(t.Data, vars), NonemptyString(t.Data))), "data"),
+		errors.Wrap(ResolveParam(&gasLimit, From(VarExpr(t.GasLimit, vars), NonemptyString(t.GasLimit), "")), "gasLimit"),
+	)
+	if err != nil {
+		return Result{Error: err}
+	}
+
+	tx := SimulatedTx{
+		DotID: t.DotID(),
+		To:    common.Address(toAddr),
+		Data:  hexutil.Bytes(data),
+	}
+	if limit, isSet := gasLimit.Uint64(); isSet {
+		tx.GasLimit = &limit
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.txs = append(s.txs, tx)
+	return Result{}
+}
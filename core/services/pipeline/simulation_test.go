@@ -0,0 +1,101 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func Test_PipelineRunner_Simulation(t *testing.T) {
+	cfg := configtest.NewTestGeneralConfig(t)
+	r := pipeline.NewRunner(nil, nil, cfg.JobPipeline(), cfg.WebServer(), nil, nil, nil, logger.TestLogger(t), nil, nil)
+
+	spec := pipeline.Spec{DotDagSource: `
+ds1          [type=http method=GET url="https://chain.link/price"]
+ds1_parse    [type=jsonparse path="price"]
+ds2          [type=bridge name="price-adapter"]
+ds2_parse    [type=jsonparse path="data,price"]
+ds3          [type=ethcall contract="0x613a38AC1659769640aaE063C651F48E0250454C" data="0x01" evmChainID=0]
+answer       [type=median index=0]
+submit       [type=ethtx to="$(jobRun.to)" data="0xdeadbeef" gasLimit=21000 evmChainID=0]
+
+ds1 -> ds1_parse -> answer
+ds2 -> ds2_parse -> answer
+answer -> submit
+ds3
+`}
+	vars := map[string]interface{}{
+		"jobRun": map[string]interface{}{
+			"to": "0x613a38AC1659769640aaE063C651F48E0250454C",
+		},
+	}
+	sim := pipeline.NewSimulation(map[string]pipeline.SimulatedResponse{
+		"ds1": {Value: `{"price": 100}`},
+		"ds2": {Value: map[string]interface{}{"data": map[string]interface{}{"price": 200}}},
+	})
+
+	ctx := pipeline.WithSimulation(testutils.Context(t), sim)
+	run, trrs, err := r.ExecuteRun(ctx, spec, pipeline.NewVarsFrom(vars))
+	require.NoError(t, err)
+	require.Len(t, trrs, 7)
+	require.True(t, run.FinishedAt.Valid)
+
+	results := make(map[string]pipeline.TaskRunResult)
+	for _, trr := range trrs {
+		results[trr.Task.DotID()] = trr
+	}
+
+	assert.Equal(t, `{"price": 100}`, results["ds1"].Result.Value)
+	assert.Equal(t, `{"data":{"price":200}}`, results["ds2"].Result.Value)
+	require.NoError(t, results["answer"].Result.Error)
+	assert.Equal(t, "150", results["answer"].Result.Value.(decimal.Decimal).String())
+
+	// missing stubs fail the task instead of reaching out to the network
+	require.ErrorIs(t, results["ds3"].Result.Error, pipeline.ErrSimulationStubMissing)
+
+	require.NoError(t, results["submit"].Result.Error)
+	txs := sim.Transactions()
+	require.Len(t, txs, 1)
+	assert.Equal(t, "submit", txs[0].DotID)
+	assert.Equal(t, common.HexToAddress("0x613a38AC1659769640aaE063C651F48E0250454C"), txs[0].To)
+	assert.Equal(t, "0xdeadbeef", txs[0].Data.String())
+	require.NotNil(t, txs[0].GasLimit)
+	assert.Equal(t, uint64(21000), *txs[0].GasLimit)
+
+	inputs := sim.Inputs("answer")
+	require.Len(t, inputs, 2)
+}
+
+func Test_PipelineRunner_SimulationStubs(t *testing.T) {
+	cfg := configtest.NewTestGeneralConfig(t)
+	r := pipeline.NewRunner(nil, nil, cfg.JobPipeline(), cfg.WebServer(), nil, nil, nil, logger.TestLogger(t), nil, nil)
+
+	spec := pipeline.Spec{DotDagSource: `
+call [type=ethcall contract="0x613a38AC1659769640aaE063C651F48E0250454C" data="0x01" evmChainID=0]
+fail [type=http method=GET url="https://chain.link"]
+`}
+	sim := pipeline.NewSimulation(map[string]pipeline.SimulatedResponse{
+		"call": {Value: "0x0102"},
+		"fail": {Error: "connection refused"},
+	})
+
+	_, trrs, err := r.ExecuteRun(pipeline.WithSimulation(testutils.Context(t), sim), spec, pipeline.NewVarsFrom(nil))
+	require.NoError(t, err)
+	require.Len(t, trrs, 2)
+	for _, trr := range trrs {
+		switch trr.Task.DotID() {
+		case "call":
+			assert.Equal(t, []byte{1, 2}, trr.Result.Value)
+		case "fail":
+			assert.EqualError(t, trr.Result.Error, "connection refused")
+		}
+	}
+}
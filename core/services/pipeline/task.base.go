@@ -21,12 +21,14 @@ type BaseTask struct {
 	Retries    null.Uint32   `mapstructure:"retries"`
 	MinBackoff time.Duration `mapstructure:"minBackoff"`
 	MaxBackoff time.Duration `mapstructure:"maxBackoff"`
+	RetryOn    string        `mapstructure:"retryOn"`
 
 	Tags string `mapstructure:"tags" json:"-"`
 
 	StreamID null.Uint32 `mapstructure:"streamID"`
 
-	uuid uuid.UUID
+	uuid        uuid.UUID
+	retryPolicy RetryPolicy
 }
 
 func NewBaseTask(id int, dotID string, inputs []TaskDependency, outputs []Task, index int32) BaseTask {
@@ -76,12 +78,20 @@ func (t BaseTask) TaskMinBackoff() time.Duration {
 }
 
 func (t BaseTask) TaskMaxBackoff() time.Duration {
-	if t.MinBackoff > 0 {
+	if t.MaxBackoff > 0 {
 		return t.MaxBackoff
 	}
+	if t.MinBackoff > time.Minute {
+		return t.MinBackoff
+	}
 	return time.Minute
 }
 
+// TaskRetryPolicy returns the policy parsed from the retryOn attribute.
+func (t BaseTask) TaskRetryPolicy() RetryPolicy {
+	return t.retryPolicy
+}
+
 func (t BaseTask) TaskTags() string {
 	return t.Tags
 }
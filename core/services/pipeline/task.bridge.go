@@ -229,7 +229,7 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 
 		promBridgeErrors.WithLabelValues(t.Name).Inc()
 		if cacheTTL == 0 {
-			return Result{Error: err}, RunInfo{IsRetryable: isRetryableHTTPError(statusCode, err)}
+			return Result{Error: err}, RunInfo{IsRetryable: isRetryableHTTPError(statusCode, err), HTTPStatusCode: statusCode}
 		}
 
 		var cacheErr error
@@ -242,7 +242,7 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 					"url", url.String(),
 				)
 			}
-			return Result{Error: err}, RunInfo{IsRetryable: isRetryableHTTPError(statusCode, err)}
+			return Result{Error: err}, RunInfo{IsRetryable: isRetryableHTTPError(statusCode, err), HTTPStatusCode: statusCode}
 		}
 		promBridgeCacheHits.WithLabelValues(t.Name).Inc()
 		lggr.Debugw("Bridge task: request failed, falling back to cache",
@@ -0,0 +1,228 @@
+package pipeline
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"math/big"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/itchyny/gojq"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+)
+
+// jqVariableRegexp matches the variable names that can be bound in a jq
+// query, i.e. the top level vars of a run whose name is a valid jq identifier.
+var jqVariableRegexp = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// JQTask evaluates a jq expression against its input, e.g.
+//
+//	price [type=jq query=<[.data[] | select(.volume > $jobRun.minVolume) | .price] | add / length>]
+//
+// The top level variables of the run, including the results of previous
+// tasks, are available in the query as $name, e.g. $jobRun or $ds1_parse.
+// The query must produce exactly one value. When lax is enabled, a query
+// producing no value returns nil instead of an error.
+//
+// Return types:
+//
+//	int
+//	*big.Int
+//	float64
+//	string
+//	bool
+//	map[string]interface{}
+//	[]interface{}
+//	nil
+type JQTask struct {
+	BaseTask `mapstructure:",squash"`
+	Query    string `json:"query"`
+	Data     string `json:"data"`
+	Lax      string `json:"lax"`
+}
+
+var _ Task = (*JQTask)(nil)
+
+func (t *JQTask) Type() TaskType {
+	return TaskTypeJQ
+}
+
+func (t *JQTask) Run(ctx context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		query StringParam
+		data  jqInputParam
+		lax   BoolParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&query, From(NonemptyString(t.Query))), "query"),
+		errors.Wrap(ResolveParam(&data, From(VarExpr(t.Data, vars), JSONWithVarExprs(t.Data, vars, false), Input(inputs, 0))), "data"),
+		errors.Wrap(ResolveParam(&lax, From(NonemptyString(t.Lax), false)), "lax"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	parsed, err := gojq.Parse(string(query))
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrBadInput, "invalid jq query: %v", err)}, runInfo
+	}
+
+	names, values, err := jqVariables(string(query), vars)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	code, err := gojq.Compile(parsed, gojq.WithVariables(names))
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrBadInput, "invalid jq query: %v", err)}, runInfo
+	}
+
+	var outputs []interface{}
+	iter := code.RunWithContext(ctx, data.v, values...)
+	for {
+		v, ok := iter.Next()
+		if !ok {
+			break
+		}
+		if err, isErr := v.(error); isErr {
+			return Result{Error: errors.Wrap(err, "jq")}, runInfo
+		}
+		outputs = append(outputs, v)
+		if len(outputs) > 1 {
+			return Result{Error: errors.Wrap(ErrBadInput, "jq query produced more than one value, wrap it in [...] to collect them into an array")}, runInfo
+		}
+	}
+
+	if len(outputs) == 0 {
+		if bool(lax) {
+			return Result{Value: nil}, runInfo
+		}
+		return Result{Error: errors.Wrapf(ErrKeypathNotFound, "jq query %q produced no value", string(query))}, runInfo
+	}
+	return Result{Value: outputs[0]}, runInfo
+}
+
+// jqVariables returns the run variables referenced by the query, in the form
+// expected by gojq.
+func jqVariables(query string, vars Vars) (names []string, values []interface{}, err error) {
+	for name := range vars.vars {
+		if !jqVariableRegexp.MatchString(name) || !strings.Contains(query, "$"+name) {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	values = make([]interface{}, len(names))
+	for i, name := range names {
+		values[i], err = toJQValue(vars.vars[name])
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "variable $%s", name)
+		}
+		names[i] = "$" + name
+	}
+	return names, values, nil
+}
+
+// toJQValue converts pipeline values into the JSON-like values gojq operates
+// on. Numbers are kept exact where gojq allows it.
+func toJQValue(val interface{}) (interface{}, error) {
+	switch v := val.(type) {
+	case nil, bool, string, json.Number, *big.Int,
+		int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return v, nil
+	case error:
+		// failed tasks are bound as null
+		return nil, nil
+	case big.Int:
+		return &v, nil
+	case decimal.Decimal:
+		return json.Number(v.String()), nil
+	case *decimal.Decimal:
+		if v == nil {
+			return nil, nil
+		}
+		return json.Number(v.String()), nil
+	case []byte:
+		return hexutil.Encode(v), nil
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, elem := range v {
+			converted, err := toJQValue(elem)
+			if err != nil {
+				return nil, err
+			}
+			m[key] = converted
+		}
+		return m, nil
+	case []interface{}:
+		s := make([]interface{}, len(v))
+		for i, elem := range v {
+			converted, err := toJQValue(elem)
+			if err != nil {
+				return nil, err
+			}
+			s[i] = converted
+		}
+		return s, nil
+	default:
+		// anything else is passed through its JSON representation
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, errors.Wrapf(ErrBadInput, "cannot convert %T to a jq value: %v", val, err)
+		}
+		var decoded interface{}
+		d := json.NewDecoder(bytes.NewReader(b))
+		d.UseNumber()
+		if err = d.Decode(&decoded); err != nil {
+			return nil, errors.Wrapf(ErrBadInput, "cannot convert %T to a jq value: %v", val, err)
+		}
+		return decoded, nil
+	}
+}
+
+// jqInputParam is the document a jq query runs against. Strings and bytes
+// are parsed as JSON, anything else is used as is.
+type jqInputParam struct {
+	v interface{}
+}
+
+func (p *jqInputParam) UnmarshalPipelineParam(val interface{}) error {
+	switch v := val.(type) {
+	case string:
+		return p.UnmarshalPipelineParam([]byte(v))
+	case []byte:
+		var decoded interface{}
+		d := json.NewDecoder(bytes.NewReader(v))
+		d.UseNumber()
+		if err := d.Decode(&decoded); err != nil {
+			return errors.Wrapf(ErrBadInput, "expected JSON, got %q: %v", v, err)
+		}
+		p.v = decoded
+		return nil
+	case ObjectParam:
+		b, err := v.MarshalJSON()
+		if err != nil {
+			return err
+		}
+		return p.UnmarshalPipelineParam(b)
+	default:
+		converted, err := toJQValue(v)
+		if err != nil {
+			return err
+		}
+		p.v = converted
+		return nil
+	}
+}
@@ -0,0 +1,237 @@
+package pipeline_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestJQTask(t *testing.T) {
+	t.Parallel()
+
+	prices := `{"data":[{"symbol":"ETH","price":3000.5,"volume":10},{"symbol":"BTC","price":60000,"volume":2},{"symbol":"LINK","price":20,"volume":500}]}`
+
+	tests := []struct {
+		name              string
+		query             string
+		data              string
+		lax               string
+		vars              pipeline.Vars
+		inputs            []pipeline.Result
+		want              interface{}
+		wantErrorCause    error
+		wantErrorContains string
+	}{
+		{
+			"projection",
+			".data[0].symbol",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: prices}},
+			"ETH",
+			nil,
+			"",
+		},
+		{
+			"filter and arithmetic",
+			"[.data[] | select(.volume >= 10) | .price] | add",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: prices}},
+			3020.5,
+			nil,
+			"",
+		},
+		{
+			"collect into an array",
+			"[.data[].symbol]",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: prices}},
+			[]interface{}{"ETH", "BTC", "LINK"},
+			nil,
+			"",
+		},
+		{
+			"data from variable and variable bindings",
+			`.data[] | select(.symbol == $jobRun.symbol) | .price * $factor`,
+			"$(ds1)",
+			"",
+			pipeline.NewVarsFrom(map[string]interface{}{
+				"ds1":    prices,
+				"jobRun": map[string]interface{}{"symbol": "LINK"},
+				"factor": decimal.NewFromInt(100),
+			}),
+			nil,
+			2000,
+			nil,
+			"",
+		},
+		{
+			"data from JSON with variables",
+			`.a + .b`,
+			`{"a": $(foo), "b": 2}`,
+			"",
+			pipeline.NewVarsFrom(map[string]interface{}{"foo": 40}),
+			nil,
+			42,
+			nil,
+			"",
+		},
+		{
+			"big integers are kept exact",
+			".id",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: `{"id":115792089237316195423570985008687907853269984665640564039457584007913129639935}`}},
+			func() *big.Int {
+				i, _ := new(big.Int).SetString("115792089237316195423570985008687907853269984665640564039457584007913129639935", 10)
+				return i
+			}(),
+			nil,
+			"",
+		},
+		{
+			"no value",
+			".data[] | select(.symbol == \"DOGE\")",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: prices}},
+			nil,
+			pipeline.ErrKeypathNotFound,
+			"produced no value",
+		},
+		{
+			"no value with lax",
+			".data[] | select(.symbol == \"DOGE\")",
+			"",
+			"true",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: prices}},
+			nil,
+			nil,
+			"",
+		},
+		{
+			"more than one value",
+			".data[].symbol",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: prices}},
+			nil,
+			pipeline.ErrBadInput,
+			"more than one value",
+		},
+		{
+			"invalid query",
+			".data[",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: prices}},
+			nil,
+			pipeline.ErrBadInput,
+			"invalid jq query",
+		},
+		{
+			"undefined variable",
+			".data | $missing",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: prices}},
+			nil,
+			pipeline.ErrBadInput,
+			"variable not defined: $missing",
+		},
+		{
+			"runtime error",
+			".data[0].symbol + 1",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: prices}},
+			nil,
+			nil,
+			"cannot add",
+		},
+		{
+			"invalid JSON input",
+			".",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: `{"foo`}},
+			nil,
+			pipeline.ErrBadInput,
+			"expected JSON",
+		},
+		{
+			"input error",
+			".",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Error: errors.New("foo")}},
+			nil,
+			pipeline.ErrTooManyErrors,
+			"task inputs",
+		},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			task := pipeline.JQTask{
+				BaseTask: pipeline.NewBaseTask(0, "jq", nil, nil, 0),
+				Query:    test.query,
+				Data:     test.data,
+				Lax:      test.lax,
+			}
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), test.vars, test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+
+			if test.wantErrorCause != nil || test.wantErrorContains != "" {
+				require.Error(t, result.Error)
+				if test.wantErrorCause != nil {
+					require.Equal(t, test.wantErrorCause, errors.Cause(result.Error))
+				}
+				require.Contains(t, result.Error.Error(), test.wantErrorContains)
+				return
+			}
+			require.NoError(t, result.Error)
+			require.Equal(t, test.want, result.Value)
+		})
+	}
+}
+
+func TestJQTask_Parse(t *testing.T) {
+	t.Parallel()
+
+	p, err := pipeline.Parse(`price [type=jq data="$(ds1)" query=<.data[] | select(.symbol == "LINK") | .price> lax=true]`)
+	require.NoError(t, err)
+	require.Len(t, p.Tasks, 1)
+
+	task, ok := p.Tasks[0].(*pipeline.JQTask)
+	require.True(t, ok)
+	assert.Equal(t, `.data[] | select(.symbol == "LINK") | .price`, task.Query)
+	assert.Equal(t, "$(ds1)", task.Data)
+	assert.Equal(t, "true", task.Lax)
+}
@@ -0,0 +1,165 @@
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	pkgerrors "github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+)
+
+const (
+	// MapElementKey and MapIndexKey are the variables holding the current
+	// element and its index inside a map task's sub-pipeline.
+	MapElementKey = "element"
+	MapIndexKey   = "index"
+
+	defaultMapConcurrency = 1
+)
+
+// MapTask runs an embedded sub-pipeline once for every element of its input
+// array and returns the results in input order.
+//
+// The sub-pipeline is given as a quoted DOT string. Inside it, the current
+// element and its index are available as $(element) and $(index) and the
+// variables of the enclosing run can be referenced as usual. Nested quotes
+// must be escaped, or attribute values can be written as <...> instead:
+//
+//	fetch [type=map
+//	       values="$(feeds)"
+//	       concurrency=4
+//	       pipeline="req [type=http method=GET url=<$(element)>]; parse [type=jsonparse path=<data,result>]; req -> parse"]
+//
+// If the sub-pipeline has more than one terminal task, the result for an
+// element is the array of their values. Elements whose sub-pipeline fails
+// are dropped from the result while there are no more than allowedFaults
+// of them.
+//
+// Return types:
+//
+//	[]interface{}
+type MapTask struct {
+	BaseTask      `mapstructure:",squash"`
+	Values        string `json:"values"`
+	Pipeline      string `json:"pipeline"`
+	Concurrency   string `json:"concurrency"`
+	AllowedFaults string `json:"allowedFaults"`
+
+	subPipeline *Pipeline
+	runner      *runner
+	spec        Spec
+}
+
+var _ Task = (*MapTask)(nil)
+
+func (t *MapTask) Type() TaskType {
+	return TaskTypeMap
+}
+
+// parseSubPipeline parses the embedded pipeline once when the enclosing
+// pipeline is parsed, so that invalid specs are rejected early.
+func (t *MapTask) parseSubPipeline() error {
+	source := strings.ReplaceAll(t.Pipeline, `\"`, `"`)
+	p, err := Parse(source)
+	if err != nil {
+		return pkgerrors.Wrapf(err, "invalid sub-pipeline for map task %s", t.DotID())
+	}
+	if p.RequiresPreInsert() {
+		return pkgerrors.Errorf("map task %s: sub-pipelines may not contain ethtx or async bridge tasks", t.DotID())
+	}
+	t.subPipeline = p
+	return nil
+}
+
+func (t *MapTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	var (
+		values             SliceParam
+		concurrency        MaybeUint64Param
+		maybeAllowedFaults MaybeUint64Param
+	)
+	err := multierr.Combine(
+		pkgerrors.Wrap(ResolveParam(&values, From(VarExpr(t.Values, vars), JSONWithVarExprs(t.Values, vars, false), Input(inputs, 0))), "values"),
+		pkgerrors.Wrap(ResolveParam(&concurrency, From(t.Concurrency)), "concurrency"),
+		pkgerrors.Wrap(ResolveParam(&maybeAllowedFaults, From(t.AllowedFaults)), "allowedFaults"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	if t.runner == nil || t.subPipeline == nil {
+		return Result{Error: pkgerrors.Errorf("map task %s was not initialized", t.DotID())}, runInfo
+	}
+
+	limit := defaultMapConcurrency
+	if c, isSet := concurrency.Uint64(); isSet && c > 0 {
+		limit = int(c)
+	}
+	var allowedFaults int
+	if allowed, isSet := maybeAllowedFaults.Uint64(); isSet {
+		allowedFaults = int(allowed)
+	}
+
+	results := make([]Result, len(values))
+	sem := make(chan struct{}, limit)
+	var wg sync.WaitGroup
+	for i, element := range values {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			results[i] = Result{Error: ctx.Err()}
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			results[i] = t.runElement(ctx, vars, i, element)
+		}()
+	}
+	wg.Wait()
+
+	var (
+		out    = make([]interface{}, 0, len(values))
+		faults int
+		errs   error
+	)
+	for i, r := range results {
+		if r.Error != nil {
+			faults++
+			errs = multierr.Append(errs, fmt.Errorf("element %d: %w", i, r.Error))
+			continue
+		}
+		out = append(out, r.Value)
+	}
+	if faults > allowedFaults {
+		return Result{Error: pkgerrors.Wrapf(ErrTooManyErrors, "number of failed elements %v in map task > number allowed faults %v: %v", faults, allowedFaults, errs)}, runInfo
+	} else if faults > 0 {
+		lggr.Debugw("map task dropped failed elements", "dotID", t.DotID(), "faults", faults, "err", errs)
+	}
+	return Result{Value: out}, runInfo
+}
+
+func (t *MapTask) runElement(ctx context.Context, vars Vars, index int, element interface{}) Result {
+	elementVars := vars.Copy()
+	if err := multierr.Combine(
+		elementVars.Set(MapElementKey, element),
+		elementVars.Set(MapIndexKey, index),
+	); err != nil {
+		return Result{Error: err}
+	}
+
+	fr := t.runner.runSubPipeline(ctx, t.spec, t.subPipeline, elementVars).FinalResult()
+	if fr.HasFatalErrors() {
+		return Result{Error: errors.Join(fr.FatalErrors...)}
+	}
+	if len(fr.Values) == 1 {
+		return Result{Value: fr.Values[0]}
+	}
+	return Result{Value: fr.Values}
+}
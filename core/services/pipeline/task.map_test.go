@@ -0,0 +1,122 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestMapTask(t *testing.T) {
+	t.Parallel()
+
+	cfg := configtest.NewTestGeneralConfig(t)
+	r := pipeline.NewRunner(nil, nil, cfg.JobPipeline(), cfg.WebServer(), nil, nil, nil, logger.TestLogger(t), nil, nil)
+
+	tests := []struct {
+		name        string
+		source      string
+		vars        map[string]interface{}
+		want        []string
+		wantErrorIs error
+	}{
+		{
+			"multiplies every element",
+			`
+map    [type=map values="$(foo.values)" concurrency=2 pipeline="mul [type=multiply input=\"$(element)\" times=\"$(foo.times)\"]"]
+answer [type=median]
+map -> answer
+`,
+			map[string]interface{}{"foo": map[string]interface{}{"values": []interface{}{1, 2, 3}, "times": 10}},
+			[]string{"10", "20", "30"},
+			nil,
+		},
+		{
+			"binds index and takes input from the previous task",
+			`
+values [type=jsonparse data="$(json)" path="v"]
+map    [type=map pipeline="sum [type=sum values=<[ $(element), $(index) ]>]"]
+values -> map
+`,
+			map[string]interface{}{"json": `{"v": [5, 5, 5]}`},
+			[]string{"5", "6", "7"},
+			nil,
+		},
+		{
+			"drops failed elements within allowed faults",
+			`map [type=map values=<[1, 0, 4]> allowedFaults=1 pipeline="div [type=divide input=1 divisor=<$(element)>]"]`,
+			nil,
+			[]string{"1", "0.25"},
+			nil,
+		},
+		{
+			"fails when too many elements fail",
+			`map [type=map values=<[1, 0, 0]> allowedFaults=1 pipeline="div [type=divide input=1 divisor=<$(element)>]"]`,
+			nil,
+			nil,
+			pipeline.ErrTooManyErrors,
+		},
+		{
+			"empty input",
+			`map [type=map values=<[]> pipeline="mul [type=multiply input=<$(element)> times=2]"]`,
+			nil,
+			[]string{},
+			nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			spec := pipeline.Spec{DotDagSource: test.source}
+			_, trrs, err := r.ExecuteRun(testutils.Context(t), spec, pipeline.NewVarsFrom(test.vars))
+			require.NoError(t, err)
+
+			var result pipeline.Result
+			for _, trr := range trrs {
+				if trr.Task.Type() == pipeline.TaskTypeMap {
+					result = trr.Result
+				}
+			}
+			if test.wantErrorIs != nil {
+				require.ErrorIs(t, result.Error, test.wantErrorIs)
+				return
+			}
+			require.NoError(t, result.Error)
+
+			values, ok := result.Value.([]interface{})
+			require.True(t, ok)
+			got := make([]string, len(values))
+			for i, v := range values {
+				got[i] = v.(decimal.Decimal).String()
+			}
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestMapTask_Parse(t *testing.T) {
+	t.Parallel()
+
+	_, err := pipeline.Parse(`map [type=map values=<[1]> pipeline="a [type=multiply"]`)
+	require.ErrorContains(t, err, "invalid sub-pipeline for map task map")
+
+	_, err = pipeline.Parse(`map [type=map values=<[1]> pipeline=""]`)
+	require.ErrorContains(t, err, "empty pipeline")
+
+	_, err = pipeline.Parse(`map [type=map values=<[1]> pipeline="tx [type=ethtx to=<$(element)> data=<0x>]"]`)
+	require.ErrorContains(t, err, "sub-pipelines may not contain ethtx or async bridge tasks")
+
+	p, err := pipeline.Parse(`map [type=map values=<[1]> pipeline="a [type=multiply input=<$(element)> times=2]; b [type=sum values=<[ $(a) ]>]; a -> b"]`)
+	require.NoError(t, err)
+	require.Len(t, p.Tasks, 1)
+	assert.Equal(t, pipeline.TaskTypeMap, p.Tasks[0].Type())
+}
@@ -0,0 +1,395 @@
+package pipeline
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/common/types/traits"
+	"github.com/google/cel-go/ext"
+	"github.com/google/cel-go/interpreter"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+const (
+	// DefaultScriptCostLimit bounds the work a script task may do, see
+	// https://github.com/google/cel-spec/blob/master/doc/langdef.md#performance
+	DefaultScriptCostLimit = 1_000_000
+	// MaxScriptLength is the maximum size of a script in bytes.
+	MaxScriptLength = 16 * 1024
+
+	scriptInterruptCheckFrequency = 100
+)
+
+var ErrScriptCostExceeded = errors.New("script exceeded its cost limit")
+
+// ScriptTask evaluates a CEL expression (https://github.com/google/cel-spec)
+// in-process. CEL is side effect free, not Turing complete and evaluation is
+// bounded by maxCost as well as the task timeout, so scripts are
+// deterministic and can't exhaust the node's resources.
+//
+// The task inputs are available as the list `inputs` (failed inputs are null)
+// and the top level variables of the run as the map `vars`, e.g.
+//
+//	answer [type=script resultType=decimal expr=<
+//	    cel.bind(prices, inputs.filter(p, p != null),
+//	        mean(prices.filter(p, p >= median(prices) * 0.95 && p <= median(prices) * 1.05)))
+//	>]
+//
+// Numbers are always passed to the script as double, so integer literals
+// combined with them must be written as doubles (e.g. 2.0). Doubles only hold
+// 53 bits of precision, so larger or more precise numbers, such as wei
+// amounts, are rounded to the nearest double. Values which must be kept exact
+// should be passed as strings, which are returned exactly as int, uint or
+// decimal results. Besides the standard
+// library, the math, strings, lists, encoders and bindings extensions as well
+// as sum(list), mean(list) and median(list) are available.
+//
+// The result is converted according to resultType, for use in e.g.
+// ethabiencode tasks:
+//
+//	int, uint  *big.Int
+//	decimal    decimal.Decimal
+//	bool       bool
+//	string     string
+//	bytes      []byte (also accepts 0x prefixed hex strings)
+//	address    common.Address
+//
+// Without resultType, lists and maps are returned as []interface{} and
+// map[string]interface{} and scalars as int64, uint64, float64, string, bool
+// or []byte.
+type ScriptTask struct {
+	BaseTask   `mapstructure:",squash"`
+	Expr       string `json:"expr"`
+	ResultType string `json:"resultType"`
+	MaxCost    string `json:"maxCost"`
+}
+
+var _ Task = (*ScriptTask)(nil)
+
+func (t *ScriptTask) Type() TaskType {
+	return TaskTypeScript
+}
+
+func (t *ScriptTask) Run(ctx context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	var (
+		expr       StringParam
+		resultType StringParam
+		maxCost    MaybeUint64Param
+	)
+	err := multierr.Combine(
+		errors.Wrap(ResolveParam(&expr, From(NonemptyString(t.Expr))), "expr"),
+		errors.Wrap(ResolveParam(&resultType, From(t.ResultType)), "resultType"),
+		errors.Wrap(ResolveParam(&maxCost, From(t.MaxCost)), "maxCost"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	if len(expr) > MaxScriptLength {
+		return Result{Error: errors.Wrapf(ErrBadInput, "expr is longer than %d bytes", MaxScriptLength)}, runInfo
+	}
+	costLimit := uint64(DefaultScriptCostLimit)
+	if limit, isSet := maxCost.Uint64(); isSet {
+		costLimit = limit
+	}
+
+	env, err := scriptEnv()
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	ast, iss := env.Compile(string(expr))
+	if iss.Err() != nil {
+		return Result{Error: errors.Wrapf(ErrBadInput, "invalid expr: %v", iss.Err())}, runInfo
+	}
+	prg, err := env.Program(ast,
+		cel.CostLimit(costLimit),
+		cel.InterruptCheckFrequency(scriptInterruptCheckFrequency),
+	)
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrBadInput, "invalid expr: %v", err)}, runInfo
+	}
+
+	activation, err := scriptActivation(vars, inputs)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	val, _, err := prg.ContextEval(ctx, activation)
+	if err != nil {
+		if ctx.Err() != nil {
+			return Result{Error: errors.Wrap(ctx.Err(), "script")}, runInfo
+		}
+		var cancelled interpreter.EvalCancelledError
+		if errors.As(err, &cancelled) && cancelled.Cause == interpreter.CostLimitExceeded {
+			return Result{Error: errors.Wrapf(ErrScriptCostExceeded, "limit %d", costLimit)}, runInfo
+		}
+		return Result{Error: errors.Wrap(err, "script")}, runInfo
+	}
+
+	out, err := convertScriptResult(val, string(resultType))
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	return Result{Value: out}, runInfo
+}
+
+var scriptEnv = sync.OnceValues(func() (*cel.Env, error) {
+	return cel.NewEnv(
+		cel.Variable("inputs", cel.ListType(cel.DynType)),
+		cel.Variable("vars", cel.MapType(cel.StringType, cel.DynType)),
+		cel.ParserExpressionSizeLimit(MaxScriptLength),
+		ext.Bindings(),
+		ext.Encoders(),
+		ext.Lists(),
+		ext.Math(),
+		ext.Strings(),
+		scriptAggregate("sum", func(xs []float64) float64 {
+			var sum float64
+			for _, x := range xs {
+				sum += x
+			}
+			return sum
+		}),
+		scriptAggregate("mean", func(xs []float64) float64 {
+			var sum float64
+			for _, x := range xs {
+				sum += x
+			}
+			return sum / float64(len(xs))
+		}),
+		scriptAggregate("median", func(xs []float64) float64 {
+			sorted := append([]float64(nil), xs...)
+			sort.Float64s(sorted)
+			k := len(sorted) / 2
+			if len(sorted)%2 == 1 {
+				return sorted[k]
+			}
+			return (sorted[k-1] + sorted[k]) / 2
+		}),
+	)
+})
+
+// scriptAggregate declares a function reducing a non-empty list of numbers
+// to a double.
+func scriptAggregate(name string, fn func([]float64) float64) cel.EnvOption {
+	return cel.Function(name,
+		cel.Overload(name+"_list", []*cel.Type{cel.ListType(cel.DynType)}, cel.DoubleType,
+			cel.UnaryBinding(func(arg ref.Val) ref.Val {
+				list, ok := arg.(traits.Lister)
+				if !ok {
+					return types.MaybeNoSuchOverloadErr(arg)
+				}
+				var xs []float64
+				for it := list.Iterator(); it.HasNext() == types.True; {
+					elem := it.Next()
+					d, ok := elem.ConvertToType(types.DoubleType).(types.Double)
+					if !ok {
+						return types.NewErr("%s: expected a number, got %s", name, elem.Type().TypeName())
+					}
+					xs = append(xs, float64(d))
+				}
+				if len(xs) == 0 {
+					return types.NewErr("%s: empty list", name)
+				}
+				return types.Double(fn(xs))
+			}),
+		),
+	)
+}
+
+func scriptActivation(vars Vars, inputs []Result) (map[string]interface{}, error) {
+	in := make([]interface{}, len(inputs))
+	for i, input := range inputs {
+		if input.Error != nil {
+			continue
+		}
+		v, err := toScriptValue(input.Value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "input %d", i)
+		}
+		in[i] = v
+	}
+	vs := make(map[string]interface{}, len(vars.vars))
+	for name, val := range vars.vars {
+		v, err := toScriptValue(val)
+		if err != nil {
+			return nil, errors.Wrapf(err, "variable %s", name)
+		}
+		vs[name] = v
+	}
+	return map[string]interface{}{"inputs": in, "vars": vs}, nil
+}
+
+// toScriptValue converts pipeline values into values CEL can operate on. CEL
+// doesn't mix integers and doubles in arithmetic, so all numbers are passed as
+// double.
+func toScriptValue(val interface{}) (interface{}, error) {
+	switch v := val.(type) {
+	case nil, bool, string, []byte, float64:
+		return v, nil
+	case error:
+		return nil, nil
+	case json.Number:
+		d, err := decimal.NewFromString(v.String())
+		if err != nil {
+			return nil, errors.Wrapf(ErrBadInput, "%v", err)
+		}
+		return scriptNumber(d), nil
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32,
+		*big.Int, big.Int, *decimal.Decimal, decimal.Decimal:
+		d, err := utils.ToDecimal(v)
+		if err != nil {
+			return nil, errors.Wrapf(ErrBadInput, "%v", err)
+		}
+		return scriptNumber(d), nil
+	case common.Address:
+		return v.Hex(), nil
+	case common.Hash:
+		return v.Hex(), nil
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, elem := range v {
+			converted, err := toScriptValue(elem)
+			if err != nil {
+				return nil, err
+			}
+			m[key] = converted
+		}
+		return m, nil
+	case []interface{}:
+		s := make([]interface{}, len(v))
+		for i, elem := range v {
+			converted, err := toScriptValue(elem)
+			if err != nil {
+				return nil, err
+			}
+			s[i] = converted
+		}
+		return s, nil
+	default:
+		// anything else is passed through its JSON representation
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, errors.Wrapf(ErrBadInput, "unsupported type %T: %v", val, err)
+		}
+		var decoded interface{}
+		if err = json.Unmarshal(b, &decoded); err != nil {
+			return nil, errors.Wrapf(ErrBadInput, "unsupported type %T: %v", val, err)
+		}
+		return toScriptValue(decoded)
+	}
+}
+
+// scriptNumber returns the number as the nearest double, so every number has
+// the same type in scripts regardless of its value.
+func scriptNumber(d decimal.Decimal) interface{} {
+	f, _ := d.Float64()
+	return f
+}
+
+func convertScriptResult(val ref.Val, resultType string) (interface{}, error) {
+	native, err := fromScriptValue(val)
+	if err != nil {
+		return nil, err
+	}
+
+	switch resultType {
+	case "":
+		return native, nil
+	case "int", "uint":
+		var d DecimalParam
+		if err = d.UnmarshalPipelineParam(native); err != nil {
+			return nil, errors.Wrapf(err, "script result %v is not a number", native)
+		}
+		if !d.Decimal().IsInteger() {
+			return nil, errors.Wrapf(ErrBadInput, "script result %v is not an integer", native)
+		}
+		if resultType == "uint" && d.Decimal().IsNegative() {
+			return nil, errors.Wrapf(ErrBadInput, "script result %v is negative", native)
+		}
+		return d.Decimal().BigInt(), nil
+	case "decimal":
+		var d DecimalParam
+		if err = d.UnmarshalPipelineParam(native); err != nil {
+			return nil, errors.Wrapf(err, "script result %v is not a number", native)
+		}
+		return d.Decimal(), nil
+	case "bool":
+		if b, ok := native.(bool); ok {
+			return b, nil
+		}
+	case "string":
+		if s, ok := native.(string); ok {
+			return s, nil
+		}
+	case "bytes":
+		switch v := native.(type) {
+		case []byte:
+			return v, nil
+		case string:
+			b, err := hexutil.Decode(v)
+			if err != nil {
+				return nil, errors.Wrapf(ErrBadInput, "script result %q is not hex encoded: %v", v, err)
+			}
+			return b, nil
+		}
+	case "address":
+		var a AddressParam
+		if err = a.UnmarshalPipelineParam(native); err != nil {
+			return nil, errors.Wrapf(err, "script result %v is not an address", native)
+		}
+		return common.Address(a), nil
+	default:
+		return nil, errors.Wrapf(ErrBadInput, "unknown resultType %q", resultType)
+	}
+	return nil, errors.Wrapf(ErrBadInput, "script result of type %T can't be converted to %s", native, resultType)
+}
+
+func fromScriptValue(val ref.Val) (interface{}, error) {
+	switch v := val.(type) {
+	case types.Null:
+		return nil, nil
+	case traits.Mapper:
+		m := make(map[string]interface{})
+		for it := v.Iterator(); it.HasNext() == types.True; {
+			key := it.Next()
+			k, ok := key.(types.String)
+			if !ok {
+				return nil, errors.Wrapf(ErrBadInput, "script result: map keys must be strings, got %s", key.Type().TypeName())
+			}
+			elem, err := fromScriptValue(v.Get(key))
+			if err != nil {
+				return nil, err
+			}
+			m[string(k)] = elem
+		}
+		return m, nil
+	case traits.Lister:
+		var s []interface{}
+		for it := v.Iterator(); it.HasNext() == types.True; {
+			elem, err := fromScriptValue(it.Next())
+			if err != nil {
+				return nil, err
+			}
+			s = append(s, elem)
+		}
+		if s == nil {
+			s = []interface{}{}
+		}
+		return s, nil
+	default:
+		return val.Value(), nil
+	}
+}
@@ -0,0 +1,288 @@
+package pipeline_test
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestScriptTask(t *testing.T) {
+	t.Parallel()
+
+	prices := []pipeline.Result{
+		{Value: decimal.RequireFromString("100")},
+		{Value: "101"},
+		{Error: errors.New("adapter down")},
+		{Value: 99.5},
+		{Value: big.NewInt(250)},
+	}
+
+	tests := []struct {
+		name              string
+		expr              string
+		resultType        string
+		maxCost           string
+		vars              pipeline.Vars
+		inputs            []pipeline.Result
+		want              interface{}
+		wantErrorCause    error
+		wantErrorContains string
+	}{
+		{
+			"arithmetic on inputs",
+			"inputs[0] * 2.0 + 1.0",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: decimal.RequireFromString("20.5")}},
+			float64(42),
+			nil,
+			"",
+		},
+		{
+			"outlier rejection",
+			`cel.bind(ps, inputs.filter(p, p != null && type(p) == double),
+				mean(ps.filter(p, p >= median(ps) * 0.95 && p <= median(ps) * 1.05)))`,
+			"decimal",
+			"",
+			pipeline.NewVarsFrom(nil),
+			prices,
+			decimal.RequireFromString("99.75"),
+			nil,
+			"",
+		},
+		{
+			"weighted average from vars",
+			`sum(vars.feeds.map(f, f.price * f.weight)) / sum(vars.feeds.map(f, f.weight))`,
+			"decimal",
+			"",
+			pipeline.NewVarsFrom(map[string]interface{}{
+				"feeds": []interface{}{
+					map[string]interface{}{"price": 10, "weight": 1},
+					map[string]interface{}{"price": decimal.RequireFromString("20"), "weight": 3},
+				},
+			}),
+			nil,
+			decimal.RequireFromString("17.5"),
+			nil,
+			"",
+		},
+		{
+			"unit conversion to uint256",
+			"vars.price * 1e18",
+			"uint",
+			"",
+			pipeline.NewVarsFrom(map[string]interface{}{"price": decimal.RequireFromString("1.5")}),
+			nil,
+			big.NewInt(1_500_000_000_000_000_000),
+			nil,
+			"",
+		},
+		{
+			"large integers are rounded to the nearest double",
+			"inputs[0]",
+			"uint",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: new(big.Int).Add(new(big.Int).Lsh(big.NewInt(1), 53), big.NewInt(1))}},
+			new(big.Int).Lsh(big.NewInt(1), 53),
+			nil,
+			"",
+		},
+		{
+			"large integers are doubles in arithmetic",
+			"vars.wei / 1e18",
+			"decimal",
+			"",
+			pipeline.NewVarsFrom(map[string]interface{}{"wei": decimal.RequireFromString("1500000000000000001")}),
+			nil,
+			decimal.RequireFromString("1.5"),
+			nil,
+			"",
+		},
+		{
+			"precise numbers are doubles in arithmetic",
+			"inputs[0] * 2.0",
+			"decimal",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: json.Number("1000000000000000000.5")}},
+			decimal.RequireFromString("2000000000000000000"),
+			nil,
+			"",
+		},
+		{
+			"strings are passed exactly",
+			"inputs[0]",
+			"uint",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: "9007199254740993"}},
+			big.NewInt(9007199254740993),
+			nil,
+			"",
+		},
+		{
+			"list and map results",
+			`{"symbols": ["ETH", "LINK"], "ok": true}`,
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			nil,
+			map[string]interface{}{"symbols": []interface{}{"ETH", "LINK"}, "ok": true},
+			nil,
+			"",
+		},
+		{
+			"bytes result from hex",
+			`"0x" + vars.suffix`,
+			"bytes",
+			"",
+			pipeline.NewVarsFrom(map[string]interface{}{"suffix": "deadbeef"}),
+			nil,
+			[]byte{0xde, 0xad, 0xbe, 0xef},
+			nil,
+			"",
+		},
+		{
+			"address result",
+			`vars.addr`,
+			"address",
+			"",
+			pipeline.NewVarsFrom(map[string]interface{}{"addr": "0x613a38AC1659769640aaE063C651F48E0250454C"}),
+			nil,
+			common.HexToAddress("0x613a38AC1659769640aaE063C651F48E0250454C"),
+			nil,
+			"",
+		},
+		{
+			"non-integer result for int",
+			"1.5",
+			"int",
+			"",
+			pipeline.NewVarsFrom(nil),
+			nil,
+			nil,
+			pipeline.ErrBadInput,
+			"not an integer",
+		},
+		{
+			"negative result for uint",
+			"-1.0",
+			"uint",
+			"",
+			pipeline.NewVarsFrom(nil),
+			nil,
+			nil,
+			pipeline.ErrBadInput,
+			"is negative",
+		},
+		{
+			"unknown result type",
+			"1",
+			"float128",
+			"",
+			pipeline.NewVarsFrom(nil),
+			nil,
+			nil,
+			pipeline.ErrBadInput,
+			`unknown resultType "float128"`,
+		},
+		{
+			"invalid expression",
+			"inputs[0] +",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			nil,
+			nil,
+			pipeline.ErrBadInput,
+			"invalid expr",
+		},
+		{
+			"runtime error",
+			"vars.missing + 1.0",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			nil,
+			nil,
+			nil,
+			"no such key: missing",
+		},
+		{
+			"cost limit",
+			"[1, 2, 3, 4, 5, 6, 7, 8, 9, 10].map(x, [1, 2, 3, 4, 5, 6, 7, 8, 9, 10].map(y, x * y))",
+			"",
+			"50",
+			pipeline.NewVarsFrom(nil),
+			nil,
+			nil,
+			pipeline.ErrScriptCostExceeded,
+			"limit 50",
+		},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			task := pipeline.ScriptTask{
+				BaseTask:   pipeline.NewBaseTask(0, "script", nil, nil, 0),
+				Expr:       test.expr,
+				ResultType: test.resultType,
+				MaxCost:    test.maxCost,
+			}
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), test.vars, test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+
+			if test.wantErrorCause != nil || test.wantErrorContains != "" {
+				require.Error(t, result.Error)
+				if test.wantErrorCause != nil {
+					require.Equal(t, test.wantErrorCause, errors.Cause(result.Error))
+				}
+				require.Contains(t, result.Error.Error(), test.wantErrorContains)
+				return
+			}
+			require.NoError(t, result.Error)
+			if want, ok := test.want.(decimal.Decimal); ok {
+				require.True(t, want.Equal(result.Value.(decimal.Decimal)), "want %s, got %s", want, result.Value)
+				return
+			}
+			require.Equal(t, test.want, result.Value)
+		})
+	}
+}
+
+func TestScriptTask_Timeout(t *testing.T) {
+	t.Parallel()
+
+	xs := make([]interface{}, 10_000)
+	for i := range xs {
+		xs[i] = i
+	}
+	task := pipeline.ScriptTask{
+		BaseTask: pipeline.NewBaseTask(0, "script", nil, nil, 0),
+		Expr:     "vars.xs.map(x, vars.xs.map(y, x * y)).size()",
+		MaxCost:  "18446744073709551615",
+	}
+	ctx, cancel := context.WithTimeout(testutils.Context(t), 50*time.Millisecond)
+	defer cancel()
+
+	result, _ := task.Run(ctx, logger.TestLogger(t), pipeline.NewVarsFrom(map[string]interface{}{"xs": xs}), nil)
+	require.ErrorIs(t, result.Error, context.DeadlineExceeded)
+}
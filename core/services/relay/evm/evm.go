@@ -40,6 +40,7 @@ import (
 
 	txmgrcommon "github.com/smartcontractkit/chainlink-framework/chains/txmgr"
 	txm "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/userops"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
 	"github.com/smartcontractkit/chainlink/v2/core/config"
 	coreconfig "github.com/smartcontractkit/chainlink/v2/core/config"
@@ -1020,11 +1021,28 @@ func generateTransmitterFrom(ctx context.Context, rargs commontypes.RelayArgs, e
 		subject = *opts.subjectID
 	}
 	strategy := txmgrcommon.NewQueueingTxStrategy(subject, relayConfig.DefaultTransactionQueueDepth)
+	if relayConfig.UserOperations {
+		// The transmissions are sent from the smart account owned by the sending key, as the effective transmitter
+		if !configWatcher.chain.Config().EVM().Transactions().AccountAbstraction().Enabled() {
+			return nil, pkgerrors.New("userOperations requires EVM.Transactions.AccountAbstraction to be enabled")
+		}
+		if sendingKeysLength > 1 {
+			return nil, pkgerrors.New("userOperations requires a single sending key, owning the smart account")
+		}
+		if relayConfig.DualTransmissionConfig != nil {
+			return nil, pkgerrors.New("userOperations is not supported with dual transmission")
+		}
+		strategy = userops.NewStrategy()
+	}
 
 	var checker txm.TransmitCheckerSpec
 	if relayConfig.SimulateTransactions {
 		checker.CheckerType = txm.TransmitCheckerTypeSimulate
 	}
+	if relayConfig.SimulateBeforeSend {
+		// the transmission contract is an OCR2 aggregator, whose custom errors the checker decodes
+		checker.CheckerType = txm.TransmitCheckerTypeSimulateBeforeSendOCR2Aggregator
+	}
 
 	gasLimit := configWatcher.chain.Config().EVM().GasEstimator().LimitDefault()
 	ocr2Limit := configWatcher.chain.Config().EVM().GasEstimator().LimitJobType().OCR2()
@@ -1040,6 +1058,20 @@ func generateTransmitterFrom(ctx context.Context, rargs commontypes.RelayArgs, e
 
 	switch commontypes.OCR2PluginType(rargs.ProviderType) {
 	case commontypes.Median:
+		if relayConfig.UserOperations {
+			// forwarders don't apply to the smart account, so the transmissions are sent as is
+			transmitter, err = ocrcommon.NewTransmitter(
+				configWatcher.chain.TxManager(),
+				fromAddresses,
+				gasLimit,
+				effectiveTransmitterAddress,
+				strategy,
+				checker,
+				configWatcher.chain.ID(),
+				ethKeystore,
+			)
+			break
+		}
 		transmitter, err = ocrcommon.NewOCR2FeedsTransmitter(
 			ctx,
 			configWatcher.chain.TxManager(),
@@ -174,6 +174,11 @@ func newFunctionsContractTransmitter(ctx context.Context, contractVersion uint32
 	if relayConfig.SimulateTransactions {
 		checker.CheckerType = txm.TransmitCheckerTypeSimulate
 	}
+	if relayConfig.SimulateBeforeSend {
+		// the coordinator is resolved through the router for each transmission, so only the standard
+		// Error(string) and Panic(uint256) reverts are decoded
+		checker.CheckerType = txm.TransmitCheckerTypeSimulateBeforeSend
+	}
 
 	gasLimit := configWatcher.chain.Config().EVM().GasEstimator().LimitDefault()
 	ocr2Limit := configWatcher.chain.Config().EVM().GasEstimator().LimitJobType().OCR2()
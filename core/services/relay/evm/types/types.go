@@ -208,6 +208,8 @@ type RelayConfig struct {
 
 	DefaultTransactionQueueDepth uint32 `json:"defaultTransactionQueueDepth"`
 	SimulateTransactions         bool   `json:"simulateTransactions"`
+	SimulateBeforeSend           bool   `json:"simulateBeforeSend"`
+	UserOperations               bool   `json:"userOperations"`
 
 	// Contract-specific
 	SendingKeys pq.StringArray `json:"sendingKeys"`
@@ -2,6 +2,7 @@ package synchronization
 
 import (
 	"context"
+	"crypto/ed25519"
 	"errors"
 	"fmt"
 	"net/url"
@@ -10,6 +11,7 @@ import (
 	"time"
 
 	"github.com/smartcontractkit/wsrpc"
+	"github.com/smartcontractkit/wsrpc/credentials"
 	"github.com/smartcontractkit/wsrpc/examples/simple/keys"
 	"google.golang.org/grpc/connectivity"
 
@@ -96,7 +98,7 @@ func NewTelemetryIngressBatchClient(url *url.URL, serverPubKeyHex string, ks key
 // server does come back up, wsrpc will establish the connection without any interaction
 // on behalf of the node operator.
 func (tc *telemetryIngressBatchClient) start(ctx context.Context) error {
-	clientPrivKey, err := tc.getCSAPrivateKey()
+	signer, err := tc.getCSASigner()
 	if err != nil {
 		return err
 	}
@@ -108,7 +110,7 @@ func (tc *telemetryIngressBatchClient) start(ctx context.Context) error {
 	if tc.telemClient == nil { // only preset for tests
 		if tc.useUniConn {
 			tc.eng.Go(func(ctx context.Context) {
-				conn, err := wsrpc.DialUniWithContext(ctx, tc.eng, tc.url.String(), clientPrivKey, serverPubKey)
+				conn, err := tc.dialUni(ctx, signer, serverPubKey)
 				if err != nil {
 					if ctx.Err() != nil {
 						tc.eng.Warnw("gave up connecting to telemetry endpoint", "err", err)
@@ -124,7 +126,7 @@ func (tc *telemetryIngressBatchClient) start(ctx context.Context) error {
 			})
 		} else {
 			// Spawns a goroutine that will eventually connect
-			conn, err := wsrpc.DialWithContext(ctx, tc.url.String(), wsrpc.WithTransportCreds(clientPrivKey, serverPubKey), wsrpc.WithLogger(tc.eng))
+			conn, err := wsrpc.DialWithContext(ctx, tc.url.String(), wsrpc.WithTransportSigner(signer, serverPubKey), wsrpc.WithLogger(tc.eng))
 			if err != nil {
 				return fmt.Errorf("could not start TelemIngressBatchClient, Dial returned error: %v", err)
 			}
@@ -173,17 +175,32 @@ func (tc *telemetryIngressBatchClient) close() error {
 	return nil
 }
 
-// getCSAPrivateKey gets the client's CSA private key
-func (tc *telemetryIngressBatchClient) getCSAPrivateKey() (privkey []byte, err error) {
+// dialUni blocks until the uni-directional connection is established or the context expires, as
+// wsrpc.DialUniWithContext does, but signs the TLS handshake with the CSA key signer
+func (tc *telemetryIngressBatchClient) dialUni(ctx context.Context, signer *keystore.CSASigner, serverPubKey ed25519.PublicKey) (*wsrpc.UniClientConn, error) {
+	pubs, err := credentials.ValidPublicKeysFromEd25519(serverPubKey)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig, err := credentials.NewClientTLSSigner(signer, pubs)
+	if err != nil {
+		return nil, err
+	}
+	conn := wsrpc.NewTLSUniClientConn(tc.eng, tc.url.String(), tlsConfig)
+	return conn, conn.Dial(ctx)
+}
+
+// getCSASigner gets the signer of the client's CSA key
+func (tc *telemetryIngressBatchClient) getCSASigner() (*keystore.CSASigner, error) {
 	keys, err := tc.ks.GetAll()
 	if err != nil {
-		return privkey, err
+		return nil, err
 	}
 	if len(keys) < 1 {
-		return privkey, errors.New("CSA key does not exist")
+		return nil, errors.New("CSA key does not exist")
 	}
 
-	return keys[0].Raw(), nil
+	return keystore.NewCSASigner(tc.ks, keys[0]), nil
 }
 
 // Send directs incoming telmetry messages to the worker responsible for pushing it to
@@ -67,20 +67,20 @@ func NewTelemetryIngressClient(url *url.URL, serverPubKeyHex string, ks keystore
 
 // Start connects the wsrpc client to the telemetry ingress server
 func (tc *telemetryIngressClient) start(context.Context) error {
-	privkey, err := tc.getCSAPrivateKey()
+	signer, err := tc.getCSASigner()
 	if err != nil {
 		return err
 	}
 
-	tc.connect(privkey)
+	tc.connect(signer)
 
 	return nil
 }
 
-func (tc *telemetryIngressClient) connect(clientPrivKey []byte) {
+func (tc *telemetryIngressClient) connect(signer *keystore.CSASigner) {
 	tc.eng.Go(func(ctx context.Context) {
 		serverPubKey := keys.FromHex(tc.serverPubKeyHex)
-		conn, err := wsrpc.DialWithContext(ctx, tc.url.String(), wsrpc.WithTransportCreds(clientPrivKey, serverPubKey), wsrpc.WithLogger(tc.eng))
+		conn, err := wsrpc.DialWithContext(ctx, tc.url.String(), wsrpc.WithTransportSigner(signer, serverPubKey), wsrpc.WithLogger(tc.eng))
 		if err != nil {
 			if ctx.Err() != nil {
 				tc.eng.Warnw("gave up connecting to telemetry endpoint", "err", err)
@@ -152,18 +152,18 @@ func (tc *telemetryIngressClient) logBufferFullWithExpBackoff(payload TelemPaylo
 	}
 }
 
-// getCSAPrivateKey gets the client's CSA private key
-func (tc *telemetryIngressClient) getCSAPrivateKey() (privkey []byte, err error) {
+// getCSASigner gets the signer of the client's CSA key
+func (tc *telemetryIngressClient) getCSASigner() (*keystore.CSASigner, error) {
 	// Fetch the client's public key
 	keys, err := tc.ks.GetAll()
 	if err != nil {
-		return privkey, err
+		return nil, err
 	}
 	if len(keys) < 1 {
-		return privkey, errors.New("CSA key does not exist")
+		return nil, errors.New("CSA key does not exist")
 	}
 
-	return keys[0].Raw(), nil
+	return keystore.NewCSASigner(tc.ks, keys[0]), nil
 }
 
 // Send sends telemetry to the ingress server using wsrpc if the client is ready.
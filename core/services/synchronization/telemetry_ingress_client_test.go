@@ -1,6 +1,8 @@
 package synchronization_test
 
 import (
+	"context"
+	"crypto/ed25519"
 	"net/url"
 	"sync/atomic"
 	"testing"
@@ -30,6 +32,9 @@ func TestTelemetryIngressClient_Send_HappyPath(t *testing.T) {
 	key := cltest.DefaultCSAKey
 	keyList := []csakey.KeyV2{key}
 	csaKeystore.On("GetAll").Return(keyList, nil)
+	csaKeystore.On("Sign", mock.Anything, key.ID(), mock.Anything).Return(func(_ context.Context, _ string, msg []byte) ([]byte, error) {
+		return ed25519.Sign(key.PrivateKey(), msg), nil
+	}).Maybe()
 
 	// Wire up the telem ingress client
 	url := &url.URL{}
@@ -24,6 +24,7 @@ type Delegate struct {
 	logger         logger.Logger
 	store          store.Store
 	ratelimiter    *ratelimiter.RateLimiter
+	replayer       *ExecutionReplayer
 }
 
 var _ job.Delegate = (*Delegate)(nil)
@@ -61,6 +62,12 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, spec job.Job) ([]job.Ser
 		return nil, err
 	}
 
+	stepPolicies, err := spec.WorkflowSpec.StepPolicies(ctx)
+	if err != nil {
+		logCustMsg(ctx, cma, fmt.Sprintf("failed to start workflow engine: failed to get workflow step policies: %v", err), d.logger)
+		return nil, err
+	}
+
 	cfg := Config{
 		Lggr:          d.logger,
 		Workflow:      sdkSpec,
@@ -74,7 +81,9 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, spec job.Job) ([]job.Ser
 		Config:         config,
 		Binary:         binary,
 		SecretsFetcher: d.secretsFetcher,
+		StepPolicies:   stepPolicies,
 		RateLimiter:    d.ratelimiter,
+		Replayer:       d.replayer,
 	}
 	engine, err := NewEngine(ctx, cfg)
 	if err != nil {
@@ -99,6 +108,7 @@ func NewDelegate(
 	registry core.CapabilitiesRegistry,
 	store store.Store,
 	ratelimiter *ratelimiter.RateLimiter,
+	replayer *ExecutionReplayer,
 ) *Delegate {
 	return &Delegate{
 		logger:         logger,
@@ -106,6 +116,7 @@ func NewDelegate(
 		secretsFetcher: newNoopSecretsFetcher(),
 		store:          store,
 		ratelimiter:    ratelimiter,
+		replayer:       replayer,
 	}
 }
 
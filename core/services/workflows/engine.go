@@ -24,6 +24,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/transmission"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/platform"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/ratelimiter"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
 )
@@ -121,17 +122,18 @@ type Engine struct {
 	maxExecutionDuration time.Duration
 	heartbeatCadence     time.Duration
 	stepTimeoutDuration  time.Duration
+	stepPolicies         map[string]job.WorkflowStepPolicy
 
-	// testing lifecycle hook to signal when an execution is finished.
+	// lifecycle hook to signal when an execution is finished.
 	onExecutionFinished func(string)
-	// testing lifecycle hook to signal initialization status
+	// lifecycle hook to signal initialization status
 	afterInit func(success bool)
 
 	// testing lifecycle hook to signal the execution was rate limited
 	onRateLimit func(string)
 
-	// Used for testing to control the number of retries
-	// we'll do when initializing the engine.
+	// Controls the number of retries we'll do when
+	// initializing the engine.
 	maxRetries int
 	// Used for testing to control the retry interval
 	// when initializing the engine.
@@ -141,6 +143,7 @@ type Engine struct {
 
 	clock       clockwork.Clock
 	ratelimiter *ratelimiter.RateLimiter
+	replayer    *ExecutionReplayer
 }
 
 func (e *Engine) Start(_ context.Context) error {
@@ -358,6 +361,10 @@ func (e *Engine) init(ctx context.Context) {
 		}
 	}
 
+	if e.replayer != nil {
+		e.replayer.add(e)
+	}
+
 	e.logger.Info("engine initialized")
 	logCustMsg(ctx, e.cma, "workflow registered", e.logger)
 	e.metrics.incrementWorkflowRegisteredCounter(ctx)
@@ -423,6 +430,80 @@ func (e *Engine) resumeInProgressExecutions(ctx context.Context) error {
 	return nil
 }
 
+// ReplayExecution re-runs a failed execution from the step with the given ref, e.g. after
+// a target failed because of an outage. The step and all the steps depending on it are
+// executed again, while the persisted outputs of the other completed steps are reused.
+// The execution timeout starts over from the replay.
+func (e *Engine) ReplayExecution(ctx context.Context, executionID string, stepRef string) error {
+	if err := e.Ready(); err != nil {
+		return fmt.Errorf("%w: %w", ErrEngineNotRunning, err)
+	}
+
+	execution, err := e.executionStates.Get(ctx, executionID)
+	if err != nil {
+		return err
+	}
+	if execution.WorkflowID != e.workflow.id {
+		return fmt.Errorf("%w: execution %s doesn't belong to workflow %s", ErrInvalidReplay, executionID, e.workflow.id)
+	}
+	if execution.Status != store.StatusErrored && execution.Status != store.StatusTimeout {
+		return fmt.Errorf("%w: execution %s has status %s, only errored and timed out executions can be replayed", ErrInvalidReplay, executionID, execution.Status)
+	}
+	if stepRef == workflows.KeywordTrigger {
+		return fmt.Errorf("%w: cannot replay the trigger of an execution", ErrInvalidReplay)
+	}
+
+	s, err := e.workflow.Vertex(stepRef)
+	if err != nil {
+		return fmt.Errorf("%w: step %s not found in workflow", ErrInvalidReplay, stepRef)
+	}
+	for _, dep := range s.Vertex.Dependencies {
+		if depState, ok := execution.Steps[dep]; !ok || depState.Status != store.StatusCompleted {
+			return fmt.Errorf("%w: dependency %s of step %s has not completed", ErrInvalidReplay, dep, stepRef)
+		}
+	}
+
+	var refs []string
+	err = e.workflow.walkDo(stepRef, func(s *step) error {
+		refs = append(refs, s.Ref)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	ch := make(chan store.WorkflowExecutionStep)
+	added := e.stepUpdatesChMap.add(executionID, stepUpdateChannel{
+		ch:          ch,
+		executionID: executionID,
+	})
+	if !added {
+		return fmt.Errorf("%w: execution %s is already running", ErrInvalidReplay, executionID)
+	}
+
+	state, err := e.executionStates.ResetExecution(ctx, executionID, refs)
+	if err != nil {
+		e.stepUpdatesChMap.remove(executionID)
+		return err
+	}
+
+	lggr := e.logger.With(platform.KeyWorkflowExecutionID, executionID, platform.KeyStepRef, stepRef)
+	lggr.Infow("replaying execution", "resetSteps", refs)
+	logCustMsg(ctx, e.cma.With(platform.KeyWorkflowExecutionID, executionID, platform.KeyStepRef, stepRef), "execution replayed", lggr)
+
+	// the step update loop outlives the request replaying the execution
+	loopCtx, cancel := e.stopCh.NewCtx()
+	replayedAt := e.clock.Now()
+	e.wg.Add(1)
+	go func() {
+		defer cancel()
+		e.stepUpdateLoop(loopCtx, executionID, ch, &replayedAt)
+	}()
+
+	e.queueIfReady(state, s)
+	return nil
+}
+
 func generateTriggerId(workflowID string, triggerIdx int) string {
 	return fmt.Sprintf("wf_%s_trigger_%d", workflowID, triggerIdx)
 }
@@ -803,7 +884,7 @@ func (e *Engine) workerForStepRequest(ctx context.Context, msg stepRequest) {
 	logCustMsg(ctx, cma, "executing step", l)
 
 	stepExecutionStartTime := time.Now()
-	inputs, outputs, err := e.executeStep(ctx, l, msg)
+	inputs, outputs, attempts, err := e.executeStep(ctx, l, msg)
 	stepExecutionDuration := time.Since(stepExecutionStartTime).Seconds()
 
 	curStepID := "UNSET"
@@ -839,6 +920,7 @@ func (e *Engine) workerForStepRequest(ctx context.Context, msg stepRequest) {
 	stepState.Outputs.Value = outputs
 	stepState.Outputs.Err = err
 	stepState.Inputs = inputs
+	stepState.Attempts = attempts
 
 	// Let's try and emit the stepUpdate.
 	// If the context is canceled, we'll just drop the update.
@@ -952,10 +1034,10 @@ func (e *Engine) configForStep(ctx context.Context, lggr logger.Logger, step *st
 }
 
 // executeStep executes the referenced capability within a step and returns the result.
-func (e *Engine) executeStep(ctx context.Context, lggr logger.Logger, msg stepRequest) (*values.Map, values.Value, error) {
+func (e *Engine) executeStep(ctx context.Context, lggr logger.Logger, msg stepRequest) (*values.Map, values.Value, []store.StepAttempt, error) {
 	curStep, err := e.workflow.Vertex(msg.stepRef)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	var inputs any
@@ -967,32 +1049,19 @@ func (e *Engine) executeStep(ctx context.Context, lggr logger.Logger, msg stepRe
 
 	i, err := exec.FindAndInterpolateAllKeys(inputs, msg.state)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	inputsMap, err := values.NewMap(i.(map[string]any))
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	config, err := e.configForStep(ctx, lggr, curStep)
 	if err != nil {
-		return nil, nil, err
-	}
-	stepTimeoutDuration := e.stepTimeoutDuration
-	if timeoutOverride, ok := config.Underlying[reservedFieldNameStepTimeout]; ok {
-		var desiredTimeout int64
-		err2 := timeoutOverride.UnwrapTo(&desiredTimeout)
-		if err2 != nil {
-			e.logger.Warnw("couldn't decode step timeout override, using default", "error", err2, "default", stepTimeoutDuration)
-		} else {
-			if desiredTimeout > maxStepTimeoutOverrideSec {
-				e.logger.Warnw("desired step timeout is too large, limiting to max value", "maxValue", maxStepTimeoutOverrideSec)
-				desiredTimeout = maxStepTimeoutOverrideSec
-			}
-			stepTimeoutDuration = time.Duration(desiredTimeout) * time.Second
-		}
+		return nil, nil, nil, err
 	}
+	policy := e.stepPolicy(curStep, config)
 
 	tr := capabilities.CapabilityRequest{
 		Inputs: inputsMap,
@@ -1009,17 +1078,105 @@ func (e *Engine) executeStep(ctx context.Context, lggr logger.Logger, msg stepRe
 		},
 	}
 
-	stepCtx, cancel := context.WithTimeout(ctx, stepTimeoutDuration)
+	var attempts []store.StepAttempt
+	for attempt := 1; ; attempt++ {
+		startedAt := e.clock.Now()
+		output, err := e.executeStepAttempt(ctx, curStep, tr, policy.timeout)
+		stepAttempt := store.StepAttempt{Attempt: attempt, StartedAt: startedAt, FinishedAt: e.clock.Now()}
+		if err != nil {
+			errMsg := err.Error()
+			stepAttempt.Error = &errMsg
+		}
+		attempts = append(attempts, stepAttempt)
+
+		// a step signaling an early exit has succeeded
+		if err == nil || errors.Is(capabilities.ErrStopExecution, err) || attempt > policy.maxRetries {
+			if len(attempts) == 1 {
+				// attempts are only recorded for retried steps
+				attempts = nil
+			}
+			if err != nil {
+				return inputsMap, nil, attempts, err
+			}
+			return inputsMap, output.Value, attempts, nil
+		}
+
+		backoff := policy.backoffFor(attempt)
+		lggr.Warnw("step failed, retrying", "attempt", attempt, "maxRetries", policy.maxRetries, "backoff", backoff, "err", err)
+		select {
+		case <-ctx.Done():
+			return inputsMap, nil, attempts, err
+		case <-e.clock.After(backoff):
+		}
+	}
+}
+
+func (e *Engine) executeStepAttempt(ctx context.Context, curStep *step, tr capabilities.CapabilityRequest, timeout time.Duration) (capabilities.CapabilityResponse, error) {
+	stepCtx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	e.metrics.with(platform.KeyCapabilityID, curStep.ID).incrementCapabilityInvocationCounter(ctx)
 	output, err := curStep.capability.Execute(stepCtx, tr)
 	if err != nil {
-		e.metrics.with(platform.KeyStepRef, msg.stepRef, platform.KeyCapabilityID, curStep.ID).incrementCapabilityFailureCounter(ctx)
-		return inputsMap, nil, err
+		e.metrics.with(platform.KeyStepRef, tr.Metadata.ReferenceID, platform.KeyCapabilityID, curStep.ID).incrementCapabilityFailureCounter(ctx)
+		return capabilities.CapabilityResponse{}, err
 	}
+	return output, nil
+}
 
-	return inputsMap, output.Value, err
+// stepPolicy controls how a step is executed.
+type stepPolicy struct {
+	timeout    time.Duration
+	maxRetries int
+	// backoff is the delay before the first retry, doubled for each retry after that.
+	backoff time.Duration
+}
+
+func (p stepPolicy) backoffFor(attempt int) time.Duration {
+	backoff := p.backoff
+	for i := 1; i < attempt && backoff < job.MaxWorkflowStepBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, job.MaxWorkflowStepBackoff)
+}
+
+// stepPolicy returns the execution policy of a step, set with the timeout,
+// maxRetries and backoff fields of its definition. The timeout can also be
+// overridden with the reserved cre_step_timeout field of the step config, in
+// seconds. Targets are never retried, as a retried write could be sent more
+// than once.
+func (e *Engine) stepPolicy(curStep *step, config *values.Map) stepPolicy {
+	policy := stepPolicy{
+		timeout: e.stepTimeoutDuration,
+		backoff: job.DefaultWorkflowStepBackoff,
+	}
+
+	if timeoutOverride, ok := config.Underlying[reservedFieldNameStepTimeout]; ok {
+		var desiredTimeout int64
+		err := timeoutOverride.UnwrapTo(&desiredTimeout)
+		if err != nil {
+			e.logger.Warnw("couldn't decode step timeout override, using default", "error", err, "default", policy.timeout)
+		} else {
+			if desiredTimeout > maxStepTimeoutOverrideSec {
+				e.logger.Warnw("desired step timeout is too large, limiting to max value", "maxValue", maxStepTimeoutOverrideSec)
+				desiredTimeout = maxStepTimeoutOverrideSec
+			}
+			policy.timeout = time.Duration(desiredTimeout) * time.Second
+		}
+	}
+
+	definition, ok := e.stepPolicies[curStep.Ref]
+	if !ok {
+		return policy
+	}
+	if definition.Timeout > 0 {
+		policy.timeout = definition.Timeout
+	}
+	if curStep.CapabilityType != capabilities.CapabilityTypeTarget {
+		policy.maxRetries = definition.MaxRetries
+		policy.backoff = definition.Backoff
+	}
+	return policy
 }
 
 func (e *Engine) deregisterTrigger(ctx context.Context, t *triggerCapability, triggerIdx int) error {
@@ -1159,6 +1316,9 @@ func (e *Engine) heartbeat(ctx context.Context) {
 func (e *Engine) Close() error {
 	return e.StopOnce("Engine", func() error {
 		e.logger.Info("shutting down engine")
+		if e.replayer != nil {
+			e.replayer.remove(e)
+		}
 		ctx := context.Background()
 		// To shut down the engine, we'll start by deregistering
 		// any triggers to ensure no new executions are triggered,
@@ -1248,14 +1408,21 @@ type Config struct {
 	HeartbeatCadence     time.Duration
 	StepTimeout          time.Duration
 	RateLimiter          *ratelimiter.RateLimiter
+	// Replayer, when set, makes failed executions of the workflow replayable.
+	Replayer *ExecutionReplayer
+	// StepPolicies are the execution policies of the steps of the workflow, indexed by step ref.
+	StepPolicies map[string]job.WorkflowStepPolicy
+	// InitMaxRetries limits the attempts to initialize the engine, 0 retries until the engine is closed.
+	InitMaxRetries int
+	// OnInitialized is called once the engine is initialized, or has given up on initializing.
+	OnInitialized func(success bool)
+	// OnExecutionFinished is called with the ID of each finished execution.
+	OnExecutionFinished func(executionID string)
 
 	// For testing purposes only
-	maxRetries          int
-	retryMs             int
-	afterInit           func(success bool)
-	onExecutionFinished func(weid string)
-	onRateLimit         func(weid string)
-	clock               clockwork.Clock
+	retryMs     int
+	onRateLimit func(weid string)
+	clock       clockwork.Clock
 }
 
 const (
@@ -1304,12 +1471,12 @@ func NewEngine(ctx context.Context, cfg Config) (engine *Engine, err error) {
 		cfg.retryMs = 5000
 	}
 
-	if cfg.afterInit == nil {
-		cfg.afterInit = func(success bool) {}
+	if cfg.OnInitialized == nil {
+		cfg.OnInitialized = func(success bool) {}
 	}
 
-	if cfg.onExecutionFinished == nil {
-		cfg.onExecutionFinished = func(weid string) {}
+	if cfg.OnExecutionFinished == nil {
+		cfg.OnExecutionFinished = func(executionID string) {}
 	}
 
 	if cfg.onRateLimit == nil {
@@ -1371,16 +1538,18 @@ func NewEngine(ctx context.Context, cfg Config) (engine *Engine, err error) {
 		stopCh:               make(chan struct{}),
 		newWorkerTimeout:     cfg.NewWorkerTimeout,
 		stepTimeoutDuration:  cfg.StepTimeout,
+		stepPolicies:         cfg.StepPolicies,
 		maxExecutionDuration: cfg.MaxExecutionDuration,
 		heartbeatCadence:     cfg.HeartbeatCadence,
-		onExecutionFinished:  cfg.onExecutionFinished,
+		onExecutionFinished:  cfg.OnExecutionFinished,
 		onRateLimit:          cfg.onRateLimit,
-		afterInit:            cfg.afterInit,
-		maxRetries:           cfg.maxRetries,
+		afterInit:            cfg.OnInitialized,
+		maxRetries:           cfg.InitMaxRetries,
 		retryMs:              cfg.retryMs,
 		maxWorkerLimit:       cfg.MaxWorkerLimit,
 		clock:                cfg.clock,
 		ratelimiter:          cfg.RateLimiter,
+		replayer:             cfg.Replayer,
 	}
 
 	return engine, nil
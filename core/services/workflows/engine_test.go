@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -148,12 +150,16 @@ func (t testConfigProvider) ConfigForCapability(ctx context.Context, capabilityI
 }
 
 func newTestEngineWithYAMLSpec(t *testing.T, reg *coreCap.Registry, spec string, opts ...func(c *Config)) (*Engine, *testHooks) {
-	sdkSpec, err := (&job.WorkflowSpec{
+	workflowSpec := &job.WorkflowSpec{
 		Workflow: spec,
 		SpecType: job.YamlSpec,
-	}).SDKSpec(testutils.Context(t))
+	}
+	sdkSpec, err := workflowSpec.SDKSpec(testutils.Context(t))
+	require.NoError(t, err)
+	stepPolicies, err := workflowSpec.StepPolicies(testutils.Context(t))
 	require.NoError(t, err)
 
+	opts = append([]func(c *Config){func(c *Config) { c.StepPolicies = stepPolicies }}, opts...)
 	return newTestEngine(t, reg, sdkSpec, opts...)
 }
 
@@ -185,19 +191,19 @@ func newTestEngine(t *testing.T, reg *coreCap.Registry, sdkSpec sdk.WorkflowSpec
 		WorkflowName: defaultName{
 			name: testWorkflowName,
 		},
-		Lggr:       logger.TestLogger(t),
-		Registry:   reg,
-		Workflow:   sdkSpec,
-		maxRetries: 1,
-		retryMs:    100,
-		afterInit: func(success bool) {
+		Lggr:           logger.TestLogger(t),
+		Registry:       reg,
+		Workflow:       sdkSpec,
+		InitMaxRetries: 1,
+		retryMs:        100,
+		OnInitialized: func(success bool) {
 			if success {
 				close(initSuccessful)
 			} else {
 				close(initFailed)
 			}
 		},
-		onExecutionFinished: func(weid string) {
+		OnExecutionFinished: func(weid string) {
 			executionFinished <- weid
 		},
 		onRateLimit: func(weid string) {
@@ -631,6 +637,168 @@ func TestEngine_ErrorsTheWorkflowIfAStepErrors(t *testing.T) {
 	assert.Equal(t, state.Steps["evm_median"].Status, store.StatusErrored)
 }
 
+func TestEngine_RetriesFailedSteps(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	reg := coreCap.NewRegistry(logger.TestLogger(t))
+
+	trigger, _ := mockTrigger(t)
+	require.NoError(t, reg.Add(ctx, trigger))
+	require.NoError(t, reg.Add(ctx, mockTarget("")))
+
+	// the consensus step fails twice before succeeding
+	var calls atomic.Int32
+	consensus := mockConsensus("")
+	transform := consensus.transform
+	consensus.transform = func(req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+		if calls.Add(1) <= 2 {
+			return capabilities.CapabilityResponse{}, errors.New("rpc outage")
+		}
+		return transform(req)
+	}
+	require.NoError(t, reg.Add(ctx, consensus))
+
+	spec := strings.Replace(simpleWorkflow, `    ref: "evm_median"`, `    ref: "evm_median"
+    maxRetries: 3
+    backoff: 0s`, 1)
+	eng, hooks := newTestEngineWithYAMLSpec(t, reg, spec)
+	servicetest.Run(t, eng)
+
+	eid := getExecutionID(t, eng, hooks)
+	state, err := eng.executionStates.Get(ctx, eid)
+	require.NoError(t, err)
+	assert.Equal(t, store.StatusCompleted, state.Status)
+
+	consensusStep := state.Steps["evm_median"]
+	assert.Equal(t, store.StatusCompleted, consensusStep.Status)
+	require.Len(t, consensusStep.Attempts, 3)
+	for i, attempt := range consensusStep.Attempts {
+		assert.Equal(t, i+1, attempt.Attempt)
+	}
+	require.NotNil(t, consensusStep.Attempts[0].Error)
+	assert.Equal(t, "rpc outage", *consensusStep.Attempts[0].Error)
+	assert.Nil(t, consensusStep.Attempts[2].Error)
+	// steps that succeed on the first attempt don't record attempts
+	assert.Nil(t, state.Steps["write_polygon-testnet-mumbai"].Attempts)
+}
+
+func TestEngine_StepPolicy(t *testing.T) {
+	t.Parallel()
+
+	eng := &Engine{
+		logger:              logger.TestLogger(t),
+		stepTimeoutDuration: defaultStepTimeout,
+		stepPolicies: map[string]job.WorkflowStepPolicy{
+			"evm_median":                   {Timeout: 30 * time.Second, MaxRetries: 3, Backoff: 5 * time.Second},
+			"write_polygon-testnet-mumbai": {Timeout: 45 * time.Second, MaxRetries: 3, Backoff: 5 * time.Second},
+		},
+	}
+	newStep := func(ref string, capabilityType capabilities.CapabilityType) *step {
+		return &step{Vertex: workflows.Vertex{StepDefinition: sdk.StepDefinition{Ref: ref, CapabilityType: capabilityType}}}
+	}
+
+	tests := []struct {
+		name   string
+		step   *step
+		config map[string]any
+		want   stepPolicy
+	}{
+		{
+			name:   "defaults",
+			step:   newStep("compute", capabilities.CapabilityTypeAction),
+			config: map[string]any{},
+			want:   stepPolicy{timeout: defaultStepTimeout, backoff: job.DefaultWorkflowStepBackoff},
+		},
+		{
+			name:   "timeout override",
+			step:   newStep("compute", capabilities.CapabilityTypeAction),
+			config: map[string]any{reservedFieldNameStepTimeout: 3600},
+			want:   stepPolicy{timeout: maxStepTimeoutOverrideSec * time.Second, backoff: job.DefaultWorkflowStepBackoff},
+		},
+		{
+			name:   "step definition",
+			step:   newStep("evm_median", capabilities.CapabilityTypeConsensus),
+			config: map[string]any{reservedFieldNameStepTimeout: 60},
+			want:   stepPolicy{timeout: 30 * time.Second, maxRetries: 3, backoff: 5 * time.Second},
+		},
+		{
+			name:   "targets are not retried",
+			step:   newStep("write_polygon-testnet-mumbai", capabilities.CapabilityTypeTarget),
+			config: map[string]any{},
+			want:   stepPolicy{timeout: 45 * time.Second, backoff: job.DefaultWorkflowStepBackoff},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			config, err := values.NewMap(tc.config)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, eng.stepPolicy(tc.step, config))
+		})
+	}
+
+	policy := stepPolicy{backoff: 10 * time.Second}
+	assert.Equal(t, 10*time.Second, policy.backoffFor(1))
+	assert.Equal(t, 20*time.Second, policy.backoffFor(2))
+	assert.Equal(t, 40*time.Second, policy.backoffFor(3))
+	assert.Equal(t, job.MaxWorkflowStepBackoff, policy.backoffFor(4))
+}
+
+func TestEngine_ReplayExecution(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	reg := coreCap.NewRegistry(logger.TestLogger(t))
+
+	trigger, _ := mockTrigger(t)
+	require.NoError(t, reg.Add(ctx, trigger))
+	require.NoError(t, reg.Add(ctx, mockConsensus("")))
+
+	// the target fails until the outage is over
+	var outage atomic.Bool
+	outage.Store(true)
+	target := mockTarget("")
+	transform := target.transform
+	target.transform = func(req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+		if outage.Load() {
+			return capabilities.CapabilityResponse{}, errors.New("rpc outage")
+		}
+		return transform(req)
+	}
+	require.NoError(t, reg.Add(ctx, target))
+
+	dbstore := newTestDBStore(t, clockwork.NewFakeClock())
+	replayer := NewExecutionReplayer(dbstore)
+	eng, hooks := newTestEngineWithYAMLSpec(t, reg, simpleWorkflow, func(c *Config) {
+		c.Store = dbstore
+		c.Replayer = replayer
+	})
+	servicetest.Run(t, eng)
+
+	eid := getExecutionID(t, eng, hooks)
+	state, err := dbstore.Get(ctx, eid)
+	require.NoError(t, err)
+	require.Equal(t, store.StatusErrored, state.Status)
+	consensusOutputs := state.Steps["evm_median"].Outputs.Value
+
+	err = replayer.Replay(ctx, eid, "unknown")
+	require.ErrorIs(t, err, ErrInvalidReplay)
+	err = replayer.Replay(ctx, eid, workflows.KeywordTrigger)
+	require.ErrorIs(t, err, ErrInvalidReplay)
+
+	outage.Store(false)
+	require.NoError(t, replayer.Replay(ctx, eid, "write_polygon-testnet-mumbai"))
+	assert.Equal(t, eid, getExecutionID(t, eng, hooks))
+
+	state, err = dbstore.Get(ctx, eid)
+	require.NoError(t, err)
+	assert.Equal(t, store.StatusCompleted, state.Status)
+	assert.Equal(t, store.StatusCompleted, state.Steps["write_polygon-testnet-mumbai"].Status)
+	// the consensus step isn't executed again
+	assert.Equal(t, consensusOutputs, state.Steps["evm_median"].Outputs.Value)
+
+	err = replayer.Replay(ctx, eid, "write_polygon-testnet-mumbai")
+	require.ErrorIs(t, err, ErrInvalidReplay)
+}
+
 func TestEngine_GracefulEarlyTermination(t *testing.T) {
 	t.Parallel()
 	ctx := testutils.Context(t)
@@ -1007,7 +1175,7 @@ func TestEngine_GetsNodeInfoDuringInitialization(t *testing.T) {
 		func(c *Config) {
 			c.Store = dbstore
 			c.clock = clock
-			c.maxRetries = 2
+			c.InitMaxRetries = 2
 			c.retryMs = 0
 		},
 	)
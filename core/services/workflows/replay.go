@@ -0,0 +1,64 @@
+package workflows
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+var (
+	// ErrInvalidReplay is returned when an execution can't be replayed from the requested step.
+	ErrInvalidReplay = errors.New("invalid replay")
+	// ErrEngineNotRunning is returned when the workflow of an execution isn't running on this node.
+	ErrEngineNotRunning = errors.New("workflow engine is not running")
+)
+
+// ExecutionReplayer replays failed workflow executions on the engine running their workflow.
+// Engines register themselves once initialized, and deregister when closed.
+type ExecutionReplayer struct {
+	store store.Store
+
+	mu      sync.RWMutex
+	engines map[string]*Engine
+}
+
+func NewExecutionReplayer(store store.Store) *ExecutionReplayer {
+	return &ExecutionReplayer{
+		store:   store,
+		engines: make(map[string]*Engine),
+	}
+}
+
+func (r *ExecutionReplayer) add(e *Engine) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.engines[e.workflow.id] = e
+}
+
+func (r *ExecutionReplayer) remove(e *Engine) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	// the workflow may have been registered again by a newer engine
+	if r.engines[e.workflow.id] == e {
+		delete(r.engines, e.workflow.id)
+	}
+}
+
+// Replay re-runs the execution from the step with the given ref, see Engine.ReplayExecution.
+func (r *ExecutionReplayer) Replay(ctx context.Context, executionID string, stepRef string) error {
+	execution, err := r.store.Get(ctx, executionID)
+	if err != nil {
+		return err
+	}
+
+	r.mu.RLock()
+	engine, ok := r.engines[execution.WorkflowID]
+	r.mu.RUnlock()
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrEngineNotRunning, execution.WorkflowID)
+	}
+	return engine.ReplayExecution(ctx, executionID, stepRef)
+}
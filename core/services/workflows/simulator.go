@@ -0,0 +1,374 @@
+package workflows
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+
+	"github.com/andybalholm/brotli"
+	"github.com/dominikbraun/graph"
+	"github.com/jonboulle/clockwork"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/custmsg"
+	commonlogger "github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+	pkgworkflows "github.com/smartcontractkit/chainlink-common/pkg/workflows"
+	"github.com/smartcontractkit/chainlink-common/pkg/workflows/sdk"
+	"github.com/smartcontractkit/chainlink-common/pkg/workflows/wasm/host"
+	wasmpb "github.com/smartcontractkit/chainlink-common/pkg/workflows/wasm/pb"
+
+	coreCap "github.com/smartcontractkit/chainlink/v2/core/capabilities"
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/compute"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/ratelimiter"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+const (
+	defaultSimulationOwner = "0000000000000000000000000000000000000000"
+	defaultSimulationName  = "simulation"
+)
+
+// wasmMagic prefixes uncompressed WASM binaries, registered binaries are brotli compressed.
+var wasmMagic = []byte("\x00asm")
+
+// SimulationConfig describes the trigger events fired at a simulated workflow, and the
+// scripted responses of the capabilities it calls.
+type SimulationConfig struct {
+	// Owner is the hex encoded address the workflow is simulated for.
+	Owner string
+	// Name is the name the workflow is simulated as.
+	Name string
+	// WorkflowConfig is passed to the workflow binary as its config.
+	WorkflowConfig string
+	// Secrets are made available to the workflow.
+	Secrets map[string]string
+	// Events are fired in order, each one waiting for the previous execution to finish.
+	Events []SimulationEvent
+	// Capabilities script the responses of the capabilities called by the workflow.
+	// Capabilities that aren't scripted echo their inputs back as outputs.
+	Capabilities []SimulationCapability
+}
+
+// SimulationEvent is a trigger event fired at the workflow.
+type SimulationEvent struct {
+	// Trigger is the ID of the trigger capability, it can be omitted when the workflow has a single trigger.
+	Trigger string
+	Outputs map[string]any
+}
+
+// SimulationCapability scripts the responses of an action, consensus or target capability.
+type SimulationCapability struct {
+	ID string
+	// Responses are returned in order, the last one being repeated once they run out.
+	Responses []SimulationResponse
+}
+
+// SimulationResponse is either the outputs of a capability, or the error it fails with.
+type SimulationResponse struct {
+	Outputs map[string]any
+	Error   string
+}
+
+// SimulationResult holds the executions of a simulated workflow.
+type SimulationResult struct {
+	WorkflowID string
+	// StepRefs lists the steps of the workflow in the order they execute in.
+	StepRefs   []string
+	Executions []store.WorkflowExecution
+}
+
+// Simulate runs a WASM workflow in-process against mocked capabilities. The binary may be
+// compressed or not. Compute steps run the binary, like they would on a DON.
+func Simulate(ctx context.Context, lggr logger.Logger, binary []byte, cfg SimulationConfig) (*SimulationResult, error) {
+	if bytes.HasPrefix(binary, wasmMagic) {
+		var err error
+		if binary, err = compressBinary(binary); err != nil {
+			return nil, fmt.Errorf("failed to compress workflow binary: %w", err)
+		}
+	}
+	config := []byte(cfg.WorkflowConfig)
+
+	spec, err := host.GetWorkflowSpec(ctx, &host.ModuleConfig{Logger: lggr}, binary, config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workflow sdk spec: %w", err)
+	}
+
+	if cfg.Owner == "" {
+		cfg.Owner = defaultSimulationOwner
+	}
+	if cfg.Name == "" {
+		cfg.Name = defaultSimulationName
+	}
+	workflowID, err := pkgworkflows.GenerateWorkflowIDFromStrings(cfg.Owner, cfg.Name, binary, config, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate workflow ID: %w", err)
+	}
+
+	registry := coreCap.NewRegistry(lggr)
+	registry.SetLocalRegistry(&coreCap.TestMetadataRegistry{})
+	triggers, closeFn, err := registerSimulationCapabilities(ctx, lggr, registry, spec, cfg)
+	if err != nil {
+		return nil, err
+	}
+	defer closeFn()
+
+	events := make([]SimulationEvent, len(cfg.Events))
+	for i, event := range cfg.Events {
+		// events can omit the trigger of single trigger workflows
+		if event.Trigger == "" && len(spec.Triggers) == 1 {
+			event.Trigger = spec.Triggers[0].ID
+		}
+		if _, ok := triggers[event.Trigger]; !ok {
+			return nil, fmt.Errorf("event %d: workflow has no trigger %q", i, event.Trigger)
+		}
+		events[i] = event
+	}
+
+	rl, err := ratelimiter.NewRateLimiter(ratelimiter.Config{
+		GlobalRPS:      1000.0,
+		GlobalBurst:    1000,
+		PerSenderRPS:   1000.0,
+		PerSenderBurst: 1000,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	initDone := make(chan bool, 1)
+	executionFinished := make(chan string, len(cfg.Events))
+	engine, err := NewEngine(ctx, Config{
+		Workflow:       *spec,
+		WorkflowID:     workflowID,
+		WorkflowOwner:  cfg.Owner,
+		WorkflowName:   defaultName{name: cfg.Name},
+		Lggr:           lggr,
+		Registry:       registry,
+		Store:          store.NewMemoryStore(clockwork.NewRealClock()),
+		Config:         config,
+		Binary:         binary,
+		SecretsFetcher: simulationSecrets(cfg.Secrets),
+		RateLimiter:    rl,
+		InitMaxRetries: 1,
+		OnInitialized: func(success bool) {
+			initDone <- success
+		},
+		OnExecutionFinished: func(weid string) {
+			executionFinished <- weid
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err = engine.Start(ctx); err != nil {
+		return nil, err
+	}
+	defer engine.Close()
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case success := <-initDone:
+		if !success {
+			return nil, errors.New("failed to initialize workflow engine")
+		}
+	}
+
+	stepRefs, err := graph.StableTopologicalSort(engine.workflow.Graph, func(a, b string) bool { return a < b })
+	if err != nil {
+		return nil, err
+	}
+	result := &SimulationResult{
+		WorkflowID: workflowID,
+		StepRefs:   stepRefs,
+	}
+	for i, event := range events {
+		outputs, err := values.NewMap(event.Outputs)
+		if err != nil {
+			return nil, fmt.Errorf("invalid outputs for event %d: %w", i, err)
+		}
+		eventID := "simulated-event-" + strconv.Itoa(i)
+		executionID, err := generateExecutionID(workflowID, eventID)
+		if err != nil {
+			return nil, err
+		}
+
+		triggers[event.Trigger].ch <- capabilities.TriggerResponse{
+			Event: capabilities.TriggerEvent{
+				TriggerType: event.Trigger,
+				ID:          eventID,
+				Outputs:     outputs,
+			},
+		}
+
+		for finished := ""; finished != executionID; {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case finished = <-executionFinished:
+			}
+		}
+		execution, err := engine.executionStates.Get(ctx, executionID)
+		if err != nil {
+			return nil, err
+		}
+		result.Executions = append(result.Executions, execution)
+	}
+	return result, nil
+}
+
+// registerSimulationCapabilities adds a mock for each capability used by the workflow to the
+// registry, and a compute capability running the workflow binary unless it is scripted.
+func registerSimulationCapabilities(ctx context.Context, lggr logger.Logger, registry *coreCap.Registry, spec *sdk.WorkflowSpec, cfg SimulationConfig) (map[string]*simulatedTrigger, func(), error) {
+	closeFn := func() {}
+
+	triggers := map[string]*simulatedTrigger{}
+	for _, t := range spec.Triggers {
+		if _, ok := triggers[t.ID]; ok {
+			continue
+		}
+		info, err := capabilities.NewCapabilityInfo(t.ID, capabilities.CapabilityTypeTrigger, "simulated trigger")
+		if err != nil {
+			return nil, closeFn, err
+		}
+		triggers[t.ID] = &simulatedTrigger{
+			CapabilityInfo: info,
+			ch:             make(chan capabilities.TriggerResponse, len(cfg.Events)),
+		}
+	}
+
+	scripted := map[string][]SimulationResponse{}
+	for _, c := range cfg.Capabilities {
+		scripted[c.ID] = c.Responses
+	}
+	mocks := map[string]capabilities.BaseCapability{}
+	for _, t := range triggers {
+		mocks[t.ID] = t
+	}
+	for _, step := range spec.Steps() {
+		if _, ok := mocks[step.ID]; ok {
+			continue
+		}
+		if _, ok := scripted[step.ID]; !ok && step.ID == compute.CapabilityIDCompute {
+			continue
+		}
+		info, err := capabilities.NewCapabilityInfo(step.ID, step.CapabilityType, "simulated capability")
+		if err != nil {
+			return nil, closeFn, err
+		}
+		mocks[step.ID] = &simulatedCapability{
+			CapabilityInfo: info,
+			responses:      scripted[step.ID],
+		}
+		delete(scripted, step.ID)
+	}
+	for id := range scripted {
+		return nil, closeFn, fmt.Errorf("workflow does not use capability %q", id)
+	}
+
+	for _, m := range mocks {
+		if err := registry.Add(ctx, m); err != nil {
+			return nil, closeFn, err
+		}
+	}
+	if _, ok := mocks[compute.CapabilityIDCompute]; !ok {
+		action, err := compute.NewAction(compute.Config{}, lggr, registry, simulationFetcherFactory{})
+		if err != nil {
+			return nil, closeFn, err
+		}
+		if err = action.Start(ctx); err != nil {
+			return nil, closeFn, err
+		}
+		closeFn = func() {
+			if err := action.Close(); err != nil {
+				lggr.Errorw("failed to close compute capability", "err", err)
+			}
+		}
+	}
+	return triggers, closeFn, nil
+}
+
+func compressBinary(binary []byte) ([]byte, error) {
+	var b bytes.Buffer
+	w := brotli.NewWriter(&b)
+	if _, err := w.Write(binary); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return io.ReadAll(&b)
+}
+
+type simulationSecrets map[string]string
+
+func (s simulationSecrets) SecretsFor(ctx context.Context, workflowOwner, hexWorkflowName, decodedWorkflowName, workflowID string) (map[string]string, error) {
+	return s, nil
+}
+
+// simulationFetcherFactory refuses the HTTP requests of compute steps, which would go through
+// the gateway on a DON.
+type simulationFetcherFactory struct{}
+
+func (simulationFetcherFactory) NewFetcher(commonlogger.Logger, custmsg.MessageEmitter) compute.FetcherFn {
+	return func(ctx context.Context, req *wasmpb.FetchRequest) (*wasmpb.FetchResponse, error) {
+		return nil, fmt.Errorf("fetching %s is not supported when simulating a workflow", req.Url)
+	}
+}
+
+type simulatedTrigger struct {
+	capabilities.CapabilityInfo
+	ch chan capabilities.TriggerResponse
+}
+
+var _ capabilities.TriggerCapability = (*simulatedTrigger)(nil)
+
+func (t *simulatedTrigger) RegisterTrigger(ctx context.Context, req capabilities.TriggerRegistrationRequest) (<-chan capabilities.TriggerResponse, error) {
+	return t.ch, nil
+}
+
+func (t *simulatedTrigger) UnregisterTrigger(ctx context.Context, req capabilities.TriggerRegistrationRequest) error {
+	return nil
+}
+
+type simulatedCapability struct {
+	capabilities.CapabilityInfo
+
+	mu        sync.Mutex
+	calls     int
+	responses []SimulationResponse
+}
+
+var _ capabilities.ExecutableCapability = (*simulatedCapability)(nil)
+
+func (c *simulatedCapability) Execute(ctx context.Context, req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.responses) == 0 {
+		return capabilities.CapabilityResponse{Value: req.Inputs}, nil
+	}
+	resp := c.responses[min(c.calls, len(c.responses)-1)]
+	c.calls++
+	if resp.Error != "" {
+		return capabilities.CapabilityResponse{}, errors.New(resp.Error)
+	}
+	outputs, err := values.NewMap(resp.Outputs)
+	if err != nil {
+		return capabilities.CapabilityResponse{}, fmt.Errorf("invalid scripted outputs of %s: %w", c.ID, err)
+	}
+	return capabilities.CapabilityResponse{Value: outputs}, nil
+}
+
+func (c *simulatedCapability) RegisterToWorkflow(ctx context.Context, req capabilities.RegisterToWorkflowRequest) error {
+	return nil
+}
+
+func (c *simulatedCapability) UnregisterFromWorkflow(ctx context.Context, req capabilities.UnregisterFromWorkflowRequest) error {
+	return nil
+}
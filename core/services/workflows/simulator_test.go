@@ -0,0 +1,107 @@
+package workflows
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/wasmtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+func TestSimulate(t *testing.T) {
+	ctx := testutils.Context(t)
+	lggr := logger.TestLogger(t)
+	binary := wasmtest.CreateTestBinary("core/services/workflows/test/wasm/cmd", filepath.Join(t.TempDir(), "testmodule.wasm"), false, t)
+
+	t.Run("runs the compute steps of the binary", func(t *testing.T) {
+		result, err := Simulate(ctx, lggr, binary, SimulationConfig{
+			Events: []SimulationEvent{
+				{Outputs: map[string]any{"cool_output": "foo"}},
+				{Trigger: "basic-test-trigger@1.0.0", Outputs: map[string]any{"cool_output": "bar"}},
+			},
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"trigger", "compute"}, result.StepRefs)
+		require.Len(t, result.Executions, 2)
+		for i, want := range []bool{true, false} {
+			execution := result.Executions[i]
+			assert.Equal(t, result.WorkflowID, execution.WorkflowID)
+			assert.Equal(t, store.StatusCompleted, execution.Status)
+			res, ok := execution.ResultForStep("compute")
+			require.True(t, ok)
+			assert.Equal(t, want, res.Outputs.(*values.Map).Underlying["Value"].(*values.Bool).Underlying)
+		}
+	})
+
+	t.Run("scripted compute", func(t *testing.T) {
+		result, err := Simulate(ctx, lggr, binary, SimulationConfig{
+			Events: []SimulationEvent{{Outputs: map[string]any{"cool_output": "foo"}}},
+			Capabilities: []SimulationCapability{
+				{ID: "custom-compute@1.0.0", Responses: []SimulationResponse{{Error: "out of fuel"}}},
+			},
+		})
+		require.NoError(t, err)
+
+		require.Len(t, result.Executions, 1)
+		assert.Equal(t, store.StatusErrored, result.Executions[0].Status)
+		res, ok := result.Executions[0].ResultForStep("compute")
+		require.True(t, ok)
+		assert.ErrorContains(t, res.Error, "out of fuel")
+	})
+
+	t.Run("unknown trigger", func(t *testing.T) {
+		_, err := Simulate(ctx, lggr, binary, SimulationConfig{
+			Events: []SimulationEvent{{Trigger: "cron-trigger@1.0.0"}},
+		})
+		require.ErrorContains(t, err, `event 0: workflow has no trigger "cron-trigger@1.0.0"`)
+	})
+
+	t.Run("unused capability", func(t *testing.T) {
+		_, err := Simulate(ctx, lggr, binary, SimulationConfig{
+			Capabilities: []SimulationCapability{{ID: "write_ethereum-testnet-sepolia@1.0.0"}},
+		})
+		require.ErrorContains(t, err, `workflow does not use capability "write_ethereum-testnet-sepolia@1.0.0"`)
+	})
+}
+
+func TestSimulatedCapability(t *testing.T) {
+	ctx := testutils.Context(t)
+	inputs, err := values.NewMap(map[string]any{"price": 100})
+	require.NoError(t, err)
+	req := capabilities.CapabilityRequest{Inputs: inputs}
+
+	t.Run("echoes its inputs", func(t *testing.T) {
+		c := &simulatedCapability{}
+		resp, err := c.Execute(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, inputs, resp.Value)
+	})
+
+	t.Run("returns its responses in order", func(t *testing.T) {
+		c := &simulatedCapability{responses: []SimulationResponse{
+			{Error: "rpc down"},
+			{Outputs: map[string]any{"txHash": "0xabc"}},
+		}}
+
+		_, err := c.Execute(ctx, req)
+		require.Equal(t, errors.New("rpc down"), err)
+
+		want, err := values.NewMap(map[string]any{"txHash": "0xabc"})
+		require.NoError(t, err)
+		for range 2 {
+			resp, err := c.Execute(ctx, req)
+			require.NoError(t, err)
+			assert.Equal(t, want, resp.Value)
+		}
+	})
+}
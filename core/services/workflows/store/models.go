@@ -37,10 +37,20 @@ type WorkflowExecutionStep struct {
 
 	Inputs  *values.Map
 	Outputs StepOutput
+	// Attempts is only recorded for steps that were retried
+	Attempts []StepAttempt
 
 	UpdatedAt *time.Time
 }
 
+// StepAttempt records a single attempt at executing a step.
+type StepAttempt struct {
+	Attempt    int       `json:"attempt"`
+	Error      *string   `json:"error,omitempty"`
+	StartedAt  time.Time `json:"startedAt"`
+	FinishedAt time.Time `json:"finishedAt"`
+}
+
 type WorkflowExecution struct {
 	Steps       map[string]*WorkflowExecutionStep
 	ExecutionID string
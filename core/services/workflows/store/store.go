@@ -10,6 +10,15 @@ type Store interface {
 	UpdateStatus(ctx context.Context, executionID string, status string) error
 	Get(ctx context.Context, executionID string) (WorkflowExecution, error)
 	GetUnfinished(ctx context.Context, workflowID string, offset, limit int) ([]WorkflowExecution, error)
+	ListExecutions(ctx context.Context, filter ExecutionsFilter, offset, limit int) ([]WorkflowExecution, int, error)
+	ResetExecution(ctx context.Context, executionID string, stepRefs []string) (WorkflowExecution, error)
+}
+
+// ExecutionsFilter narrows down the workflow executions returned by ListExecutions.
+// Zero values match every execution.
+type ExecutionsFilter struct {
+	WorkflowID string
+	Statuses   []string
 }
 
 var _ Store = (*DBStore)(nil)
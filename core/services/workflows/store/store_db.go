@@ -2,8 +2,10 @@ package store
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -11,6 +13,9 @@ import (
 
 	"github.com/jmoiron/sqlx"
 	"github.com/jonboulle/clockwork"
+	"github.com/lib/pq"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 	"github.com/smartcontractkit/chainlink-common/pkg/values"
@@ -21,6 +26,13 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services"
 )
 
+// ErrExecutionNotFound is returned when a workflow execution doesn't exist.
+var ErrExecutionNotFound = errors.New("workflow execution not found")
+
+// ErrExecutionNotReplayable is returned when resetting a workflow execution which isn't errored or timed out,
+// e.g. because it's already being replayed.
+var ErrExecutionNotReplayable = errors.New("workflow execution is not errored or timed out")
+
 const (
 	defaultPruneFrequencySec   = 20
 	defaultPruneTimeoutSec     = 60
@@ -28,6 +40,68 @@ const (
 	defaultPruneBatchSize      = 3000
 )
 
+// Reasons for which the reaper deletes workflow executions, used as the
+// label of the workflow_executions_reaped metric.
+const (
+	reapReasonAge                  = "age"
+	reapReasonFailedAge            = "failed_age"
+	reapReasonMaxExecutionsPerFlow = "max_executions_per_workflow"
+)
+
+var promWorkflowExecutionsReaped = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "workflow_executions_reaped",
+	Help: "The total number of workflow executions deleted by the reaper",
+}, []string{"reason"})
+
+var (
+	// failedStatuses are the statuses of executions kept for
+	// RetentionConfig.FailedReaperThreshold.
+	failedStatuses = []string{StatusErrored, StatusTimeout}
+	// succeededStatuses are the statuses of the other finished executions.
+	succeededStatuses = []string{StatusCompleted, StatusCompletedEarlyExit}
+	// finishedStatuses are the statuses of the executions which may be deleted
+	// by the reaper, executions in progress are never deleted.
+	finishedStatuses = append(append([]string{}, succeededStatuses...), failedStatuses...)
+)
+
+// RetentionConfig controls how long workflow executions are kept in the database.
+type RetentionConfig struct {
+	// ReaperInterval is how often the reaper runs, 0 disables it.
+	ReaperInterval time.Duration
+	// ReaperThreshold is the age after which executions are deleted, 0
+	// disables deleting executions by age.
+	ReaperThreshold time.Duration
+	// FailedReaperThreshold is the age after which errored and timed out
+	// executions are deleted, when greater than ReaperThreshold.
+	FailedReaperThreshold time.Duration
+	// MaxExecutionsPerWorkflow is the number of executions kept for each
+	// workflow, 0 disables the limit.
+	MaxExecutionsPerWorkflow uint32
+	// ReaperBatchSize is the maximum number of executions deleted by a single query.
+	ReaperBatchSize uint32
+}
+
+// DefaultRetentionConfig returns the retention used when none is configured.
+func DefaultRetentionConfig() RetentionConfig {
+	return RetentionConfig{
+		ReaperInterval:  defaultPruneFrequencySec * time.Second,
+		ReaperThreshold: defaultPruneRecordAgeHours * time.Hour,
+		ReaperBatchSize: defaultPruneBatchSize,
+	}
+}
+
+// keepFailedLonger reports whether failed executions outlive the other ones.
+func (c RetentionConfig) keepFailedLonger() bool {
+	return c.FailedReaperThreshold > c.ReaperThreshold
+}
+
+// WithRetention sets the retention of workflow executions.
+func WithRetention(cfg RetentionConfig) func(*DBStore) {
+	return func(d *DBStore) {
+		d.retention = cfg
+	}
+}
+
 // `DBStore` is a postgres-backed
 // data store that persists workflow progress.
 type DBStore struct {
@@ -37,6 +111,7 @@ type DBStore struct {
 	shutdownWaitGroup sync.WaitGroup
 	chStop            commonservices.StopChan
 	clock             clockwork.Clock
+	retention         RetentionConfig
 }
 
 var _ services.ServiceCtx = (*DBStore)(nil)
@@ -62,6 +137,7 @@ type workflowStepRow struct {
 	Inputs              []byte
 	OutputErr           *string    `db:"output_err"`
 	OutputValue         []byte     `db:"output_value"`
+	Attempts            []byte     `db:"attempts"`
 	UpdatedAt           *time.Time `db:"updated_at"`
 }
 
@@ -74,6 +150,7 @@ type workflowExecutionWithStep struct {
 	WSInputs              []byte     `db:"ws_inputs"`
 	WSOutputErr           *string    `db:"ws_output_err"`
 	WSOutputValue         []byte     `db:"ws_output_value"`
+	WSAttempts            []byte     `db:"ws_attempts"`
 	WSUpdatedAt           *time.Time `db:"ws_updated_at"`
 
 	// WorkflowExecution fields
@@ -88,7 +165,7 @@ type workflowExecutionWithStep struct {
 func (d *DBStore) Start(context.Context) error {
 	return d.StartOnce("DBStore", func() error {
 		d.shutdownWaitGroup.Add(1)
-		go d.pruneDBEntries()
+		go d.runReaperLoop()
 		return nil
 	})
 }
@@ -101,9 +178,13 @@ func (d *DBStore) Close() error {
 	})
 }
 
-func (d *DBStore) pruneDBEntries() {
+func (d *DBStore) runReaperLoop() {
 	defer d.shutdownWaitGroup.Done()
-	ticker := time.NewTicker(defaultPruneFrequencySec * time.Second)
+	if d.retention.ReaperInterval == 0 {
+		return
+	}
+
+	ticker := commonservices.NewTicker(d.retention.ReaperInterval)
 	defer ticker.Stop()
 	for {
 		select {
@@ -111,29 +192,87 @@ func (d *DBStore) pruneDBEntries() {
 			return
 		case <-ticker.C:
 			ctx, cancel := d.chStop.CtxWithTimeout(defaultPruneTimeoutSec * time.Second)
-			nPruned := int64(0)
-			err := sqlutil.TransactDataSource(ctx, d.db, nil, func(tx sqlutil.DataSource) error {
-				stmt := fmt.Sprintf("DELETE FROM workflow_executions WHERE (id) IN (SELECT id FROM workflow_executions WHERE (created_at < now() - interval '%d hours') LIMIT %d);", defaultPruneRecordAgeHours, defaultPruneBatchSize)
-				res, err := tx.ExecContext(ctx, stmt)
-				if err != nil {
-					return err
-				}
-				nPruned, err = res.RowsAffected()
-				if err != nil {
-					d.lggr.Warnw("Failed to get number of pruned workflow_executions", "err", err)
-				}
-				return nil
-			})
-			if err != nil {
+			if err := d.runReaper(ctx); err != nil {
 				d.lggr.Errorw("Failed to prune workflow_executions", "err", err)
-			} else if nPruned > 0 {
-				d.lggr.Debugw("Pruned oldest workflow_executions", "nPruned", nPruned, "batchSize", defaultPruneBatchSize, "ageLimitHours", defaultPruneRecordAgeHours)
 			}
 			cancel()
 		}
 	}
 }
 
+// runReaper deletes a batch of the finished workflow executions that are past
+// their retention, for each of the configured retention rules. Steps are
+// deleted along with their execution. Executions still in progress are never
+// deleted, nor counted towards MaxExecutionsPerWorkflow.
+func (d *DBStore) runReaper(ctx context.Context) error {
+	cfg := d.retention
+	now := d.clock.Now()
+	keepFailedLonger := cfg.keepFailedLonger()
+	// failed executions are left to their own threshold when kept for longer
+	reaped := finishedStatuses
+	if keepFailedLonger {
+		reaped = succeededStatuses
+	}
+
+	if cfg.ReaperThreshold > 0 {
+		stmt := `DELETE FROM workflow_executions WHERE id IN (
+			SELECT id FROM workflow_executions
+			WHERE created_at < $1 AND status = ANY($2::workflow_status[])
+			LIMIT $3
+		)`
+		err := d.reap(ctx, reapReasonAge, stmt, now.Add(-cfg.ReaperThreshold), pq.Array(reaped), cfg.ReaperBatchSize)
+		if err != nil {
+			return err
+		}
+	}
+
+	if keepFailedLonger {
+		stmt := `DELETE FROM workflow_executions WHERE id IN (
+			SELECT id FROM workflow_executions
+			WHERE created_at < $1 AND status = ANY($2::workflow_status[])
+			LIMIT $3
+		)`
+		err := d.reap(ctx, reapReasonFailedAge, stmt, now.Add(-cfg.FailedReaperThreshold), pq.Array(failedStatuses), cfg.ReaperBatchSize)
+		if err != nil {
+			return err
+		}
+	}
+
+	if cfg.MaxExecutionsPerWorkflow > 0 {
+		stmt := `DELETE FROM workflow_executions WHERE id IN (
+			SELECT id FROM (
+				SELECT id, row_number() OVER (PARTITION BY workflow_id ORDER BY created_at DESC, id) AS rn
+				FROM workflow_executions
+				WHERE workflow_id IS NOT NULL AND status = ANY($2::workflow_status[])
+			) ranked
+			WHERE rn > $1
+			LIMIT $3
+		)`
+		err := d.reap(ctx, reapReasonMaxExecutionsPerFlow, stmt, cfg.MaxExecutionsPerWorkflow, pq.Array(reaped), cfg.ReaperBatchSize)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *DBStore) reap(ctx context.Context, reason string, stmt string, args ...any) error {
+	res, err := d.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return fmt.Errorf("could not delete workflow executions (%s): %w", reason, err)
+	}
+	nPruned, err := res.RowsAffected()
+	if err != nil {
+		d.lggr.Warnw("Failed to get number of pruned workflow_executions", "reason", reason, "err", err)
+		return nil
+	}
+	if nPruned > 0 {
+		promWorkflowExecutionsReaped.WithLabelValues(reason).Add(float64(nPruned))
+		d.lggr.Debugw("Pruned workflow_executions", "reason", reason, "nPruned", nPruned, "batchSize", d.retention.ReaperBatchSize)
+	}
+	return nil
+}
+
 // `UpdateStatus` updates the status of the given workflow execution
 func (d *DBStore) UpdateStatus(ctx context.Context, executionID string, status string) error {
 	sql := `UPDATE workflow_executions SET status = $1, updated_at = $2 WHERE id = $3`
@@ -178,6 +317,7 @@ func (d *DBStore) Get(ctx context.Context, executionID string) (WorkflowExecutio
 			workflow_steps.inputs AS ws_inputs,
 			workflow_steps.output_err AS ws_output_err,
 			workflow_steps.output_value AS ws_output_value,
+			workflow_steps.attempts AS ws_attempts,
 			workflow_steps.updated_at AS ws_updated_at
 	FROM workflow_executions JOIN workflow_steps
 	ON workflow_executions.id = workflow_steps.workflow_execution_id
@@ -194,7 +334,7 @@ func (d *DBStore) Get(ctx context.Context, executionID string) (WorkflowExecutio
 	}
 	state, ok := idToExecutionState[executionID]
 	if !ok {
-		return WorkflowExecution{}, fmt.Errorf("could not find workflow execution with id %s", executionID)
+		return WorkflowExecution{}, fmt.Errorf("could not find workflow execution with id %s: %w", executionID, ErrExecutionNotFound)
 	}
 	return *state, nil
 }
@@ -223,6 +363,7 @@ func workflowExecutionsWithStepToWorkflowExecutions(wews []workflowExecutionWith
 			Ref:                 jr.WSRef,
 			OutputErr:           jr.WSOutputErr,
 			OutputValue:         jr.WSOutputValue,
+			Attempts:            jr.WSAttempts,
 			Inputs:              jr.WSInputs,
 			Status:              jr.WSStatus,
 			UpdatedAt:           jr.WSUpdatedAt,
@@ -275,6 +416,13 @@ func stepToState(step workflowStepRow) (*WorkflowExecutionStep, error) {
 		}
 	}
 
+	var attempts []StepAttempt
+	if len(step.Attempts) != 0 {
+		if err := json.Unmarshal(step.Attempts, &attempts); err != nil {
+			return nil, err
+		}
+	}
+
 	return &WorkflowExecutionStep{
 		ExecutionID: step.WorkflowExecutionID,
 		Ref:         step.Ref,
@@ -284,6 +432,7 @@ func stepToState(step workflowStepRow) (*WorkflowExecutionStep, error) {
 			Err:   outputErr,
 			Value: outputs,
 		},
+		Attempts: attempts,
 	}, nil
 }
 
@@ -319,6 +468,14 @@ func stateToStep(state *WorkflowExecutionStep) (workflowStepRow, error) {
 		errs := state.Outputs.Err.Error()
 		wsr.OutputErr = &errs
 	}
+
+	if len(state.Attempts) != 0 {
+		ab, err := json.Marshal(state.Attempts)
+		if err != nil {
+			return workflowStepRow{}, err
+		}
+		wsr.Attempts = ab
+	}
 	return wsr, nil
 }
 
@@ -382,8 +539,8 @@ func (d *DBStore) upsertSteps(ctx context.Context, steps []workflowStepRow) erro
 
 	sql := `
 	INSERT INTO
-	workflow_steps(workflow_execution_id, ref, status, inputs, output_err, output_value, updated_at)
-	VALUES (:workflow_execution_id, :ref, :status, :inputs, :output_err, :output_value, :updated_at)
+	workflow_steps(workflow_execution_id, ref, status, inputs, output_err, output_value, attempts, updated_at)
+	VALUES (:workflow_execution_id, :ref, :status, :inputs, :output_err, :output_value, :attempts, :updated_at)
 	ON CONFLICT ON CONSTRAINT uniq_workflow_execution_id_ref
 	DO UPDATE SET
 		workflow_execution_id = EXCLUDED.workflow_execution_id,
@@ -392,6 +549,7 @@ func (d *DBStore) upsertSteps(ctx context.Context, steps []workflowStepRow) erro
 		inputs = EXCLUDED.inputs,
 		output_err = EXCLUDED.output_err,
 		output_value = EXCLUDED.output_value,
+		attempts = EXCLUDED.attempts,
 		updated_at = EXCLUDED.updated_at;
 	`
 	stmt, args, err := sqlx.Named(sql, steps)
@@ -435,6 +593,7 @@ func (d *DBStore) GetUnfinished(ctx context.Context, workflowID string, offset,
 		workflow_steps.inputs AS ws_inputs,
 		workflow_steps.output_err AS ws_output_err,
 		workflow_steps.output_value AS ws_output_value,
+		workflow_steps.attempts AS ws_attempts,
 		workflow_steps.updated_at AS ws_updated_at,
 		workflow_executions.id AS we_id,
 		workflow_executions.workflow_id AS we_workflow_id,
@@ -470,8 +629,116 @@ func (d *DBStore) GetUnfinished(ctx context.Context, workflowID string, offset,
 	return states, nil
 }
 
-func NewDBStore(ds sqlutil.DataSource, lggr logger.Logger, clock clockwork.Clock) *DBStore {
-	return &DBStore{db: ds, lggr: lggr.Named("WorkflowDBStore"), clock: clock, chStop: make(chan struct{})}
+// ResetExecution deletes the given steps of an errored or timed out execution and marks it
+// as started again, so that the steps can be executed once more. The status is checked by
+// the update itself, so concurrent resets of the same execution can't both succeed.
+func (d *DBStore) ResetExecution(ctx context.Context, executionID string, stepRefs []string) (WorkflowExecution, error) {
+	var execution WorkflowExecution
+	err := d.transact(ctx, func(db *DBStore) error {
+		res, err := db.db.ExecContext(ctx, `UPDATE workflow_executions SET status = $1, updated_at = $2, finished_at = NULL
+			WHERE id = $3 AND status IN ($4, $5)`,
+			StatusStarted, db.clock.Now(), executionID, StatusErrored, StatusTimeout)
+		if err != nil {
+			return fmt.Errorf("could not reset workflow execution: %w", err)
+		}
+		if n, err := res.RowsAffected(); err != nil {
+			return err
+		} else if n == 0 {
+			var exists bool
+			if err = db.db.GetContext(ctx, &exists, `SELECT EXISTS (SELECT 1 FROM workflow_executions WHERE id = $1)`, executionID); err != nil {
+				return fmt.Errorf("could not find workflow execution: %w", err)
+			}
+			if !exists {
+				return fmt.Errorf("could not find workflow execution with id %s: %w", executionID, ErrExecutionNotFound)
+			}
+			return fmt.Errorf("could not reset workflow execution with id %s: %w", executionID, ErrExecutionNotReplayable)
+		}
+
+		_, err = db.db.ExecContext(ctx, `DELETE FROM workflow_steps WHERE workflow_execution_id = $1 AND ref = ANY($2)`,
+			executionID, pq.Array(stepRefs))
+		if err != nil {
+			return fmt.Errorf("could not delete workflow steps: %w", err)
+		}
+
+		execution, err = db.Get(ctx, executionID)
+		return err
+	})
+	return execution, err
+}
+
+// ListExecutions returns a page of workflow executions matching the filter, most recent first,
+// along with the total number of matching executions. The returned executions don't include
+// their steps, use Get to fetch them.
+func (d *DBStore) ListExecutions(ctx context.Context, filter ExecutionsFilter, offset, limit int) ([]WorkflowExecution, int, error) {
+	var (
+		conds []string
+		args  []any
+	)
+	if filter.WorkflowID != "" {
+		args = append(args, filter.WorkflowID)
+		conds = append(conds, fmt.Sprintf("workflow_id = $%d", len(args)))
+	}
+	if len(filter.Statuses) > 0 {
+		for _, status := range filter.Statuses {
+			if !ValidStatuses[status] {
+				return nil, 0, fmt.Errorf("invalid workflow execution status %q", status)
+			}
+		}
+		args = append(args, pq.Array(filter.Statuses))
+		conds = append(conds, fmt.Sprintf("status = ANY($%d::workflow_status[])", len(args)))
+	}
+	where := ""
+	if len(conds) > 0 {
+		where = "WHERE " + strings.Join(conds, " AND ")
+	}
+
+	var count int
+	err := d.db.GetContext(ctx, &count, `SELECT count(*) FROM workflow_executions `+where, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("could not count workflow executions: %w", err)
+	}
+
+	sql := fmt.Sprintf(`
+	SELECT * FROM workflow_executions
+	%s
+	ORDER BY created_at DESC, id
+	LIMIT $%d
+	OFFSET $%d
+	`, where, len(args)+1, len(args)+2)
+	var rows []workflowExecutionRow
+	err = d.db.SelectContext(ctx, &rows, sql, append(args, limit, offset)...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("could not list workflow executions: %w", err)
+	}
+
+	executions := make([]WorkflowExecution, len(rows))
+	for i, row := range rows {
+		executions[i] = WorkflowExecution{
+			ExecutionID: row.ID,
+			Status:      row.Status,
+			CreatedAt:   row.CreatedAt,
+			UpdatedAt:   row.UpdatedAt,
+			FinishedAt:  row.FinishedAt,
+		}
+		if row.WorkflowID != nil {
+			executions[i].WorkflowID = *row.WorkflowID
+		}
+	}
+	return executions, count, nil
+}
+
+func NewDBStore(ds sqlutil.DataSource, lggr logger.Logger, clock clockwork.Clock, opts ...func(*DBStore)) *DBStore {
+	d := &DBStore{
+		db:        ds,
+		lggr:      lggr.Named("WorkflowDBStore"),
+		clock:     clock,
+		chStop:    make(chan struct{}),
+		retention: DefaultRetentionConfig(),
+	}
+	for _, o := range opts {
+		o(d)
+	}
+	return d
 }
 
 func (d *DBStore) HealthReport() map[string]error {
@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/jonboulle/clockwork"
 	"github.com/stretchr/testify/assert"
@@ -313,3 +314,184 @@ func Test_StoreDB_GetUnfinishedSteps(t *testing.T) {
 	states[0].CreatedAt = nil
 	assert.Equal(t, es, states[0])
 }
+
+func Test_StoreDB_ListExecutions(t *testing.T) {
+	store := newTestDBStore(t)
+	ctx := tests.Context(t)
+
+	wid := randomID()
+	createWorkflow(t, store, wid)
+	otherWid := randomID()
+	createWorkflow(t, store, otherWid)
+
+	for i, status := range []string{StatusCompleted, StatusErrored, StatusStarted} {
+		id := randomID()
+		_, err := store.Add(ctx, &WorkflowExecution{
+			Steps: map[string]*WorkflowExecutionStep{
+				"step1": {ExecutionID: id, Ref: "step1", Status: status},
+			},
+			ExecutionID: id,
+			WorkflowID:  wid,
+			Status:      status,
+		})
+		require.NoError(t, err)
+		store.clock.(clockwork.FakeClock).Advance(time.Duration(i+1) * time.Minute)
+	}
+	_, err := store.Add(ctx, &WorkflowExecution{ExecutionID: randomID(), WorkflowID: otherWid, Status: StatusErrored})
+	require.NoError(t, err)
+
+	executions, count, err := store.ListExecutions(ctx, ExecutionsFilter{WorkflowID: wid}, 0, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+	require.Len(t, executions, 2)
+	assert.Equal(t, StatusStarted, executions[0].Status)
+	assert.Equal(t, StatusErrored, executions[1].Status)
+	assert.Equal(t, wid, executions[0].WorkflowID)
+	assert.Nil(t, executions[0].Steps)
+
+	executions, count, err = store.ListExecutions(ctx, ExecutionsFilter{WorkflowID: wid}, 2, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+	require.Len(t, executions, 1)
+	assert.Equal(t, StatusCompleted, executions[0].Status)
+
+	executions, count, err = store.ListExecutions(ctx, ExecutionsFilter{Statuses: []string{StatusErrored}}, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	require.Len(t, executions, 2)
+
+	_, _, err = store.ListExecutions(ctx, ExecutionsFilter{Statuses: []string{"bogus"}}, 0, 10)
+	require.ErrorContains(t, err, `invalid workflow execution status "bogus"`)
+}
+
+func Test_StoreDB_GetNotFound(t *testing.T) {
+	store := newTestDBStore(t)
+
+	_, err := store.Get(tests.Context(t), randomID())
+	require.ErrorIs(t, err, ErrExecutionNotFound)
+}
+
+func Test_StoreDB_Reaper(t *testing.T) {
+	store := newTestDBStore(t)
+	store.retention = RetentionConfig{
+		ReaperThreshold:          time.Hour,
+		FailedReaperThreshold:    24 * time.Hour,
+		MaxExecutionsPerWorkflow: 2,
+		ReaperBatchSize:          100,
+	}
+	ctx := tests.Context(t)
+	clock := store.clock.(clockwork.FakeClock)
+
+	wid := randomID()
+	createWorkflow(t, store, wid)
+
+	add := func(status string) string {
+		id := randomID()
+		_, err := store.Add(ctx, &WorkflowExecution{
+			Steps: map[string]*WorkflowExecutionStep{
+				"step1": {ExecutionID: id, Ref: "step1", Status: status},
+			},
+			ExecutionID: id,
+			WorkflowID:  wid,
+			Status:      status,
+		})
+		require.NoError(t, err)
+		clock.Advance(time.Second)
+		return id
+	}
+	ids := func() []string {
+		executions, _, err := store.ListExecutions(ctx, ExecutionsFilter{WorkflowID: wid}, 0, 10)
+		require.NoError(t, err)
+		var got []string
+		for _, e := range executions {
+			got = append(got, e.ExecutionID)
+		}
+		return got
+	}
+
+	inFlight := add(StatusStarted)
+	add(StatusCompleted)
+	failed := add(StatusErrored)
+	clock.Advance(2 * time.Hour)
+	add(StatusCompleted)
+	newer := add(StatusCompleted)
+	newest := add(StatusCompleted)
+
+	// the old completed execution is past ReaperThreshold, the failed one is
+	// kept for longer, only the 2 newest of the others are kept, and the
+	// execution in progress is never deleted
+	require.NoError(t, store.runReaper(ctx))
+	assert.Equal(t, []string{newest, newer, failed, inFlight}, ids())
+
+	_, err := store.Get(ctx, newest)
+	require.NoError(t, err)
+
+	clock.Advance(25 * time.Hour)
+	require.NoError(t, store.runReaper(ctx))
+	assert.Equal(t, []string{inFlight}, ids())
+}
+
+func Test_StoreDB_ResetExecution(t *testing.T) {
+	store := newTestDBStore(t)
+	ctx := tests.Context(t)
+
+	wid := randomID()
+	createWorkflow(t, store, wid)
+
+	id := randomID()
+	_, err := store.Add(ctx, &WorkflowExecution{
+		Steps: map[string]*WorkflowExecutionStep{
+			"trigger": {ExecutionID: id, Ref: "trigger", Status: StatusCompleted},
+			"step1":   {ExecutionID: id, Ref: "step1", Status: StatusCompleted},
+			"step2":   {ExecutionID: id, Ref: "step2", Status: StatusErrored},
+		},
+		ExecutionID: id,
+		WorkflowID:  wid,
+		Status:      StatusStarted,
+	})
+	require.NoError(t, err)
+	require.NoError(t, store.UpdateStatus(ctx, id, StatusErrored))
+
+	execution, err := store.ResetExecution(ctx, id, []string{"step2"})
+	require.NoError(t, err)
+	assert.Equal(t, StatusStarted, execution.Status)
+	assert.Nil(t, execution.FinishedAt)
+	assert.Len(t, execution.Steps, 2)
+	assert.Contains(t, execution.Steps, "step1")
+	assert.NotContains(t, execution.Steps, "step2")
+
+	// a concurrent replay already reset the execution
+	_, err = store.ResetExecution(ctx, id, []string{"step2"})
+	require.ErrorIs(t, err, ErrExecutionNotReplayable)
+
+	_, err = store.ResetExecution(ctx, randomID(), []string{"step2"})
+	require.ErrorIs(t, err, ErrExecutionNotFound)
+}
+
+func Test_StoreDB_StepAttempts(t *testing.T) {
+	store := newTestDBStore(t)
+	ctx := tests.Context(t)
+
+	id := randomID()
+	_, err := store.Add(ctx, &WorkflowExecution{
+		Steps: map[string]*WorkflowExecutionStep{
+			"step1": {ExecutionID: id, Ref: "step1", Status: StatusStarted},
+		},
+		ExecutionID: id,
+		Status:      StatusStarted,
+	})
+	require.NoError(t, err)
+
+	errMsg := "rpc outage"
+	now := store.clock.Now().UTC()
+	attempts := []StepAttempt{
+		{Attempt: 1, Error: &errMsg, StartedAt: now, FinishedAt: now.Add(time.Second)},
+		{Attempt: 2, StartedAt: now.Add(2 * time.Second), FinishedAt: now.Add(3 * time.Second)},
+	}
+	_, err = store.UpsertStep(ctx, &WorkflowExecutionStep{ExecutionID: id, Ref: "step1", Status: StatusCompleted, Attempts: attempts})
+	require.NoError(t, err)
+
+	got, err := store.Get(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, attempts, got.Steps["step1"].Attempts)
+}
@@ -0,0 +1,215 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+)
+
+// MemoryStore is an in-memory Store, used where workflow executions don't need to outlive
+// the process, e.g. when simulating a workflow.
+// MemoryStore is safe for concurrent use.
+type MemoryStore struct {
+	mu         sync.RWMutex
+	executions map[string]*WorkflowExecution
+	clock      clockwork.Clock
+}
+
+var _ Store = (*MemoryStore)(nil)
+
+func NewMemoryStore(clock clockwork.Clock) *MemoryStore {
+	return &MemoryStore{
+		executions: map[string]*WorkflowExecution{},
+		clock:      clock,
+	}
+}
+
+// Add stores the given execution along with its steps.
+func (m *MemoryStore) Add(ctx context.Context, state *WorkflowExecution) (WorkflowExecution, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.executions[state.ExecutionID]; ok {
+		return WorkflowExecution{}, fmt.Errorf("workflow execution with id %s already exists", state.ExecutionID)
+	}
+
+	now := m.clock.Now()
+	execution := &WorkflowExecution{
+		Steps:       map[string]*WorkflowExecutionStep{},
+		ExecutionID: state.ExecutionID,
+		WorkflowID:  state.WorkflowID,
+		Status:      state.Status,
+		CreatedAt:   &now,
+	}
+	for ref, step := range state.Steps {
+		execution.Steps[ref] = m.copyStep(step)
+	}
+	m.executions[state.ExecutionID] = execution
+	return copyExecution(execution), nil
+}
+
+// UpsertStep inserts or replaces the step with the same ref.
+func (m *MemoryStore) UpsertStep(ctx context.Context, step *WorkflowExecutionStep) (WorkflowExecution, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	execution, ok := m.executions[step.ExecutionID]
+	if !ok {
+		return WorkflowExecution{}, fmt.Errorf("could not find workflow execution with id %s: %w", step.ExecutionID, ErrExecutionNotFound)
+	}
+	execution.Steps[step.Ref] = m.copyStep(step)
+	return copyExecution(execution), nil
+}
+
+// UpdateStatus updates the status of the given execution, setting its finished_at
+// timestamp unless the status is started.
+func (m *MemoryStore) UpdateStatus(ctx context.Context, executionID string, status string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	execution, ok := m.executions[executionID]
+	if !ok {
+		return fmt.Errorf("could not find workflow execution with id %s: %w", executionID, ErrExecutionNotFound)
+	}
+	now := m.clock.Now()
+	execution.Status = status
+	execution.UpdatedAt = &now
+	if status != StatusStarted {
+		execution.FinishedAt = &now
+	}
+	return nil
+}
+
+func (m *MemoryStore) Get(ctx context.Context, executionID string) (WorkflowExecution, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	execution, ok := m.executions[executionID]
+	if !ok {
+		return WorkflowExecution{}, fmt.Errorf("could not find workflow execution with id %s: %w", executionID, ErrExecutionNotFound)
+	}
+	return copyExecution(execution), nil
+}
+
+func (m *MemoryStore) GetUnfinished(ctx context.Context, workflowID string, offset, limit int) ([]WorkflowExecution, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var executions []WorkflowExecution
+	for _, execution := range m.sorted() {
+		if execution.WorkflowID == workflowID && execution.Status == StatusStarted {
+			executions = append(executions, copyExecution(execution))
+		}
+	}
+	return page(executions, offset, limit), nil
+}
+
+// ListExecutions returns a page of executions matching the filter, most recent first, along
+// with the total number of matching executions. As with DBStore, steps are not included.
+func (m *MemoryStore) ListExecutions(ctx context.Context, filter ExecutionsFilter, offset, limit int) ([]WorkflowExecution, int, error) {
+	for _, status := range filter.Statuses {
+		if !ValidStatuses[status] {
+			return nil, 0, fmt.Errorf("invalid workflow execution status %q", status)
+		}
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var executions []WorkflowExecution
+	for _, execution := range m.sorted() {
+		if filter.WorkflowID != "" && execution.WorkflowID != filter.WorkflowID {
+			continue
+		}
+		if len(filter.Statuses) > 0 && !slices.Contains(filter.Statuses, execution.Status) {
+			continue
+		}
+		e := copyExecution(execution)
+		e.Steps = nil
+		executions = append(executions, e)
+	}
+	return page(executions, offset, limit), len(executions), nil
+}
+
+// ResetExecution deletes the given steps of an errored or timed out execution and marks it as started again.
+func (m *MemoryStore) ResetExecution(ctx context.Context, executionID string, stepRefs []string) (WorkflowExecution, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	execution, ok := m.executions[executionID]
+	if !ok {
+		return WorkflowExecution{}, fmt.Errorf("could not find workflow execution with id %s: %w", executionID, ErrExecutionNotFound)
+	}
+	if execution.Status != StatusErrored && execution.Status != StatusTimeout {
+		return WorkflowExecution{}, fmt.Errorf("could not reset workflow execution with id %s: %w", executionID, ErrExecutionNotReplayable)
+	}
+	now := m.clock.Now()
+	execution.Status = StatusStarted
+	execution.UpdatedAt = &now
+	execution.FinishedAt = nil
+	for _, ref := range stepRefs {
+		delete(execution.Steps, ref)
+	}
+	return copyExecution(execution), nil
+}
+
+func (m *MemoryStore) copyStep(step *WorkflowExecutionStep) *WorkflowExecutionStep {
+	now := m.clock.Now()
+	s := *step
+	s.Attempts = slices.Clone(step.Attempts)
+	s.UpdatedAt = &now
+	return &s
+}
+
+// sorted returns the executions most recent first.
+func (m *MemoryStore) sorted() []*WorkflowExecution {
+	executions := make([]*WorkflowExecution, 0, len(m.executions))
+	for _, execution := range m.executions {
+		executions = append(executions, execution)
+	}
+	sort.Slice(executions, func(i, j int) bool {
+		if !executions[i].CreatedAt.Equal(*executions[j].CreatedAt) {
+			return executions[i].CreatedAt.After(*executions[j].CreatedAt)
+		}
+		return executions[i].ExecutionID < executions[j].ExecutionID
+	})
+	return executions
+}
+
+func copyExecution(execution *WorkflowExecution) WorkflowExecution {
+	e := *execution
+	e.Steps = make(map[string]*WorkflowExecutionStep, len(execution.Steps))
+	for ref, step := range execution.Steps {
+		s := *step
+		s.Attempts = slices.Clone(step.Attempts)
+		e.Steps[ref] = &s
+	}
+	e.CreatedAt = copyTime(execution.CreatedAt)
+	e.UpdatedAt = copyTime(execution.UpdatedAt)
+	e.FinishedAt = copyTime(execution.FinishedAt)
+	return e
+}
+
+func copyTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	c := *t
+	return &c
+}
+
+func page(executions []WorkflowExecution, offset, limit int) []WorkflowExecution {
+	if offset >= len(executions) {
+		return nil
+	}
+	executions = executions[offset:]
+	if limit < len(executions) {
+		executions = executions[:limit]
+	}
+	return executions
+}
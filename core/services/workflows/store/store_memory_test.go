@@ -0,0 +1,75 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+)
+
+func Test_StoreMemory(t *testing.T) {
+	ctx := tests.Context(t)
+	clock := clockwork.NewFakeClock()
+	store := NewMemoryStore(clock)
+
+	id := randomID()
+	es := &WorkflowExecution{
+		Steps: map[string]*WorkflowExecutionStep{
+			"step1": {ExecutionID: id, Ref: "step1", Status: StatusCompleted},
+		},
+		ExecutionID: id,
+		WorkflowID:  "workflow",
+		Status:      StatusStarted,
+	}
+	_, err := store.Add(ctx, es)
+	require.NoError(t, err)
+	_, err = store.Add(ctx, es)
+	require.ErrorContains(t, err, "already exists")
+
+	// the store holds copies, not the caller's steps
+	es.Steps["step1"].Status = StatusErrored
+	got, err := store.Get(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, StatusCompleted, got.Steps["step1"].Status)
+
+	outputs, err := values.NewMap(map[string]any{"value": 1})
+	require.NoError(t, err)
+	_, err = store.UpsertStep(ctx, &WorkflowExecutionStep{ExecutionID: id, Ref: "step2", Status: StatusCompleted, Outputs: StepOutput{Value: outputs}})
+	require.NoError(t, err)
+
+	clock.Advance(time.Minute)
+	require.NoError(t, store.UpdateStatus(ctx, id, StatusCompleted))
+	got, err = store.Get(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, StatusCompleted, got.Status)
+	assert.Equal(t, clock.Now(), *got.FinishedAt)
+	assert.Equal(t, outputs, got.Steps["step2"].Outputs.Value)
+
+	executions, count, err := store.ListExecutions(ctx, ExecutionsFilter{WorkflowID: "workflow", Statuses: []string{StatusCompleted}}, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	require.Len(t, executions, 1)
+	assert.Empty(t, executions[0].Steps)
+
+	_, err = store.ResetExecution(ctx, id, []string{"step2"})
+	require.ErrorIs(t, err, ErrExecutionNotReplayable)
+
+	require.NoError(t, store.UpdateStatus(ctx, id, StatusErrored))
+	got, err = store.ResetExecution(ctx, id, []string{"step2"})
+	require.NoError(t, err)
+	assert.Equal(t, StatusStarted, got.Status)
+	assert.Nil(t, got.FinishedAt)
+	assert.Len(t, got.Steps, 1)
+
+	unfinished, err := store.GetUnfinished(ctx, "workflow", 0, 10)
+	require.NoError(t, err)
+	assert.Len(t, unfinished, 1)
+
+	_, err = store.Get(ctx, randomID())
+	require.ErrorIs(t, err, ErrExecutionNotFound)
+}
@@ -176,6 +176,7 @@ type eventHandler struct {
 	encryptionKey            workflowkey.Key
 	engineFactory            engineFactoryFn
 	ratelimiter              *ratelimiter.RateLimiter
+	replayer                 *workflows.ExecutionReplayer
 }
 
 type Event interface {
@@ -203,6 +204,12 @@ func WithMaxArtifactSize(cfg ArtifactConfig) func(*eventHandler) {
 	}
 }
 
+func WithExecutionReplayer(r *workflows.ExecutionReplayer) func(*eventHandler) {
+	return func(eh *eventHandler) {
+		eh.replayer = r
+	}
+}
+
 // NewEventHandler returns a new eventHandler instance.
 func NewEventHandler(
 	lggr logger.Logger,
@@ -610,6 +617,7 @@ func (h *eventHandler) engineFactoryFn(ctx context.Context, id string, owner str
 		Binary:         binary,
 		SecretsFetcher: h,
 		RateLimiter:    h.ratelimiter,
+		Replayer:       h.replayer,
 	}
 	return workflows.NewEngine(ctx, cfg)
 }
@@ -0,0 +1,202 @@
+package sessions
+
+import (
+	"crypto/subtle"
+	"database/sql/driver"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	pkgerrors "github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+// ErrAPITokenOutOfScope defines the error triggered when an API token is used for a resource it is not scoped to
+var ErrAPITokenOutOfScope = pkgerrors.New("API token is not scoped to this resource")
+
+// APITokenScopeAllResources is the resource of the scopes granting a role on every resource of the API
+const APITokenScopeAllResources = "*"
+
+var apiTokenScopeResourceRegexp = regexp.MustCompile(`^(\*|[a-z0-9_-]+)$`)
+
+// APIToken is a named API token of a user, restricted to a set of scopes and optionally expiring.
+// Its secret is only known to the user, the token is authenticated against the salted hash of the secret.
+type APIToken struct {
+	ID                int64
+	UserEmail         string
+	Name              string
+	TokenKey          string
+	TokenSalt         string
+	TokenHashedSecret string
+	Scopes            APITokenScopes
+	ExpiresAt         null.Time
+	LastUsedAt        null.Time
+	CreatedAt         time.Time
+}
+
+// NewAPIToken returns a named API token of the user along with its secret, which is not stored.
+func NewAPIToken(email string, name string, scopes APITokenScopes, expiresAt null.Time) (APIToken, *auth.Token, error) {
+	if strings.TrimSpace(name) == "" {
+		return APIToken{}, nil, pkgerrors.New("API token name can not be empty")
+	}
+	if len(scopes) == 0 {
+		return APIToken{}, nil, pkgerrors.New("API token requires at least one scope")
+	}
+	if expiresAt.Valid && !expiresAt.Time.After(time.Now()) {
+		return APIToken{}, nil, pkgerrors.New("API token expiry must be in the future")
+	}
+
+	token := auth.NewToken()
+	salt := utils.NewSecret(utils.DefaultSecretSize)
+	hashedSecret, err := auth.HashedSecret(token, salt)
+	if err != nil {
+		return APIToken{}, nil, pkgerrors.Wrap(err, "API token")
+	}
+	return APIToken{
+		UserEmail:         email,
+		Name:              name,
+		TokenKey:          token.AccessKey,
+		TokenSalt:         salt,
+		TokenHashedSecret: hashedSecret,
+		Scopes:            scopes,
+		ExpiresAt:         expiresAt,
+	}, token, nil
+}
+
+// Authenticate returns true if the token matches the API token and it has not expired
+func (t *APIToken) Authenticate(token *auth.Token) (bool, error) {
+	if t.Expired() {
+		return false, nil
+	}
+	hashedSecret, err := auth.HashedSecret(token, t.TokenSalt)
+	if err != nil {
+		return false, err
+	}
+	return subtle.ConstantTimeCompare([]byte(hashedSecret), []byte(t.TokenHashedSecret)) == 1, nil
+}
+
+// Expired returns true if the API token has an expiry which elapsed
+func (t *APIToken) Expired() bool {
+	return t.ExpiresAt.Valid && !t.ExpiresAt.Time.After(time.Now())
+}
+
+// Role returns the role the API token grants on the resource of the chain, which can't exceed the role of its user.
+// The chain ID is empty for the requests not targeting a chain, and only match the scopes not restricted to one.
+func (t *APIToken) Role(userRole UserRole, resource string, chainID string) (UserRole, error) {
+	var granted UserRole
+	for _, scope := range t.Scopes {
+		if scope.Matches(resource, chainID) && userRoleRanks[scope.Role] > userRoleRanks[granted] {
+			granted = scope.Role
+		}
+	}
+	if granted == "" {
+		return "", ErrAPITokenOutOfScope
+	}
+	if userRoleRanks[granted] > userRoleRanks[userRole] {
+		return userRole, nil
+	}
+	return granted, nil
+}
+
+var userRoleRanks = map[UserRole]int{
+	UserRoleView:  1,
+	UserRoleRun:   2,
+	UserRoleEdit:  3,
+	UserRoleAdmin: 4,
+}
+
+// APITokenScope grants an API token a role on a resource of the API, optionally restricted to a single chain.
+// The resource is the first segment of the API path, such as 'jobs' for '/v2/jobs/:ID', or '*' for all of them.
+type APITokenScope struct {
+	Resource string
+	Role     UserRole
+	ChainID  string
+}
+
+// ParseAPITokenScope parses a scope written as '<resource>:<role>', or '<resource>:<role>:<chain ID>' to restrict it to
+// the requests of a single chain, such as 'jobs:view', 'bridges:run' or 'keys:edit:1'.
+func ParseAPITokenScope(s string) (APITokenScope, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return APITokenScope{}, pkgerrors.Errorf("invalid API token scope %q, expected '<resource>:<role>' or '<resource>:<role>:<chain ID>'", s)
+	}
+	if !apiTokenScopeResourceRegexp.MatchString(parts[0]) {
+		return APITokenScope{}, pkgerrors.Errorf("invalid API token scope %q, resource must be '*' or an API path segment", s)
+	}
+	role, err := GetUserRole(parts[1])
+	if err != nil {
+		return APITokenScope{}, pkgerrors.Wrapf(err, "invalid API token scope %q", s)
+	}
+	scope := APITokenScope{Resource: parts[0], Role: role}
+	if len(parts) == 3 {
+		if parts[2] == "" {
+			return APITokenScope{}, pkgerrors.Errorf("invalid API token scope %q, chain ID can not be empty", s)
+		}
+		scope.ChainID = parts[2]
+	}
+	return scope, nil
+}
+
+// Matches returns true if the scope applies to the resource of the chain
+func (s APITokenScope) Matches(resource string, chainID string) bool {
+	if s.Resource != APITokenScopeAllResources && s.Resource != resource {
+		return false
+	}
+	return s.ChainID == "" || s.ChainID == chainID
+}
+
+func (s APITokenScope) String() string {
+	if s.ChainID == "" {
+		return fmt.Sprintf("%s:%s", s.Resource, s.Role)
+	}
+	return fmt.Sprintf("%s:%s:%s", s.Resource, s.Role, s.ChainID)
+}
+
+// APITokenScopes is the list of scopes of an API token, stored as an array of strings
+type APITokenScopes []APITokenScope
+
+// ParseAPITokenScopes parses each of the scopes, see ParseAPITokenScope
+func ParseAPITokenScopes(ss []string) (APITokenScopes, error) {
+	scopes := make(APITokenScopes, 0, len(ss))
+	for _, s := range ss {
+		scope, err := ParseAPITokenScope(s)
+		if err != nil {
+			return nil, err
+		}
+		scopes = append(scopes, scope)
+	}
+	return scopes, nil
+}
+
+// Strings returns the scopes written as strings
+func (s APITokenScopes) Strings() []string {
+	ss := make([]string, len(s))
+	for i, scope := range s {
+		ss[i] = scope.String()
+	}
+	return ss
+}
+
+// Value returns this instance serialized for database storage.
+func (s APITokenScopes) Value() (driver.Value, error) {
+	return pq.StringArray(s.Strings()).Value()
+}
+
+// Scan reads the database value and returns an instance.
+func (s *APITokenScopes) Scan(value interface{}) error {
+	var ss pq.StringArray
+	if err := ss.Scan(value); err != nil {
+		return err
+	}
+	scopes, err := ParseAPITokenScopes(ss)
+	if err != nil {
+		return err
+	}
+	*s = scopes
+	return nil
+}
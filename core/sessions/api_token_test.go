@@ -0,0 +1,121 @@
+package sessions_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+)
+
+func TestParseAPITokenScope(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		scope     string
+		want      sessions.APITokenScope
+		wantError bool
+	}{
+		{"jobs:view", sessions.APITokenScope{Resource: "jobs", Role: sessions.UserRoleView}, false},
+		{"bridge_types:run", sessions.APITokenScope{Resource: "bridge_types", Role: sessions.UserRoleRun}, false},
+		{"keys:edit:1", sessions.APITokenScope{Resource: "keys", Role: sessions.UserRoleEdit, ChainID: "1"}, false},
+		{"*:admin", sessions.APITokenScope{Resource: "*", Role: sessions.UserRoleAdmin}, false},
+		{"jobs", sessions.APITokenScope{}, true},
+		{"jobs:owner", sessions.APITokenScope{}, true},
+		{"Jobs:view", sessions.APITokenScope{}, true},
+		{":view", sessions.APITokenScope{}, true},
+		{"keys:edit:", sessions.APITokenScope{}, true},
+		{"keys:edit:1:2", sessions.APITokenScope{}, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.scope, func(t *testing.T) {
+			scope, err := sessions.ParseAPITokenScope(test.scope)
+			if test.wantError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.want, scope)
+			assert.Equal(t, test.scope, scope.String())
+		})
+	}
+}
+
+func TestAPIToken_Role(t *testing.T) {
+	t.Parallel()
+
+	scopes, err := sessions.ParseAPITokenScopes([]string{"jobs:view", "jobs:run", "bridge_types:admin", "keys:edit:1"})
+	require.NoError(t, err)
+	apiToken := sessions.APIToken{Scopes: scopes}
+
+	tests := []struct {
+		name     string
+		userRole sessions.UserRole
+		resource string
+		chainID  string
+		want     sessions.UserRole
+	}{
+		{"highest matching scope", sessions.UserRoleAdmin, "jobs", "", sessions.UserRoleRun},
+		{"capped by user role", sessions.UserRoleEdit, "bridge_types", "", sessions.UserRoleEdit},
+		{"scoped to chain", sessions.UserRoleAdmin, "keys", "1", sessions.UserRoleEdit},
+		{"other chain", sessions.UserRoleAdmin, "keys", "2", ""},
+		{"no chain", sessions.UserRoleAdmin, "keys", "", ""},
+		{"other resource", sessions.UserRoleAdmin, "chains", "", ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			role, err := apiToken.Role(test.userRole, test.resource, test.chainID)
+			if test.want == "" {
+				assert.ErrorIs(t, err, sessions.ErrAPITokenOutOfScope)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.want, role)
+		})
+	}
+
+	t.Run("all resources", func(t *testing.T) {
+		apiToken := sessions.APIToken{Scopes: sessions.APITokenScopes{{Resource: "*", Role: sessions.UserRoleView}}}
+		role, err := apiToken.Role(sessions.UserRoleAdmin, "chains", "")
+		require.NoError(t, err)
+		assert.Equal(t, sessions.UserRoleView, role)
+	})
+}
+
+func TestNewAPIToken(t *testing.T) {
+	t.Parallel()
+
+	scopes := sessions.APITokenScopes{{Resource: "jobs", Role: sessions.UserRoleView}}
+
+	apiToken, token, err := sessions.NewAPIToken("user@example.com", "ci", scopes, null.TimeFrom(time.Now().Add(time.Hour)))
+	require.NoError(t, err)
+	assert.Equal(t, token.AccessKey, apiToken.TokenKey)
+	assert.NotEqual(t, token.Secret, apiToken.TokenHashedSecret)
+
+	ok, err := apiToken.Authenticate(token)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = apiToken.Authenticate(&auth.Token{AccessKey: token.AccessKey, Secret: "wrong"})
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	apiToken.ExpiresAt = null.TimeFrom(time.Now().Add(-time.Second))
+	assert.True(t, apiToken.Expired())
+	ok, err = apiToken.Authenticate(token)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	_, _, err = sessions.NewAPIToken("user@example.com", " ", scopes, null.Time{})
+	assert.Error(t, err)
+	_, _, err = sessions.NewAPIToken("user@example.com", "ci", nil, null.Time{})
+	assert.Error(t, err)
+	_, _, err = sessions.NewAPIToken("user@example.com", "ci", scopes, null.TimeFrom(time.Now().Add(-time.Hour)))
+	assert.Error(t, err)
+}
@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 
+	"gopkg.in/guregu/null.v4"
+
 	"github.com/smartcontractkit/chainlink/v2/core/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 )
@@ -15,6 +17,7 @@ type AuthenticationProviderName string
 const (
 	LocalAuth AuthenticationProviderName = "local"
 	LDAPAuth  AuthenticationProviderName = "ldap"
+	OIDCAuth  AuthenticationProviderName = "oidc"
 )
 
 // ErrUserSessionExpired defines the error triggered when the user session has expired
@@ -38,7 +41,7 @@ type BasicAdminUsersORM interface {
 }
 
 // AuthenticationProvider is an interface that abstracts the required application calls to a user management backend
-// Currently localauth (users table DB) or LDAP server (readonly)
+// Currently localauth (users table DB), LDAP server (readonly) or OpenID Connect issuer (readonly)
 type AuthenticationProvider interface {
 	FindUser(ctx context.Context, email string) (User, error)
 	FindUserByAPIToken(ctx context.Context, apiToken string) (User, error)
@@ -61,3 +64,26 @@ type AuthenticationProvider interface {
 
 	FindExternalInitiator(ctx context.Context, eia *auth.Token) (initiator *bridges.ExternalInitiator, err error)
 }
+
+// RedirectAuthenticationProvider is implemented by the authentication providers delegating the login to an external
+// identity provider. Users are redirected to it, and back to the node with an authorization code to create a session.
+type RedirectAuthenticationProvider interface {
+	// AuthCodeURL returns the URL of the identity provider to redirect the user to, binding the login to the state and nonce.
+	AuthCodeURL(ctx context.Context, state, nonce string) (string, error)
+	// CreateSessionFromCode exchanges the authorization code the user was redirected back with, and creates a session.
+	CreateSessionFromCode(ctx context.Context, code, nonce string) (string, error)
+}
+
+// APITokenProvider is implemented by the authentication providers supporting multiple named API tokens per user,
+// each restricted to a set of scopes and optionally expiring, alongside the single legacy token of the user.
+type APITokenProvider interface {
+	// CreateAPIToken creates a named API token of the user, and returns its secret which can't be retrieved later.
+	CreateAPIToken(ctx context.Context, email string, name string, scopes APITokenScopes, expiresAt null.Time) (*auth.Token, APIToken, error)
+	ListAPITokens(ctx context.Context, email string) ([]APIToken, error)
+	// DeleteAPIToken deletes the API token of the user by ID and returns it, or sql.ErrNoRows if there is none.
+	DeleteAPIToken(ctx context.Context, email string, id int64) (APIToken, error)
+	// FindAPIToken returns the API token by access key, or sql.ErrNoRows if there is none.
+	FindAPIToken(ctx context.Context, accessKey string) (APIToken, error)
+	// MarkAPITokenUsed sets the last used time of the API token, once it has been authenticated.
+	MarkAPITokenUsed(ctx context.Context, id int64) error
+}
@@ -7,7 +7,9 @@ import (
 	"strings"
 	"time"
 
+	"github.com/jackc/pgconn"
 	pkgerrors "github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/mathutil"
@@ -26,8 +28,9 @@ type orm struct {
 	auditLogger     audit.AuditLogger
 }
 
-// orm implements sessions.AuthenticationProvider and sessions.BasicAdminUsersORM interfaces
+// orm implements sessions.AuthenticationProvider, sessions.APITokenProvider and sessions.BasicAdminUsersORM interfaces
 var _ sessions.AuthenticationProvider = (*orm)(nil)
+var _ sessions.APITokenProvider = (*orm)(nil)
 var _ sessions.BasicAdminUsersORM = (*orm)(nil)
 
 func NewORM(ds sqlutil.DataSource, sd time.Duration, lggr logger.Logger, auditLogger audit.AuditLogger) sessions.AuthenticationProvider {
@@ -340,6 +343,57 @@ func (o *orm) DeleteAuthToken(ctx context.Context, user *sessions.User) error {
 	return o.ds.GetContext(ctx, user, sql, user.Email)
 }
 
+// CreateAPIToken creates a named API token of the user, restricted to the scopes and expiring at the given time if any.
+func (o *orm) CreateAPIToken(ctx context.Context, email string, name string, scopes sessions.APITokenScopes, expiresAt null.Time) (*auth.Token, sessions.APIToken, error) {
+	user, err := o.findUser(ctx, email)
+	if err != nil {
+		return nil, sessions.APIToken{}, err
+	}
+	apiToken, token, err := sessions.NewAPIToken(user.Email, name, scopes, expiresAt)
+	if err != nil {
+		return nil, sessions.APIToken{}, err
+	}
+	sql := `INSERT INTO api_tokens (user_email, name, token_key, token_salt, token_hashed_secret, scopes, expires_at, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, now()) RETURNING *`
+	err = o.ds.GetContext(ctx, &apiToken, sql, apiToken.UserEmail, apiToken.Name, apiToken.TokenKey,
+		apiToken.TokenSalt, apiToken.TokenHashedSecret, apiToken.Scopes, apiToken.ExpiresAt)
+	if err != nil {
+		var pqErr *pgconn.PgError
+		if pkgerrors.As(err, &pqErr) && pqErr.ConstraintName == "api_tokens_user_email_name_key" {
+			return nil, sessions.APIToken{}, pkgerrors.Errorf("API token with name %s already exists", name)
+		}
+		return nil, sessions.APIToken{}, pkgerrors.Wrap(err, "failed to create API token")
+	}
+	return token, apiToken, nil
+}
+
+// ListAPITokens returns the named API tokens of the user, including the expired ones.
+func (o *orm) ListAPITokens(ctx context.Context, email string) (apiTokens []sessions.APIToken, err error) {
+	sql := "SELECT * FROM api_tokens WHERE lower(user_email) = lower($1) ORDER BY created_at, id"
+	err = o.ds.SelectContext(ctx, &apiTokens, sql, email)
+	return
+}
+
+// DeleteAPIToken deletes a named API token of the user by ID.
+func (o *orm) DeleteAPIToken(ctx context.Context, email string, id int64) (apiToken sessions.APIToken, err error) {
+	sql := "DELETE FROM api_tokens WHERE id = $1 AND lower(user_email) = lower($2) RETURNING *"
+	err = o.ds.GetContext(ctx, &apiToken, sql, id, email)
+	return
+}
+
+// FindAPIToken returns a named API token by access key.
+func (o *orm) FindAPIToken(ctx context.Context, accessKey string) (apiToken sessions.APIToken, err error) {
+	sql := "SELECT * FROM api_tokens WHERE token_key = $1"
+	err = o.ds.GetContext(ctx, &apiToken, sql, accessKey)
+	return
+}
+
+// MarkAPITokenUsed sets the last used time of a named API token to now.
+func (o *orm) MarkAPITokenUsed(ctx context.Context, id int64) error {
+	_, err := o.ds.ExecContext(ctx, "UPDATE api_tokens SET last_used_at = now() WHERE id = $1", id)
+	return err
+}
+
 // SaveWebAuthn saves new WebAuthn token information.
 func (o *orm) SaveWebAuthn(ctx context.Context, token *sessions.WebAuthn) error {
 	sql := "INSERT INTO web_authns (email, public_key_data) VALUES ($1, $2)"
@@ -1,6 +1,7 @@
 package localauth_test
 
 import (
+	"database/sql"
 	"encoding/json"
 	"testing"
 	"time"
@@ -12,6 +13,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/jmoiron/sqlx"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/v2/core/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
@@ -309,3 +311,44 @@ func TestOrm_GenerateAuthToken(t *testing.T) {
 	assert.Empty(t, dbUser.TokenSalt.ValueOrZero())
 	assert.Empty(t, dbUser.TokenHashedSecret.ValueOrZero())
 }
+
+func TestORM_APITokens(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	_, authProvider := setupORM(t)
+	orm := authProvider.(sessions.APITokenProvider)
+	user := cltest.MustRandomUser(t)
+	require.NoError(t, authProvider.CreateUser(ctx, &user))
+
+	scopes, err := sessions.ParseAPITokenScopes([]string{"jobs:view", "keys:edit:1"})
+	require.NoError(t, err)
+	token, created, err := orm.CreateAPIToken(ctx, user.Email, "ci", scopes, null.Time{})
+	require.NoError(t, err)
+	assert.Equal(t, token.AccessKey, created.TokenKey)
+
+	_, _, err = orm.CreateAPIToken(ctx, user.Email, "ci", scopes, null.Time{})
+	require.ErrorContains(t, err, "already exists")
+
+	found, err := orm.FindAPIToken(ctx, token.AccessKey)
+	require.NoError(t, err)
+	assert.Equal(t, scopes, found.Scopes)
+	assert.False(t, found.LastUsedAt.Valid)
+	ok, err := found.Authenticate(token)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	require.NoError(t, orm.MarkAPITokenUsed(ctx, found.ID))
+	tokens, err := orm.ListAPITokens(ctx, user.Email)
+	require.NoError(t, err)
+	require.Len(t, tokens, 1)
+	assert.True(t, tokens[0].LastUsedAt.Valid)
+
+	_, err = orm.DeleteAPIToken(ctx, "other@example.com", found.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+	deleted, err := orm.DeleteAPIToken(ctx, user.Email, found.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "ci", deleted.Name)
+	_, err = orm.FindAPIToken(ctx, token.AccessKey)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
@@ -0,0 +1,65 @@
+package oidcauth
+
+import (
+	"time"
+
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/oidcauth/oidcauthtest"
+)
+
+// Default issuer group name mappings for test config and mock issuer users
+const (
+	NodeAdminsGroup   = "NodeAdmins"
+	NodeEditorsGroup  = "NodeEditors"
+	NodeRunnersGroup  = "NodeRunners"
+	NodeReadOnlyGroup = "NodeReadOnly"
+)
+
+// Implements config.OIDC
+type TestConfig struct {
+	IssuerURL string
+}
+
+func (t *TestConfig) SessionTimeout() commonconfig.Duration {
+	return *commonconfig.MustNewDuration(15 * time.Minute)
+}
+
+func (t *TestConfig) Issuer() string {
+	return t.IssuerURL
+}
+
+func (t *TestConfig) ClientID() string {
+	return oidcauthtest.ClientID
+}
+
+func (t *TestConfig) ClientSecret() string {
+	return oidcauthtest.ClientSecret
+}
+
+func (t *TestConfig) RedirectURL() string {
+	return "http://localhost:6688/oidc/callback"
+}
+
+func (t *TestConfig) EmailClaim() string {
+	return "email"
+}
+
+func (t *TestConfig) GroupsClaim() string {
+	return "groups"
+}
+
+func (t *TestConfig) AdminUserGroup() string {
+	return NodeAdminsGroup
+}
+
+func (t *TestConfig) EditUserGroup() string {
+	return NodeEditorsGroup
+}
+
+func (t *TestConfig) RunUserGroup() string {
+	return NodeRunnersGroup
+}
+
+func (t *TestConfig) ReadUserGroup() string {
+	return NodeReadOnlyGroup
+}
@@ -0,0 +1,225 @@
+package oidcauth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/oauth2"
+)
+
+const discoveryPath = "/.well-known/openid-configuration"
+
+// providerMetadata is the subset of the OpenID Connect discovery document used by the node.
+type providerMetadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// jsonWebKey is a public key of the JSON Web Key Set of the issuer, signing the ID tokens.
+type jsonWebKey struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// issuer discovers the endpoints of the OpenID Connect issuer, and verifies the ID tokens it signed.
+type issuer struct {
+	url    string
+	client *http.Client
+
+	mu       sync.RWMutex
+	metadata *providerMetadata
+	keys     map[string]crypto.PublicKey
+}
+
+func newIssuer(url string, client *http.Client) *issuer {
+	return &issuer{url: strings.TrimSuffix(url, "/"), client: client}
+}
+
+// discover returns the metadata of the issuer, fetched on first use so that the node starts with the issuer unreachable.
+func (i *issuer) discover(ctx context.Context) (*providerMetadata, error) {
+	i.mu.RLock()
+	metadata := i.metadata
+	i.mu.RUnlock()
+	if metadata != nil {
+		return metadata, nil
+	}
+
+	metadata = new(providerMetadata)
+	if err := i.getJSON(ctx, i.url+discoveryPath, metadata); err != nil {
+		return nil, fmt.Errorf("failed to discover OIDC issuer configuration: %w", err)
+	}
+	if metadata.Issuer != i.url {
+		return nil, fmt.Errorf("OIDC issuer %q does not match the configured issuer %q", metadata.Issuer, i.url)
+	}
+	if metadata.AuthorizationEndpoint == "" || metadata.TokenEndpoint == "" || metadata.JWKSURI == "" {
+		return nil, errors.New("OIDC issuer configuration is missing the authorization, token or JWKS endpoint")
+	}
+
+	i.mu.Lock()
+	i.metadata = metadata
+	i.mu.Unlock()
+	return metadata, nil
+}
+
+// endpoint returns the OAuth2 endpoints of the issuer.
+func (i *issuer) endpoint(ctx context.Context) (oauth2.Endpoint, error) {
+	metadata, err := i.discover(ctx)
+	if err != nil {
+		return oauth2.Endpoint{}, err
+	}
+	return oauth2.Endpoint{
+		AuthURL:   metadata.AuthorizationEndpoint,
+		TokenURL:  metadata.TokenEndpoint,
+		AuthStyle: oauth2.AuthStyleInHeader,
+	}, nil
+}
+
+// verify checks the signature, issuer, audience, expiry and nonce of the raw ID token, and returns its claims.
+func (i *issuer) verify(ctx context.Context, rawIDToken, clientID, nonce string) (jwt.MapClaims, error) {
+	metadata, err := i.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := jwt.MapClaims{}
+	_, err = jwt.ParseWithClaims(rawIDToken, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		return i.key(ctx, metadata.JWKSURI, kid)
+	},
+		jwt.WithIssuer(metadata.Issuer),
+		jwt.WithAudience(clientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ID token: %w", err)
+	}
+	if claimed, _ := claims["nonce"].(string); claimed != nonce {
+		return nil, errors.New("invalid ID token: nonce does not match the login request")
+	}
+	return claims, nil
+}
+
+// key returns the signing key with the ID, refreshing the key set once if the key isn't known, as issuers rotate them.
+func (i *issuer) key(ctx context.Context, jwksURI, kid string) (crypto.PublicKey, error) {
+	i.mu.RLock()
+	key, ok := i.keys[kid]
+	i.mu.RUnlock()
+	if ok {
+		return key, nil
+	}
+
+	var jwks struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := i.getJSON(ctx, jwksURI, &jwks); err != nil {
+		return nil, fmt.Errorf("failed to fetch OIDC issuer keys: %w", err)
+	}
+	keys := make(map[string]crypto.PublicKey, len(jwks.Keys))
+	for _, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		parsed, err := jwk.publicKey()
+		if err != nil {
+			return nil, fmt.Errorf("invalid OIDC issuer key %q: %w", jwk.Kid, err)
+		}
+		keys[jwk.Kid] = parsed
+	}
+
+	i.mu.Lock()
+	i.keys = keys
+	i.mu.Unlock()
+
+	if key, ok = keys[kid]; !ok {
+		return nil, fmt.Errorf("no OIDC issuer key found with ID %q", kid)
+	}
+	return key, nil
+}
+
+func (i *issuer) getJSON(ctx context.Context, url string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := i.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %s from %s", resp.Status, url)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+func (k jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		if !e.IsInt64() {
+			return nil, errors.New("RSA exponent out of range")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
@@ -0,0 +1,93 @@
+package oidcauth
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/oidcauth/oidcauthtest"
+)
+
+func TestIssuer_Verify(t *testing.T) {
+	t.Parallel()
+	mockIssuer := oidcauthtest.NewIssuer(t)
+	iss := newIssuer(mockIssuer.URL, http.DefaultClient)
+	ctx := testutils.Context(t)
+
+	endpoint, err := iss.endpoint(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, mockIssuer.URL+"/authorize", endpoint.AuthURL)
+	assert.Equal(t, mockIssuer.URL+"/token", endpoint.TokenURL)
+
+	claims, err := iss.verify(ctx, mockIssuer.IDToken(t, jwt.MapClaims{"nonce": "n0nce", "email": "user@example.com"}), oidcauthtest.ClientID, "n0nce")
+	require.NoError(t, err)
+	assert.Equal(t, "user@example.com", claims["email"])
+
+	for _, tt := range []struct {
+		name   string
+		claims jwt.MapClaims
+	}{
+		{"wrong nonce", jwt.MapClaims{"nonce": "other"}},
+		{"wrong audience", jwt.MapClaims{"nonce": "n0nce", "aud": "other-client"}},
+		{"wrong issuer", jwt.MapClaims{"nonce": "n0nce", "iss": "https://other.example.com"}},
+		{"expired", jwt.MapClaims{"nonce": "n0nce", "exp": time.Now().Add(-time.Minute).Unix()}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := iss.verify(ctx, mockIssuer.IDToken(t, tt.claims), oidcauthtest.ClientID, "n0nce")
+			require.Error(t, err)
+		})
+	}
+
+	t.Run("not signed by the issuer", func(t *testing.T) {
+		other := oidcauthtest.NewIssuer(t)
+		_, err := iss.verify(ctx, other.IDToken(t, jwt.MapClaims{"nonce": "n0nce", "iss": mockIssuer.URL}), oidcauthtest.ClientID, "n0nce")
+		require.ErrorIs(t, err, jwt.ErrTokenSignatureInvalid)
+	})
+
+	t.Run("issuer not found", func(t *testing.T) {
+		_, err := newIssuer(mockIssuer.URL+"/tenant", http.DefaultClient).discover(ctx)
+		require.Error(t, err)
+	})
+}
+
+func TestGroupsToUserRole(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name   string
+		groups []string
+		role   sessions.UserRole
+	}{
+		{"admin", []string{"Other", "NodeAdmins"}, sessions.UserRoleAdmin},
+		{"edit", []string{"NodeEditors"}, sessions.UserRoleEdit},
+		{"run", []string{"NodeRunners"}, sessions.UserRoleRun},
+		{"view", []string{"NodeReadOnly"}, sessions.UserRoleView},
+		{"most privileged", []string{"NodeReadOnly", "NodeEditors", "NodeRunners"}, sessions.UserRoleEdit},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			role, err := GroupsToUserRole(tt.groups, "NodeAdmins", "NodeEditors", "NodeRunners", "NodeReadOnly")
+			require.NoError(t, err)
+			assert.Equal(t, tt.role, role)
+		})
+	}
+
+	_, err := GroupsToUserRole([]string{"Other"}, "NodeAdmins", "NodeEditors", "NodeRunners", "NodeReadOnly")
+	require.ErrorIs(t, err, ErrUserNoOIDCGroups)
+	_, err = GroupsToUserRole(nil, "NodeAdmins", "NodeEditors", "NodeRunners", "NodeReadOnly")
+	require.ErrorIs(t, err, ErrUserNoOIDCGroups)
+}
+
+func TestClaimedGroups(t *testing.T) {
+	t.Parallel()
+
+	claims := jwt.MapClaims{"groups": []interface{}{"NodeAdmins", 1, "NodeEditors"}, "role": "NodeRunners"}
+	assert.Equal(t, []string{"NodeAdmins", "NodeEditors"}, claimedGroups(claims, "groups"))
+	assert.Equal(t, []string{"NodeRunners"}, claimedGroups(claims, "role"))
+	assert.Empty(t, claimedGroups(claims, "missing"))
+}
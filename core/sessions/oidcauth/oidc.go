@@ -0,0 +1,471 @@
+/*
+The OIDC authentication package delegates user login to a configured OpenID Connect issuer
+with the authorization code flow.
+
+Users are redirected to the issuer, and back to the node's callback endpoint with an authorization code.
+The code is exchanged for an ID token, whose signature, issuer, audience, expiry and nonce are verified
+against the issuer's discovered configuration and keys. The user's role is mapped from the groups listed
+in the configured ID token claim.
+
+This package relies on the following local database table:
+
+	oidc_sessions: Upon successful login, creates a keyed local copy of the user email and role
+
+This implementation is read only; user mutation actions such as Delete are not supported, and neither
+are API tokens. Local admin users of the users table can still log in with their credentials, as for
+the initial node setup and the CLI.
+*/
+package oidcauth
+
+import (
+	"context"
+	"crypto/subtle"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/oauth2"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/mathutil"
+	"github.com/smartcontractkit/chainlink/v2/core/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+const httpTimeout = 30 * time.Second
+
+var ErrUserNoOIDCGroups = errors.New("user authenticated by the issuer, but matching no role groups assigned")
+
+type oidcAuthenticator struct {
+	ds          sqlutil.DataSource
+	issuer      *issuer
+	config      config.OIDC
+	lggr        logger.Logger
+	auditLogger audit.AuditLogger
+}
+
+// oidcAuthenticator implements sessions.AuthenticationProvider interface
+var _ sessions.AuthenticationProvider = (*oidcAuthenticator)(nil)
+var _ sessions.RedirectAuthenticationProvider = (*oidcAuthenticator)(nil)
+
+func NewOIDCAuthenticator(
+	ds sqlutil.DataSource,
+	oidcCfg config.OIDC,
+	dev bool,
+	lggr logger.Logger,
+	auditLogger audit.AuditLogger,
+) (*oidcAuthenticator, error) {
+	if oidcCfg.Issuer() == "" {
+		return nil, errors.New("OIDC Issuer config required")
+	}
+	if oidcCfg.ClientID() == "" {
+		return nil, errors.New("OIDC ClientID config required")
+	}
+	if oidcCfg.RedirectURL() == "" {
+		return nil, errors.New("OIDC RedirectURL config required")
+	}
+	// If not chainlink dev and not https, error
+	if !dev {
+		for name, u := range map[string]string{"Issuer": oidcCfg.Issuer(), "RedirectURL": oidcCfg.RedirectURL()} {
+			if parsed, err := url.Parse(u); err != nil || parsed.Scheme != "https" {
+				return nil, fmt.Errorf("OIDC Authentication driver requires an https %s when running in Production mode", name)
+			}
+		}
+	}
+
+	// Ensure all RBAC role mappings to issuer groups are defined, or error on startup
+	if oidcCfg.AdminUserGroup() == "" || oidcCfg.EditUserGroup() == "" ||
+		oidcCfg.RunUserGroup() == "" || oidcCfg.ReadUserGroup() == "" {
+		return nil, errors.New("OIDC Group mapping from issuer group name for all local RBAC role required. Set group names for `_UserGroup` fields")
+	}
+
+	return &oidcAuthenticator{
+		ds:          ds,
+		issuer:      newIssuer(oidcCfg.Issuer(), &http.Client{Timeout: httpTimeout}),
+		config:      oidcCfg,
+		lggr:        lggr.Named("OIDCAuthenticationProvider"),
+		auditLogger: auditLogger,
+	}, nil
+}
+
+// AuthCodeURL returns the authorization endpoint URL of the issuer, to redirect the user to for login.
+func (o *oidcAuthenticator) AuthCodeURL(ctx context.Context, state, nonce string) (string, error) {
+	oauth2Config, err := o.oauth2Config(ctx)
+	if err != nil {
+		return "", err
+	}
+	return oauth2Config.AuthCodeURL(state, oauth2.SetAuthURLParam("nonce", nonce)), nil
+}
+
+// CreateSessionFromCode exchanges the authorization code with the issuer for an ID token, and creates a
+// session for the user with the role mapped from the groups claim of the verified token.
+func (o *oidcAuthenticator) CreateSessionFromCode(ctx context.Context, code, nonce string) (string, error) {
+	oauth2Config, err := o.oauth2Config(ctx)
+	if err != nil {
+		return "", err
+	}
+	token, err := oauth2Config.Exchange(context.WithValue(ctx, oauth2.HTTPClient, o.issuer.client), code)
+	if err != nil {
+		o.lggr.Infof("Error exchanging OIDC authorization code: %v", err)
+		return "", errors.New("unable to log in with OIDC issuer. Authorization code exchange failed")
+	}
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok {
+		return "", errors.New("unable to log in with OIDC issuer. No ID token returned")
+	}
+	claims, err := o.issuer.verify(ctx, rawIDToken, o.config.ClientID(), nonce)
+	if err != nil {
+		o.lggr.Infof("Error verifying OIDC ID token: %v", err)
+		return "", errors.New("unable to log in with OIDC issuer. Invalid ID token")
+	}
+
+	email, err := o.claimedEmail(claims)
+	if err != nil {
+		return "", err
+	}
+	role, err := GroupsToUserRole(claimedGroups(claims, o.config.GroupsClaim()),
+		o.config.AdminUserGroup(), o.config.EditUserGroup(), o.config.RunUserGroup(), o.config.ReadUserGroup())
+	if err != nil {
+		o.lggr.Infof("Successful user login, but no matching groups assigned: user: %s, error %v", email, err)
+		return "", errors.New("log in successful, but no assigned groups to assume role")
+	}
+
+	o.lggr.Infof("Successful OIDC login request for user %s - %s", email, role)
+	return o.insertSession(ctx, email, role, false)
+}
+
+// FindUser returns a local admin user, or the role of the user's most recent OIDC session, by email.
+// The issuer can't be queried for users outside of their login.
+func (o *oidcAuthenticator) FindUser(ctx context.Context, email string) (sessions.User, error) {
+	email = strings.ToLower(email)
+
+	// First check for the supported local admin users table
+	var foundLocalAdminUser sessions.User
+	checkErr := o.ds.GetContext(ctx, &foundLocalAdminUser, "SELECT * FROM users WHERE lower(email) = lower($1)", email)
+	if checkErr == nil {
+		return foundLocalAdminUser, nil
+	}
+	if !errors.Is(checkErr, sql.ErrNoRows) {
+		o.lggr.Errorf("error searching users table: %v", checkErr)
+		return sessions.User{}, errors.New("error Finding user")
+	}
+
+	var role sessions.UserRole
+	err := o.ds.GetContext(ctx, &role,
+		"SELECT user_role FROM oidc_sessions WHERE user_email = $1 ORDER BY created_at DESC LIMIT 1", email)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return sessions.User{}, errors.New("no users found with provided email")
+		}
+		return sessions.User{}, fmt.Errorf("error searching oidc_sessions table: %w", err)
+	}
+	return sessions.User{Email: email, Role: role}, nil
+}
+
+// FindUserByAPIToken is not supported, API tokens can't be issued for OIDC users
+func (o *oidcAuthenticator) FindUserByAPIToken(ctx context.Context, apiToken string) (sessions.User, error) {
+	return sessions.User{}, sessions.ErrNotSupported
+}
+
+// ListUsers returns the local admin users, and the users who logged in with the issuer along with their latest role
+func (o *oidcAuthenticator) ListUsers(ctx context.Context) ([]sessions.User, error) {
+	var users []sessions.User
+	if err := o.ds.SelectContext(ctx, &users, "SELECT * FROM users ORDER BY email ASC"); err != nil {
+		return nil, fmt.Errorf("error listing local users: %w", err)
+	}
+
+	var oidcUsers []struct {
+		UserEmail string
+		UserRole  sessions.UserRole
+		CreatedAt time.Time
+	}
+	if err := o.ds.SelectContext(ctx, &oidcUsers, `SELECT DISTINCT ON (user_email) user_email, user_role, created_at
+FROM oidc_sessions WHERE NOT localauth_user ORDER BY user_email, created_at DESC`); err != nil {
+		return nil, fmt.Errorf("error listing OIDC users: %w", err)
+	}
+	for _, u := range oidcUsers {
+		users = append(users, sessions.User{Email: u.UserEmail, Role: u.UserRole, CreatedAt: u.CreatedAt})
+	}
+	return users, nil
+}
+
+// AuthorizedUserWithSession will return the API user associated with the Session ID if it
+// exists and hasn't expired. The issuer isn't queried, the user role is cached with the session
+func (o *oidcAuthenticator) AuthorizedUserWithSession(ctx context.Context, sessionID string) (sessions.User, error) {
+	if len(sessionID) == 0 {
+		return sessions.User{}, sessions.ErrEmptySessionID
+	}
+	var foundSession struct {
+		UserEmail string
+		UserRole  sessions.UserRole
+		Valid     bool
+	}
+	if err := o.ds.GetContext(ctx, &foundSession,
+		"SELECT user_email, user_role, created_at + $2 >= now() as valid FROM oidc_sessions WHERE id = $1",
+		sessionID, o.config.SessionTimeout().Duration(),
+	); err != nil {
+		return sessions.User{}, sessions.ErrUserSessionExpired
+	}
+	if !foundSession.Valid {
+		// Sessions expired, purge
+		if _, execErr := o.ds.ExecContext(ctx, "DELETE FROM oidc_sessions WHERE id = $1", sessionID); execErr != nil {
+			o.lggr.Errorf("error purging stale oidc session: %v", execErr)
+		}
+		return sessions.User{}, sessions.ErrUserSessionExpired
+	}
+	return sessions.User{
+		Email: foundSession.UserEmail,
+		Role:  foundSession.UserRole,
+	}, nil
+}
+
+// DeleteUser is not supported for read only OIDC
+func (o *oidcAuthenticator) DeleteUser(ctx context.Context, email string) error {
+	return sessions.ErrNotSupported
+}
+
+// DeleteUserSession removes an oidc_sessions table entry by ID
+func (o *oidcAuthenticator) DeleteUserSession(ctx context.Context, sessionID string) error {
+	_, err := o.ds.ExecContext(ctx, "DELETE FROM oidc_sessions WHERE id = $1", sessionID)
+	return err
+}
+
+// GetUserWebAuthn returns an empty stub, MFA is handled by the issuer
+func (o *oidcAuthenticator) GetUserWebAuthn(ctx context.Context, email string) ([]sessions.WebAuthn, error) {
+	return []sessions.WebAuthn{}, nil
+}
+
+// CreateSession supports the credentials login of the local admin users only, such as the CLI Admin account.
+// Other users log in with the issuer, through CreateSessionFromCode
+func (o *oidcAuthenticator) CreateSession(ctx context.Context, sr sessions.SessionRequest) (string, error) {
+	user, err := o.localLogin(ctx, sr)
+	if err != nil {
+		return "", err
+	}
+	return o.insertSession(ctx, user.Email, user.Role, true)
+}
+
+// ClearNonCurrentSessions removes all oidc_sessions but the id passed in.
+func (o *oidcAuthenticator) ClearNonCurrentSessions(ctx context.Context, sessionID string) error {
+	_, err := o.ds.ExecContext(ctx, "DELETE FROM oidc_sessions where id != $1", sessionID)
+	return err
+}
+
+// CreateUser is not supported for read only OIDC
+func (o *oidcAuthenticator) CreateUser(ctx context.Context, user *sessions.User) error {
+	return sessions.ErrNotSupported
+}
+
+// UpdateRole is not supported for read only OIDC
+func (o *oidcAuthenticator) UpdateRole(ctx context.Context, email, newRole string) (sessions.User, error) {
+	return sessions.User{}, sessions.ErrNotSupported
+}
+
+// SetPassword for OIDC users is not supported, however change password in the context of updating
+// a local admin user's password is required
+func (o *oidcAuthenticator) SetPassword(ctx context.Context, user *sessions.User, newPassword string) error {
+	// Ensure specified user is part of the local admins user table
+	var localAdminUser sessions.User
+	query := "SELECT * FROM users WHERE lower(email) = lower($1)"
+	if err := o.ds.GetContext(ctx, &localAdminUser, query, user.Email); err != nil {
+		o.lggr.Infof("Can not change password, local user with email not found in users table: %s, err: %v", user.Email, err)
+		return sessions.ErrNotSupported
+	}
+
+	// User is local admin, save new password
+	hashedPassword, err := utils.HashPassword(newPassword)
+	if err != nil {
+		return err
+	}
+	query = "UPDATE users SET hashed_password = $1, updated_at = now() WHERE email = $2 RETURNING *"
+	if err := o.ds.GetContext(ctx, user, query, hashedPassword, user.Email); err != nil {
+		o.lggr.Errorf("unable to set password for user: %s, err: %v", user.Email, err)
+		return errors.New("unable to save password")
+	}
+	return nil
+}
+
+// TestPassword tests the credentials of a local admin user, OIDC users have no password known to the node
+func (o *oidcAuthenticator) TestPassword(ctx context.Context, email string, password string) error {
+	var hashedPassword string
+	if err := o.ds.GetContext(ctx, &hashedPassword, "SELECT hashed_password FROM users WHERE lower(email) = lower($1)", email); err != nil {
+		return errors.New("invalid credentials")
+	}
+	if !utils.CheckPasswordHash(password, hashedPassword) {
+		return errors.New("invalid credentials")
+	}
+	return nil
+}
+
+// CreateAndSetAuthToken is not supported for OIDC
+func (o *oidcAuthenticator) CreateAndSetAuthToken(ctx context.Context, user *sessions.User) (*auth.Token, error) {
+	return nil, sessions.ErrNotSupported
+}
+
+// SetAuthToken is not supported for OIDC
+func (o *oidcAuthenticator) SetAuthToken(ctx context.Context, user *sessions.User, token *auth.Token) error {
+	return sessions.ErrNotSupported
+}
+
+// DeleteAuthToken is not supported for OIDC
+func (o *oidcAuthenticator) DeleteAuthToken(ctx context.Context, user *sessions.User) error {
+	return sessions.ErrNotSupported
+}
+
+// SaveWebAuthn is not supported for read only OIDC
+func (o *oidcAuthenticator) SaveWebAuthn(ctx context.Context, token *sessions.WebAuthn) error {
+	return sessions.ErrNotSupported
+}
+
+// Sessions returns all sessions limited by the parameters.
+func (o *oidcAuthenticator) Sessions(ctx context.Context, offset, limit int) ([]sessions.Session, error) {
+	var sessions []sessions.Session
+	query := `SELECT id, user_email AS email, created_at AS last_used, created_at FROM oidc_sessions ORDER BY created_at, id LIMIT $1 OFFSET $2;`
+	if err := o.ds.SelectContext(ctx, &sessions, query, limit, offset); err != nil {
+		return nil, err
+	}
+	return sessions, nil
+}
+
+// FindExternalInitiator supports the 'Run' role external intiator header auth functionality
+func (o *oidcAuthenticator) FindExternalInitiator(ctx context.Context, eia *auth.Token) (*bridges.ExternalInitiator, error) {
+	exi := &bridges.ExternalInitiator{}
+	err := o.ds.GetContext(ctx, exi, `SELECT * FROM external_initiators WHERE access_key = $1`, eia.AccessKey)
+	return exi, err
+}
+
+func (o *oidcAuthenticator) oauth2Config(ctx context.Context) (*oauth2.Config, error) {
+	endpoint, err := o.issuer.endpoint(ctx)
+	if err != nil {
+		o.lggr.Errorf("Unable to reach OIDC issuer: %v", err)
+		return nil, errors.New("unable to reach OIDC issuer")
+	}
+	return &oauth2.Config{
+		ClientID:     o.config.ClientID(),
+		ClientSecret: o.config.ClientSecret(),
+		RedirectURL:  o.config.RedirectURL(),
+		Endpoint:     endpoint,
+		Scopes:       []string{"openid", "email", "profile"},
+	}, nil
+}
+
+// insertSession saves the session, user and role to the database. Given a session ID for future queries, the
+// issuer will not be queried. Sessions are set to expire after the duration + creation date elapsed
+func (o *oidcAuthenticator) insertSession(ctx context.Context, email string, role sessions.UserRole, isLocalUser bool) (string, error) {
+	session := sessions.NewSession()
+	_, err := o.ds.ExecContext(
+		ctx,
+		"INSERT INTO oidc_sessions (id, user_email, user_role, localauth_user, created_at) VALUES ($1, $2, $3, $4, now())",
+		session.ID,
+		strings.ToLower(email),
+		role,
+		isLocalUser,
+	)
+	if err != nil {
+		o.lggr.Errorf("unable to create new session in oidc_sessions table %v", err)
+		return "", fmt.Errorf("error creating local OIDC session: %w", err)
+	}
+
+	o.auditLogger.Audit(audit.AuthLoginSuccessNo2FA, map[string]interface{}{"email": email})
+	return session.ID, nil
+}
+
+// localLogin tests the credentials provided against the 'local' authentication method
+// This covers the case of local CLI API calls requiring local login separate from the issuer
+func (o *oidcAuthenticator) localLogin(ctx context.Context, sr sessions.SessionRequest) (sessions.User, error) {
+	var user sessions.User
+	err := o.ds.GetContext(ctx, &user, "SELECT * FROM users WHERE lower(email) = lower($1)", sr.Email)
+	if err != nil {
+		return user, errors.New("invalid email")
+	}
+	if !constantTimeEmailCompare(strings.ToLower(sr.Email), strings.ToLower(user.Email)) {
+		o.auditLogger.Audit(audit.AuthLoginFailedEmail, map[string]interface{}{"email": sr.Email})
+		return user, errors.New("invalid email")
+	}
+
+	if !utils.CheckPasswordHash(sr.Password, user.HashedPassword) {
+		o.auditLogger.Audit(audit.AuthLoginFailedPassword, map[string]interface{}{"email": sr.Email})
+		return user, errors.New("invalid password")
+	}
+
+	return user, nil
+}
+
+func (o *oidcAuthenticator) claimedEmail(claims jwt.MapClaims) (string, error) {
+	email, _ := claims[o.config.EmailClaim()].(string)
+	if email == "" {
+		return "", fmt.Errorf("log in successful, but ID token has no %q claim", o.config.EmailClaim())
+	}
+	if verified, ok := claims["email_verified"].(bool); ok && !verified {
+		return "", errors.New("log in successful, but email is not verified by the issuer")
+	}
+	return strings.ToLower(email), nil
+}
+
+// claimedGroups returns the groups of the claim, listed as an array or a single string.
+func claimedGroups(claims jwt.MapClaims, claim string) []string {
+	switch groups := claims[claim].(type) {
+	case string:
+		return []string{groups}
+	case []interface{}:
+		var names []string
+		for _, group := range groups {
+			if name, ok := group.(string); ok {
+				names = append(names, name)
+			}
+		}
+		return names
+	default:
+		return nil
+	}
+}
+
+// GroupsToUserRole maps the groups of the user to the role with the most privileges, following the
+// admin > edit > run > view hierarchy. An error is returned if none of the groups is mapped to a role.
+func GroupsToUserRole(groups []string, adminGroup string, editGroup string, runGroup string, readGroup string) (sessions.UserRole, error) {
+	roles := map[string]sessions.UserRole{
+		adminGroup: sessions.UserRoleAdmin,
+		editGroup:  sessions.UserRoleEdit,
+		runGroup:   sessions.UserRoleRun,
+		readGroup:  sessions.UserRoleView,
+	}
+	rank := map[sessions.UserRole]int{
+		sessions.UserRoleView:  1,
+		sessions.UserRoleRun:   2,
+		sessions.UserRoleEdit:  3,
+		sessions.UserRoleAdmin: 4,
+	}
+
+	var found sessions.UserRole
+	for _, group := range groups {
+		role, ok := roles[group]
+		if ok && rank[role] > rank[found] {
+			found = role
+		}
+	}
+	if found == "" {
+		return found, ErrUserNoOIDCGroups
+	}
+	return found, nil
+}
+
+const constantTimeEmailLength = 256
+
+func constantTimeEmailCompare(left, right string) bool {
+	length := mathutil.Max(constantTimeEmailLength, len(left), len(right))
+	leftBytes := make([]byte, length)
+	rightBytes := make([]byte, length)
+	copy(leftBytes, left)
+	copy(rightBytes, right)
+	return subtle.ConstantTimeCompare(leftBytes, rightBytes) == 1
+}
@@ -0,0 +1,162 @@
+package oidcauth_test
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/oidcauth"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/oidcauth/oidcauthtest"
+)
+
+// Setup OIDC Auth authenticator against a mock issuer
+func setupAuthenticationProvider(t *testing.T) (*oidcauthtest.Issuer, sessions.AuthenticationProvider) {
+	t.Helper()
+
+	issuer := oidcauthtest.NewIssuer(t)
+	cfg := oidcauth.TestConfig{IssuerURL: issuer.URL}
+	oidcAuthProvider, err := oidcauth.NewOIDCAuthenticator(pgtest.NewSqlxDB(t), &cfg, true, logger.TestLogger(t), &audit.AuditLoggerService{})
+	require.NoError(t, err)
+	return issuer, oidcAuthProvider
+}
+
+// login follows the redirect to the issuer, and returns the code and state it redirects back with
+func login(t *testing.T, provider sessions.AuthenticationProvider, state, nonce string) (code string, returnedState string) {
+	t.Helper()
+
+	authURL, err := provider.(sessions.RedirectAuthenticationProvider).AuthCodeURL(testutils.Context(t), state, nonce)
+	require.NoError(t, err)
+
+	client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }}
+	resp, err := client.Get(authURL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusFound, resp.StatusCode)
+
+	callback, err := url.Parse(resp.Header.Get("Location"))
+	require.NoError(t, err)
+	assert.Equal(t, "/oidc/callback", callback.Path)
+	return callback.Query().Get("code"), callback.Query().Get("state")
+}
+
+func TestOIDCAuthenticator_CreateSessionFromCode(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	issuer, oidcAuthProvider := setupAuthenticationProvider(t)
+	redirectProvider := oidcAuthProvider.(sessions.RedirectAuthenticationProvider)
+
+	user := cltest.MustRandomUser(t)
+	issuer.SetUser(user.Email, "Other", oidcauth.NodeEditorsGroup, oidcauth.NodeReadOnlyGroup)
+
+	code, state := login(t, oidcAuthProvider, "st4te", "n0nce")
+	assert.Equal(t, "st4te", state)
+
+	sessionID, err := redirectProvider.CreateSessionFromCode(ctx, code, "n0nce")
+	require.NoError(t, err)
+
+	found, err := oidcAuthProvider.AuthorizedUserWithSession(ctx, sessionID)
+	require.NoError(t, err)
+	assert.Equal(t, user.Email, found.Email)
+	assert.Equal(t, sessions.UserRoleEdit, found.Role)
+
+	found, err = oidcAuthProvider.FindUser(ctx, user.Email)
+	require.NoError(t, err)
+	assert.Equal(t, sessions.UserRoleEdit, found.Role)
+
+	users, err := oidcAuthProvider.ListUsers(ctx)
+	require.NoError(t, err)
+	emails := make(map[string]sessions.UserRole)
+	for _, u := range users {
+		emails[u.Email] = u.Role
+	}
+	assert.Equal(t, sessions.UserRoleEdit, emails[user.Email])
+	assert.Equal(t, sessions.UserRoleAdmin, emails[cltest.APIEmailAdmin]) // Text fixture user is local admin included as well
+
+	// Codes are exchanged once
+	_, err = redirectProvider.CreateSessionFromCode(ctx, code, "n0nce")
+	require.Error(t, err)
+
+	require.NoError(t, oidcAuthProvider.DeleteUserSession(ctx, sessionID))
+	_, err = oidcAuthProvider.AuthorizedUserWithSession(ctx, sessionID)
+	require.ErrorIs(t, err, sessions.ErrUserSessionExpired)
+}
+
+func TestOIDCAuthenticator_CreateSessionFromCode_Invalid(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	issuer, oidcAuthProvider := setupAuthenticationProvider(t)
+	redirectProvider := oidcAuthProvider.(sessions.RedirectAuthenticationProvider)
+	user := cltest.MustRandomUser(t)
+
+	t.Run("nonce mismatch", func(t *testing.T) {
+		issuer.SetUser(user.Email, oidcauth.NodeAdminsGroup)
+		code, _ := login(t, oidcAuthProvider, "st4te", "n0nce")
+		_, err := redirectProvider.CreateSessionFromCode(ctx, code, "other")
+		require.ErrorContains(t, err, "Invalid ID token")
+	})
+
+	t.Run("no role groups", func(t *testing.T) {
+		issuer.SetUser(user.Email, "Other")
+		code, _ := login(t, oidcAuthProvider, "st4te", "n0nce")
+		_, err := redirectProvider.CreateSessionFromCode(ctx, code, "n0nce")
+		require.ErrorContains(t, err, "no assigned groups to assume role")
+	})
+
+	t.Run("no email", func(t *testing.T) {
+		issuer.SetUser("", oidcauth.NodeAdminsGroup)
+		code, _ := login(t, oidcAuthProvider, "st4te", "n0nce")
+		_, err := redirectProvider.CreateSessionFromCode(ctx, code, "n0nce")
+		require.ErrorContains(t, err, `no "email" claim`)
+	})
+
+	_, err := oidcAuthProvider.FindUser(ctx, user.Email)
+	require.Error(t, err)
+}
+
+func TestOIDCAuthenticator_CreateSession_LocalAdminLogin(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	_, oidcAuthProvider := setupAuthenticationProvider(t)
+
+	// Local admin users should still be able to login with their credentials
+	sessionID, err := oidcAuthProvider.CreateSession(ctx, sessions.SessionRequest{
+		Email:    cltest.APIEmailAdmin,
+		Password: cltest.Password,
+	})
+	require.NoError(t, err)
+
+	found, err := oidcAuthProvider.AuthorizedUserWithSession(ctx, sessionID)
+	require.NoError(t, err)
+	assert.Equal(t, sessions.UserRoleAdmin, found.Role)
+
+	userSessions, err := oidcAuthProvider.Sessions(ctx, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, userSessions, 1)
+	assert.Equal(t, sessionID, userSessions[0].ID)
+	assert.Equal(t, cltest.APIEmailAdmin, userSessions[0].Email)
+
+	_, err = oidcAuthProvider.CreateSession(ctx, sessions.SessionRequest{
+		Email:    cltest.APIEmailAdmin,
+		Password: "incorrect-password",
+	})
+	require.ErrorContains(t, err, "invalid password")
+
+	// Other users can't log in with credentials, nor use API tokens
+	user := cltest.MustRandomUser(t)
+	_, err = oidcAuthProvider.CreateSession(ctx, sessions.SessionRequest{Email: user.Email, Password: cltest.Password})
+	require.Error(t, err)
+	_, err = oidcAuthProvider.CreateAndSetAuthToken(ctx, &user)
+	require.ErrorIs(t, err, sessions.ErrNotSupported)
+}
@@ -0,0 +1,179 @@
+// Package oidcauthtest provides a local OpenID Connect issuer to test the authorization code flow against.
+package oidcauthtest
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+const (
+	ClientID     = "chainlink-node"
+	ClientSecret = "client-secret"
+	KeyID        = "test-key"
+)
+
+// Issuer is a mock OpenID Connect issuer serving the discovery document, its signing keys, and the authorize and token
+// endpoints. Users are logged in right away on authorize, as the user set with SetUser.
+type Issuer struct {
+	*httptest.Server
+	key *rsa.PrivateKey
+
+	mu     sync.Mutex
+	email  string
+	groups []string
+	codes  map[string]jwt.MapClaims
+}
+
+// NewIssuer starts a mock issuer, closed with the test.
+func NewIssuer(t testing.TB) *Issuer {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	i := &Issuer{key: key, codes: map[string]jwt.MapClaims{}}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", i.discovery)
+	mux.HandleFunc("/keys", i.keys)
+	mux.HandleFunc("/authorize", i.authorize)
+	mux.HandleFunc("/token", i.token)
+	i.Server = httptest.NewServer(mux)
+	t.Cleanup(i.Close)
+	return i
+}
+
+// SetUser sets the email and groups of the user logging in next.
+func (i *Issuer) SetUser(email string, groups ...string) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.email, i.groups = email, groups
+}
+
+// IDToken signs the claims as an ID token of the issuer, on top of the default issuer, audience and expiry claims.
+func (i *Issuer) IDToken(t testing.TB, claims jwt.MapClaims) string {
+	raw, err := i.sign(claims)
+	require.NoError(t, err)
+	return raw
+}
+
+func (i *Issuer) sign(claims jwt.MapClaims) (string, error) {
+	signed := jwt.MapClaims{
+		"iss": i.URL,
+		"aud": ClientID,
+		"iat": time.Now().Unix(),
+		"exp": time.Now().Add(time.Hour).Unix(),
+	}
+	for k, v := range claims {
+		signed[k] = v
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, signed)
+	token.Header["kid"] = KeyID
+	return token.SignedString(i.key)
+}
+
+func (i *Issuer) discovery(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, map[string]string{
+		"issuer":                 i.URL,
+		"authorization_endpoint": i.URL + "/authorize",
+		"token_endpoint":         i.URL + "/token",
+		"jwks_uri":               i.URL + "/keys",
+	})
+}
+
+func (i *Issuer) keys(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, map[string]any{"keys": []map[string]string{{
+		"kid": KeyID,
+		"kty": "RSA",
+		"use": "sig",
+		"alg": "RS256",
+		"n":   base64.RawURLEncoding.EncodeToString(i.key.N.Bytes()),
+		"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(i.key.E)).Bytes()),
+	}}})
+}
+
+// authorize logs the user in, and redirects back with a code bound to the nonce of the request.
+func (i *Issuer) authorize(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	if q.Get("client_id") != ClientID || q.Get("response_type") != "code" {
+		http.Error(w, "invalid authorization request", http.StatusBadRequest)
+		return
+	}
+	redirect, err := url.Parse(q.Get("redirect_uri"))
+	if err != nil {
+		http.Error(w, "invalid redirect_uri", http.StatusBadRequest)
+		return
+	}
+
+	i.mu.Lock()
+	code := utils.NewBytes32ID()
+	claims := jwt.MapClaims{"sub": i.email, "nonce": q.Get("nonce")}
+	if i.email != "" {
+		claims["email"] = i.email
+		claims["email_verified"] = true
+	}
+	if i.groups != nil {
+		claims["groups"] = i.groups
+	}
+	i.codes[code] = claims
+	i.mu.Unlock()
+
+	params := redirect.Query()
+	params.Set("code", code)
+	params.Set("state", q.Get("state"))
+	redirect.RawQuery = params.Encode()
+	http.Redirect(w, r, redirect.String(), http.StatusFound)
+}
+
+// token exchanges a code issued by authorize for an ID token, once.
+func (i *Issuer) token(w http.ResponseWriter, r *http.Request) {
+	if id, secret, ok := r.BasicAuth(); !ok || id != ClientID || secret != ClientSecret {
+		w.WriteHeader(http.StatusUnauthorized)
+		writeJSON(w, map[string]string{"error": "invalid_client"})
+		return
+	}
+	if r.PostFormValue("grant_type") != "authorization_code" {
+		w.WriteHeader(http.StatusBadRequest)
+		writeJSON(w, map[string]string{"error": "unsupported_grant_type"})
+		return
+	}
+
+	i.mu.Lock()
+	code := r.PostFormValue("code")
+	claims, ok := i.codes[code]
+	delete(i.codes, code)
+	i.mu.Unlock()
+	if !ok {
+		w.WriteHeader(http.StatusBadRequest)
+		writeJSON(w, map[string]string{"error": "invalid_grant"})
+		return
+	}
+
+	idToken, err := i.sign(claims)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writeJSON(w, map[string]any{
+		"access_token": utils.NewBytes32ID(),
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+		"id_token":     idToken,
+	})
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
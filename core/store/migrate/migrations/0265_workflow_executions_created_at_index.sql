@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX idx_workflow_executions_created_at ON workflow_executions (created_at);
+CREATE INDEX idx_workflow_executions_workflow_id_created_at ON workflow_executions (workflow_id, created_at DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX idx_workflow_executions_workflow_id_created_at;
+DROP INDEX idx_workflow_executions_created_at;
+-- +goose StatementEnd
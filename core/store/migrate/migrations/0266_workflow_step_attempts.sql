@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+
+ALTER TABLE workflow_steps ADD COLUMN attempts jsonb;
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE workflow_steps DROP COLUMN attempts;
+-- +goose StatementEnd
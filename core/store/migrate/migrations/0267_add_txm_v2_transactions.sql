@@ -0,0 +1,63 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE evm.txm_v2_transactions (
+    id BIGSERIAL PRIMARY KEY,
+    chain_id NUMERIC(78,0) NOT NULL,
+    idempotency_key TEXT,
+    nonce BIGINT,
+    from_address BYTEA NOT NULL CHECK (OCTET_LENGTH(from_address) = 20),
+    to_address BYTEA NOT NULL CHECK (OCTET_LENGTH(to_address) = 20),
+    value NUMERIC(78,0) NOT NULL,
+    data BYTEA NOT NULL,
+    specified_gas_limit BIGINT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    initial_broadcast_at TIMESTAMPTZ,
+    last_broadcast_at TIMESTAMPTZ,
+    state evm.txes_state NOT NULL DEFAULT 'unstarted',
+    is_purgeable BOOLEAN NOT NULL DEFAULT FALSE,
+    attempt_count INTEGER NOT NULL DEFAULT 0,
+    meta JSONB,
+    subject UUID,
+    pipeline_task_run_id UUID,
+    min_confirmations INTEGER,
+    signal_callback BOOLEAN NOT NULL DEFAULT FALSE,
+    callback_completed BOOLEAN NOT NULL DEFAULT FALSE,
+    CONSTRAINT chk_txm_v2_transactions_nonce CHECK (
+        state = 'unstarted' AND nonce IS NULL
+        OR
+        state = 'fatal_error'
+        OR
+        state IN ('unconfirmed', 'confirmed') AND nonce IS NOT NULL
+    )
+);
+
+-- A nonce can only be used by a single in-flight or confirmed transaction of an address.
+CREATE UNIQUE INDEX idx_txm_v2_transactions_nonce ON evm.txm_v2_transactions (chain_id, from_address, nonce) WHERE state IN ('unconfirmed', 'confirmed');
+CREATE UNIQUE INDEX idx_txm_v2_transactions_idempotency_key ON evm.txm_v2_transactions (chain_id, idempotency_key) WHERE idempotency_key IS NOT NULL;
+CREATE INDEX idx_txm_v2_transactions_state ON evm.txm_v2_transactions (chain_id, from_address, state, id);
+
+CREATE TABLE evm.txm_v2_attempts (
+    id BIGSERIAL PRIMARY KEY,
+    tx_id BIGINT NOT NULL REFERENCES evm.txm_v2_transactions (id) ON DELETE CASCADE,
+    hash BYTEA NOT NULL CHECK (OCTET_LENGTH(hash) = 32),
+    gas_price NUMERIC(78,0),
+    gas_tip_cap NUMERIC(78,0),
+    gas_fee_cap NUMERIC(78,0),
+    gas_limit BIGINT NOT NULL,
+    type SMALLINT NOT NULL,
+    signed_transaction BYTEA,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    broadcast_at TIMESTAMPTZ,
+    UNIQUE (tx_id, hash)
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE evm.txm_v2_attempts;
+DROP TABLE evm.txm_v2_transactions;
+
+-- +goose StatementEnd
@@ -0,0 +1,34 @@
+-- +goose Up
+-- User operations are ERC-4337 transactions sent from smart accounts owned by node keys, through a bundler.
+-- They are queued unstarted, and are assigned their sender, nonce and hash once sent to the bundler.
+CREATE TABLE evm.user_operations (
+    id BIGSERIAL PRIMARY KEY,
+    evm_chain_id NUMERIC(78,0) NOT NULL,
+    idempotency_key TEXT NOT NULL,
+    state TEXT NOT NULL DEFAULT 'unstarted' CHECK (state IN ('unstarted', 'pending', 'included', 'failed')),
+    entry_point BYTEA NOT NULL CHECK (OCTET_LENGTH(entry_point) = 20),
+    owner BYTEA NOT NULL CHECK (OCTET_LENGTH(owner) = 20),
+    to_address BYTEA NOT NULL CHECK (OCTET_LENGTH(to_address) = 20),
+    value NUMERIC(78,0) NOT NULL,
+    data BYTEA NOT NULL,
+    fee_limit BIGINT NOT NULL,
+    sender BYTEA CHECK (OCTET_LENGTH(sender) = 20),
+    nonce NUMERIC(78,0),
+    hash BYTEA CHECK (OCTET_LENGTH(hash) = 32),
+    previous_hashes BYTEA[] NOT NULL DEFAULT '{}',
+    max_fee_per_gas NUMERIC(78,0),
+    max_priority_fee_per_gas NUMERIC(78,0),
+    block_number BIGINT,
+    error TEXT,
+    broadcast_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK (state IN ('unstarted', 'failed') OR (sender IS NOT NULL AND nonce IS NOT NULL AND hash IS NOT NULL AND broadcast_at IS NOT NULL))
+);
+
+CREATE UNIQUE INDEX idx_user_operations_idempotency_key ON evm.user_operations (evm_chain_id, idempotency_key);
+-- A nonce of an account is used by a single user operation
+CREATE UNIQUE INDEX idx_user_operations_nonce ON evm.user_operations (evm_chain_id, entry_point, sender, nonce) WHERE state IN ('pending', 'included');
+CREATE INDEX idx_user_operations_state ON evm.user_operations (evm_chain_id, state) WHERE state IN ('unstarted', 'pending');
+
+-- +goose Down
+DROP TABLE evm.user_operations;
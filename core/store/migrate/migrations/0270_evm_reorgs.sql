@@ -0,0 +1,20 @@
+-- +goose Up
+-- Reorgs are the reorganizations of the chain detected by the head tracker. Unlike heads, they are never trimmed.
+CREATE TABLE evm.reorgs (
+    id BIGSERIAL PRIMARY KEY,
+    evm_chain_id NUMERIC(78,0) NOT NULL,
+    common_ancestor_hash BYTEA NOT NULL CHECK (OCTET_LENGTH(common_ancestor_hash) = 32),
+    common_ancestor_number BIGINT NOT NULL,
+    depth BIGINT NOT NULL CHECK (depth > 0),
+    previous_head_hash BYTEA NOT NULL CHECK (OCTET_LENGTH(previous_head_hash) = 32),
+    previous_head_number BIGINT NOT NULL,
+    new_head_hash BYTEA NOT NULL CHECK (OCTET_LENGTH(new_head_hash) = 32),
+    new_head_number BIGINT NOT NULL,
+    orphaned_hashes BYTEA[] NOT NULL,
+    detected_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_evm_reorgs_chain_detected_at ON evm.reorgs (evm_chain_id, detected_at DESC);
+
+-- +goose Down
+DROP TABLE evm.reorgs;
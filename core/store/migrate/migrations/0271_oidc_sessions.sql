@@ -0,0 +1,11 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS oidc_sessions (
+    id text PRIMARY KEY,
+    user_email text NOT NULL,
+    user_role user_roles,
+    localauth_user BOOLEAN,
+    created_at timestamp with time zone NOT NULL
+);
+
+-- +goose Down
+DROP TABLE oidc_sessions;
@@ -0,0 +1,18 @@
+-- +goose Up
+-- API tokens are the named, scoped and optionally expiring tokens of the users, besides their legacy token of the users table.
+CREATE TABLE api_tokens (
+    id BIGSERIAL PRIMARY KEY,
+    user_email text NOT NULL REFERENCES users (email) ON DELETE CASCADE,
+    name text NOT NULL,
+    token_key text NOT NULL UNIQUE,
+    token_salt text NOT NULL,
+    token_hashed_secret text NOT NULL,
+    scopes text[] NOT NULL,
+    expires_at timestamp with time zone,
+    last_used_at timestamp with time zone,
+    created_at timestamp with time zone NOT NULL,
+    CONSTRAINT api_tokens_user_email_name_key UNIQUE (user_email, name)
+);
+
+-- +goose Down
+DROP TABLE api_tokens;
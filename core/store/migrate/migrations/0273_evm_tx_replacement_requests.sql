@@ -0,0 +1,11 @@
+-- +goose Up
+-- Replacement requests are the transactions the node operator requested to cancel or speed up, applied by the
+-- Confirmer. Cancel requests are kept to tell the cancelled transactions apart from the terminally stuck ones.
+CREATE TABLE evm.tx_replacement_requests (
+    eth_tx_id bigint PRIMARY KEY REFERENCES evm.txes (id) ON DELETE CASCADE,
+    cancel boolean NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT NOW()
+);
+
+-- +goose Down
+DROP TABLE evm.tx_replacement_requests;
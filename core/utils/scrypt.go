@@ -1,6 +1,9 @@
 package utils
 
 import (
+	"errors"
+	"fmt"
+
 	"github.com/ethereum/go-ethereum/accounts/keystore"
 )
 
@@ -30,6 +33,17 @@ var DefaultScryptParams = ScryptParams{N: keystore.StandardScryptN, P: keystore.
 // encrypted keys will be easy to brute-force!
 var FastScryptParams = ScryptParams{N: FastN, P: FastP}
 
+// Validate returns an error if N isn't a power of two greater than 1, or P isn't positive.
+func (p ScryptParams) Validate() error {
+	if p.N <= 1 || p.N&(p.N-1) != 0 {
+		return fmt.Errorf("scrypt N parameter must be a power of two greater than 1, got %d", p.N)
+	}
+	if p.P <= 0 {
+		return errors.New("scrypt P parameter must be positive")
+	}
+	return nil
+}
+
 // GetScryptParams fetches ScryptParams from a ScryptConfigReader
 func GetScryptParams(config ScryptConfigReader) ScryptParams {
 	if config.InsecureFastScrypt() {
@@ -0,0 +1,22 @@
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+func TestScryptParams_Validate(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, utils.DefaultScryptParams.Validate())
+	assert.NoError(t, utils.FastScryptParams.Validate())
+	assert.NoError(t, utils.ScryptParams{N: 1 << 14, P: 2}.Validate())
+
+	assert.ErrorContains(t, utils.ScryptParams{N: 1, P: 1}.Validate(), "power of two")
+	assert.ErrorContains(t, utils.ScryptParams{N: 3, P: 1}.Validate(), "power of two")
+	assert.ErrorContains(t, utils.ScryptParams{N: -4, P: 1}.Validate(), "power of two")
+	assert.ErrorContains(t, utils.ScryptParams{N: 4, P: 0}.Validate(), "P parameter must be positive")
+}
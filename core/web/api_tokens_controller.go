@@ -0,0 +1,151 @@
+package web
+
+import (
+	"database/sql"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	clsession "github.com/smartcontractkit/chainlink/v2/core/sessions"
+	webauth "github.com/smartcontractkit/chainlink/v2/core/web/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// APITokensController manages the named API tokens of the current Session's User.
+type APITokensController struct {
+	App chainlink.Application
+}
+
+// CreateAPITokenRequest defines the request to create a named API token for the current session's User.
+type CreateAPITokenRequest struct {
+	Name      string     `json:"name"`
+	Scopes    []string   `json:"scopes"`
+	ExpiresAt *time.Time `json:"expiresAt"`
+	Password  string     `json:"password"`
+}
+
+// DeleteAPITokenRequest defines the request to delete a named API token of the current session's User.
+type DeleteAPITokenRequest struct {
+	Password string `json:"password"`
+}
+
+// Index lists the named API tokens of the user.
+// Example:
+// "GET <application>/user/tokens"
+func (atc *APITokensController) Index(c *gin.Context) {
+	provider, user, ok := atc.apiTokenProvider(c)
+	if !ok {
+		return
+	}
+	tokens, err := provider.ListAPITokens(c.Request.Context(), user.Email)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewAPITokenResources(tokens), "api_tokens")
+}
+
+// Create creates a named API token of the user, and returns its secret which can't be retrieved later.
+// Example:
+// "POST <application>/user/tokens"
+func (atc *APITokensController) Create(c *gin.Context) {
+	ctx := c.Request.Context()
+	var request CreateAPITokenRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	scopes, err := clsession.ParseAPITokenScopes(request.Scopes)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	provider, user, ok := atc.apiTokenProvider(c)
+	if !ok {
+		return
+	}
+	// In order to create an API token, login validation with provided password must succeed
+	if err = atc.App.AuthenticationProvider().TestPassword(ctx, user.Email, request.Password); err != nil {
+		atc.App.GetAuditLogger().Audit(audit.APITokenCreateAttemptPasswordMismatch, map[string]interface{}{"user": user.Email})
+		jsonAPIError(c, http.StatusUnauthorized, errors.New("incorrect password"))
+		return
+	}
+
+	token, apiToken, err := provider.CreateAPIToken(ctx, user.Email, request.Name, scopes, null.TimeFromPtr(request.ExpiresAt))
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	atc.App.GetAuditLogger().Audit(audit.APITokenCreated, map[string]interface{}{
+		"user":   user.Email,
+		"name":   apiToken.Name,
+		"scopes": apiToken.Scopes.Strings(),
+	})
+	resource := presenters.NewAPITokenResource(apiToken)
+	resource.Secret = token.Secret
+	jsonAPIResponseWithStatus(c, resource, "api_token", http.StatusCreated)
+}
+
+// Destroy deletes a named API token of the user.
+// Example:
+// "POST <application>/user/tokens/:tokenID/delete"
+func (atc *APITokensController) Destroy(c *gin.Context) {
+	ctx := c.Request.Context()
+	id, err := strconv.ParseInt(c.Param("tokenID"), 10, 64)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	var request DeleteAPITokenRequest
+	if err = c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	provider, user, ok := atc.apiTokenProvider(c)
+	if !ok {
+		return
+	}
+	// In order to delete an API token, login validation with provided password must succeed
+	if err = atc.App.AuthenticationProvider().TestPassword(ctx, user.Email, request.Password); err != nil {
+		atc.App.GetAuditLogger().Audit(audit.APITokenDeleteAttemptPasswordMismatch, map[string]interface{}{"user": user.Email})
+		jsonAPIError(c, http.StatusUnauthorized, errors.New("incorrect password"))
+		return
+	}
+	apiToken, err := provider.DeleteAPIToken(ctx, user.Email, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			jsonAPIError(c, http.StatusNotFound, errors.New("API token not found"))
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	atc.App.GetAuditLogger().Audit(audit.APITokenDeleted, map[string]interface{}{"user": user.Email, "name": apiToken.Name})
+	jsonAPIResponse(c, presenters.NewAPITokenResource(apiToken), "api_token")
+}
+
+// apiTokenProvider returns the authentication provider managing the named API tokens and the current user, or responds
+// with an error if the provider doesn't support them.
+func (atc *APITokensController) apiTokenProvider(c *gin.Context) (clsession.APITokenProvider, *clsession.User, bool) {
+	provider, ok := atc.App.AuthenticationProvider().(clsession.APITokenProvider)
+	if !ok {
+		jsonAPIError(c, http.StatusBadRequest, errUnsupportedForAuth)
+		return nil, nil, false
+	}
+	user, ok := webauth.GetAuthenticatedUser(c)
+	if !ok {
+		jsonAPIError(c, http.StatusInternalServerError, errors.New("failed to obtain current user from context"))
+		return nil, nil, false
+	}
+	return provider, user, true
+}
@@ -0,0 +1,110 @@
+package web_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	webauth "github.com/smartcontractkit/chainlink/v2/core/web/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestAPITokensController_Create(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	ctx := testutils.Context(t)
+	require.NoError(t, app.Start(ctx))
+
+	client := app.NewHTTPClient(nil)
+	req, err := json.Marshal(web.CreateAPITokenRequest{
+		Name:     "ci",
+		Scopes:   []string{"jobs:view"},
+		Password: cltest.Password,
+	})
+	require.NoError(t, err)
+	resp, cleanup := client.Post("/v2/user/tokens", bytes.NewBuffer(req))
+	defer cleanup()
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	var created presenters.APITokenResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &created))
+	assert.Equal(t, "ci", created.Name)
+	assert.Equal(t, []string{"jobs:view"}, created.Scopes)
+	assert.NotEmpty(t, created.Secret)
+
+	// requests by token are limited to its scopes
+	requestByToken := func(method, path string, secret string) int {
+		req, err := http.NewRequestWithContext(ctx, method, app.Server.URL+path, bytes.NewBufferString("{}"))
+		require.NoError(t, err)
+		req.Header.Set(webauth.APIKey, created.AccessKey)
+		req.Header.Set(webauth.APISecret, secret)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		return resp.StatusCode
+	}
+	assert.Equal(t, http.StatusOK, requestByToken(http.MethodGet, "/v2/jobs", created.Secret))
+	assert.Equal(t, http.StatusUnauthorized, requestByToken(http.MethodPost, "/v2/jobs", created.Secret))
+	assert.Equal(t, http.StatusUnauthorized, requestByToken(http.MethodGet, "/v2/bridge_types", created.Secret))
+	assert.Equal(t, http.StatusUnauthorized, requestByToken(http.MethodGet, "/v2/jobs", "wrong"))
+
+	resp, cleanup = client.Get("/v2/user/tokens")
+	defer cleanup()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var tokens []presenters.APITokenResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &tokens))
+	require.Len(t, tokens, 1)
+	assert.Equal(t, created.ID, tokens[0].ID)
+	assert.Empty(t, tokens[0].Secret)
+	assert.NotNil(t, tokens[0].LastUsedAt)
+
+	resp, cleanup = client.Post("/v2/user/tokens", bytes.NewBuffer(req))
+	defer cleanup()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode, "duplicate name")
+
+	deleteToken := func(password string) int {
+		req, err := json.Marshal(web.DeleteAPITokenRequest{Password: password})
+		require.NoError(t, err)
+		resp, cleanup := client.Post(fmt.Sprintf("/v2/user/tokens/%s/delete", created.ID), bytes.NewBuffer(req))
+		defer cleanup()
+		return resp.StatusCode
+	}
+	assert.Equal(t, http.StatusUnauthorized, deleteToken("wrong-password"))
+	assert.Equal(t, http.StatusOK, requestByToken(http.MethodGet, "/v2/jobs", created.Secret))
+
+	require.Equal(t, http.StatusOK, deleteToken(cltest.Password))
+	assert.Equal(t, http.StatusUnauthorized, requestByToken(http.MethodGet, "/v2/jobs", created.Secret))
+
+	assert.Equal(t, http.StatusNotFound, deleteToken(cltest.Password))
+}
+
+func TestAPITokensController_Create_invalid(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	client := app.NewHTTPClient(nil)
+	for name, request := range map[string]web.CreateAPITokenRequest{
+		"wrong password": {Name: "ci", Scopes: []string{"jobs:view"}, Password: "wrong-password"},
+		"invalid scope":  {Name: "ci", Scopes: []string{"jobs:owner"}, Password: cltest.Password},
+		"no scopes":      {Name: "ci", Password: cltest.Password},
+	} {
+		t.Run(name, func(t *testing.T) {
+			req, err := json.Marshal(request)
+			require.NoError(t, err)
+			resp, cleanup := client.Post("/v2/user/tokens", bytes.NewBuffer(req))
+			defer cleanup()
+			assert.GreaterOrEqual(t, resp.StatusCode, http.StatusBadRequest)
+		})
+	}
+}
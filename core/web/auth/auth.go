@@ -1,9 +1,13 @@
 package auth
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
+	"encoding/json"
+	"io"
 	"net/http"
+	"strings"
 
 	"github.com/gin-contrib/sessions"
 	"github.com/gin-gonic/gin"
@@ -13,6 +17,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	clsessions "github.com/smartcontractkit/chainlink/v2/core/sessions"
 	"github.com/smartcontractkit/chainlink/v2/core/static"
+	ubig "github.com/smartcontractkit/chainlink/v2/evm/utils/big"
 )
 
 const (
@@ -33,6 +38,9 @@ const (
 
 	// SessionExternalInitiatorKey is the External Initiator key in the session map
 	SessionExternalInitiatorKey = "external_initiator"
+
+	// SessionAPITokenKey is the named API token key in the session map, when the request is authenticated by one
+	SessionAPITokenKey = "api_token"
 )
 
 // Authenticator defines the interface to authenticate requests against a
@@ -89,6 +97,16 @@ func AuthenticateByToken(c *gin.Context, authr Authenticator) error {
 		return auth.ErrorAuthFailed
 	}
 
+	if provider, ok := authr.(clsessions.APITokenProvider); ok {
+		apiToken, err := provider.FindAPIToken(ctx, token.AccessKey)
+		if err == nil {
+			return authenticateByScopedToken(c, authr, provider, token, &apiToken)
+		}
+		if !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+	}
+
 	// We need to first load the user row so we can compare tokens using the stored salt
 	user, err := authr.FindUserByAPIToken(ctx, token.AccessKey)
 	if err != nil {
@@ -113,6 +131,118 @@ func AuthenticateByToken(c *gin.Context, authr Authenticator) error {
 
 var _ authMethod = AuthenticateByToken
 
+// authenticateByScopedToken authenticates a User by one of their named API tokens. The role of the user is lowered to the
+// role the token's scopes grant on the requested resource, which is then enforced by the RequiresXRole middlewares.
+func authenticateByScopedToken(c *gin.Context, authr Authenticator, provider clsessions.APITokenProvider, token *auth.Token, apiToken *clsessions.APIToken) error {
+	ctx := c.Request.Context()
+	ok, err := apiToken.Authenticate(token)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return auth.ErrorAuthFailed
+	}
+
+	user, err := authr.FindUser(ctx, apiToken.UserEmail)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return auth.ErrorAuthFailed
+		}
+		return err
+	}
+	chainID, err := APIChainID(c)
+	if err != nil {
+		return err
+	}
+	role, err := apiToken.Role(user.Role, APIResource(c.Request.URL.Path), chainID)
+	if err != nil {
+		return err
+	}
+	user.Role = role
+
+	if err := provider.MarkAPITokenUsed(ctx, apiToken.ID); err != nil {
+		return errors.Wrap(err, "marking API token used")
+	}
+
+	c.Set(SessionUserKey, &user)
+	c.Set(SessionAPITokenKey, apiToken)
+
+	return nil
+}
+
+// APIResource returns the resource of the API path the scopes of the API tokens refer to, which is the first segment
+// following the API version, such as 'jobs' for '/v2/jobs/1/runs'.
+func APIResource(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) < 2 {
+		return ""
+	}
+	return segments[1]
+}
+
+// chainIDQueryRoutes are the routes whose controllers take the chain of the request from its 'evmChainID' query
+// parameter.
+var chainIDQueryRoutes = map[string]bool{
+	"POST /v2/keys/eth":                  true,
+	"POST /v2/keys/eth/import":           true,
+	"POST /v2/keys/evm":                  true,
+	"POST /v2/keys/evm/import":           true,
+	"POST /v2/keys/evm/chain":            true,
+	"POST /v2/replay_from_block/:number": true,
+	"GET /v2/find_lca":                   true,
+	"GET /v2/logs/export":                true,
+	"POST /v2/logs/import":               true,
+}
+
+// chainIDBodyRoutes are the routes whose controllers take the chain of the request from a field of its JSON body.
+var chainIDBodyRoutes = map[string]string{
+	"POST /v2/transfers":                  "evmChainID",
+	"POST /v2/transfers/evm":              "evmChainID",
+	"POST /v2/nodes/evm/forwarders/track": "evmChainId",
+}
+
+// APIChainID returns the chain ID of the request from the same parameter its controller reads it from, the scopes of
+// the API tokens restricted to a chain only match the requests naming it. It is empty for the routes not targeting a
+// single EVM chain, whatever the query parameters of the request.
+func APIChainID(c *gin.Context) (string, error) {
+	route := c.Request.Method + " " + c.FullPath()
+	switch {
+	case strings.HasPrefix(c.FullPath(), "/v2/chains/:network/:ID"):
+		if c.Param("network") != "evm" {
+			return "", nil
+		}
+		return c.Param("ID"), nil
+	case chainIDQueryRoutes[route]:
+		return c.Query("evmChainID"), nil
+	case chainIDBodyRoutes[route] != "":
+		return bodyChainID(c, chainIDBodyRoutes[route])
+	}
+	return "", nil
+}
+
+// bodyChainID returns the chain ID from the field of the JSON body of the request, which is restored for its controller.
+func bodyChainID(c *gin.Context, field string) (string, error) {
+	if c.Request.Body == nil {
+		return "", nil
+	}
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		return "", errors.Wrap(err, "reading request body")
+	}
+	c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil {
+		// The controller rejects the malformed body, and no chain scope matches it meanwhile
+		return "", nil //nolint:nilerr
+	}
+	var chainID *ubig.Big
+	if raw, ok := fields[field]; !ok || json.Unmarshal(raw, &chainID) != nil || chainID == nil {
+		return "", nil
+	}
+	return chainID.String(), nil
+}
+
 // AuthenticateExternalInitiator authenticates an external initiator request.
 //
 // Implements authMethod
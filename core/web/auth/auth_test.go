@@ -6,6 +6,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/gin-gonic/gin"
@@ -245,6 +246,8 @@ var routesRolesMap = [...]routeRules{
 	{"GET", "/v2/tx_attempts/evm", true, true, true},
 	{"GET", "/v2/transactions/evm", true, true, true},
 	{"GET", "/v2/transactions/evm/MOCK", true, true, true},
+	{"POST", "/v2/transactions/evm/MOCK/cancel", false, false, false},
+	{"POST", "/v2/transactions/evm/MOCK/speedup", false, false, false},
 	{"GET", "/v2/transactions", true, true, true},
 	{"GET", "/v2/transactions/MOCK", true, true, true},
 	{"POST", "/v2/replay_from_block/MOCK", false, true, true},
@@ -309,6 +312,9 @@ var routesRolesMap = [...]routeRules{
 	{"GET", "/v2/pipeline/runs", true, true, true},
 	{"GET", "/v2/jobs/MOCK/runs", true, true, true},
 	{"GET", "/v2/jobs/MOCK/runs/MOCK", true, true, true},
+	{"GET", "/v2/workflows/executions", true, true, true},
+	{"GET", "/v2/workflows/executions/MOCK", true, true, true},
+	{"POST", "/v2/workflows/executions/MOCK/replay", false, false, false},
 	{"GET", "/v2/features", true, true, true},
 	{"DELETE", "/v2/pipeline/job_spec_errors/MOCK", false, false, true},
 	{"GET", "/v2/log", true, true, true},
@@ -523,3 +529,50 @@ func mustRequest(t *testing.T, method, url string, body io.Reader) *http.Request
 	require.NoError(t, err)
 	return req
 }
+
+func TestAPIResource(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "jobs", webauth.APIResource("/v2/jobs"))
+	assert.Equal(t, "jobs", webauth.APIResource("/v2/jobs/1/runs"))
+	assert.Equal(t, "keys", webauth.APIResource("/v2/keys/evm/"))
+	assert.Equal(t, "", webauth.APIResource("/health"))
+}
+
+func TestAPIChainID(t *testing.T) {
+	t.Parallel()
+
+	router := gin.New()
+	var chainID string
+	handler := func(c *gin.Context) {
+		var err error
+		chainID, err = webauth.APIChainID(c)
+		require.NoError(t, err)
+		body, err := io.ReadAll(c.Request.Body)
+		require.NoError(t, err)
+		c.String(http.StatusOK, string(body))
+	}
+	router.GET("/v2/chains/:network/:ID", handler)
+	router.POST("/v2/keys/evm", handler)
+	router.POST("/v2/transfers", handler)
+	router.GET("/v2/jobs", handler)
+
+	for _, tc := range []struct {
+		name, method, url, body, chainID string
+	}{
+		{"chain path", http.MethodGet, "/v2/chains/evm/5?evmChainID=1", "", "5"},
+		{"non-EVM chain path", http.MethodGet, "/v2/chains/solana/5?evmChainID=1", "", ""},
+		{"query", http.MethodPost, "/v2/keys/evm?evmChainID=1", "", "1"},
+		{"body", http.MethodPost, "/v2/transfers?evmChainID=1", `{"evmChainID":"5"}`, "5"},
+		{"body without chain", http.MethodPost, "/v2/transfers?evmChainID=1", `{}`, ""},
+		{"route without chain", http.MethodGet, "/v2/jobs?evmChainID=1", "", ""},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, mustRequest(t, tc.method, tc.url, strings.NewReader(tc.body)))
+			require.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, tc.chainID, chainID)
+			assert.Equal(t, tc.body, w.Body.String(), "body must be left for the controller")
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package web
+
+import (
+	"errors"
+	"math/big"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/relay"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// EVMReorgsController displays the reorg history of EVM chains.
+type EVMReorgsController struct {
+	App chainlink.Application
+}
+
+// Index returns a page of the reorgs detected by the head tracker of the chain, most recent first.
+// Example:
+//
+//	"GET <application>/chains/evm/:ID/reorgs"
+func (rc *EVMReorgsController) Index(c *gin.Context, size, page, offset int) {
+	if c.Param("network") != relay.NetworkEVM {
+		jsonAPIError(c, http.StatusNotFound, errors.New("reorg history is only available for EVM chains"))
+		return
+	}
+	chainID, ok := new(big.Int).SetString(c.Param("ID"), 10)
+	if !ok {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("invalid chain ID "+c.Param("ID")))
+		return
+	}
+	if _, err := rc.App.GetRelayers().LegacyEVMChains().Get(chainID.String()); err != nil {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	}
+
+	reorgs, count, err := headtracker.NewORM(*chainID, rc.App.GetDB()).Reorgs(c.Request.Context(), offset, size)
+	resources := []presenters.EVMReorgResource{}
+	for _, reorg := range reorgs {
+		resources = append(resources, presenters.NewEVMReorgResource(reorg))
+	}
+	paginatedResponse(c, "reorgs", size, page, resources, count, err)
+}
@@ -0,0 +1,76 @@
+package web_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+	"github.com/smartcontractkit/chainlink/v2/evm/config/toml"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/evm/utils"
+	"github.com/smartcontractkit/chainlink/v2/evm/utils/big"
+)
+
+func Test_EVMReorgsController_Index(t *testing.T) {
+	t.Parallel()
+
+	chainID := big.New(testutils.NewRandomEVMChainID())
+	app := cltest.NewApplicationWithConfig(t, configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM = toml.EVMConfigs{
+			{ChainID: chainID, Enabled: ptr(true), Chain: toml.Defaults(chainID)},
+		}
+	}))
+	ctx := testutils.Context(t)
+	require.NoError(t, app.Start(ctx))
+	client := app.NewHTTPClient(nil)
+
+	orm := headtracker.NewORM(*chainID.ToInt(), app.GetDB())
+	for i := int64(1); i <= 3; i++ {
+		require.NoError(t, orm.InsertReorg(ctx, &headtracker.Reorg{
+			CommonAncestorHash:   utils.NewHash(),
+			CommonAncestorNumber: 100 * i,
+			Depth:                i,
+			PreviousHeadHash:     utils.NewHash(),
+			PreviousHeadNumber:   100*i + i,
+			NewHeadHash:          utils.NewHash(),
+			NewHeadNumber:        100*i + i,
+			OrphanedHashes:       evmtypes.HashArray{utils.NewHash()},
+		}))
+	}
+
+	resp, cleanup := client.Get("/v2/chains/evm/" + chainID.String() + "/reorgs?size=2")
+	t.Cleanup(cleanup)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	body := cltest.ParseResponseBody(t, resp)
+	metaCount, err := cltest.ParseJSONAPIResponseMetaCount(body)
+	require.NoError(t, err)
+	require.Equal(t, 3, metaCount)
+
+	var links jsonapi.Links
+	var reorgs []presenters.EVMReorgResource
+	require.NoError(t, web.ParsePaginatedResponse(body, &reorgs, &links))
+	require.Len(t, reorgs, 2)
+	assert.Equal(t, int64(3), reorgs[0].Depth)
+	assert.Equal(t, *chainID, reorgs[0].EVMChainID)
+	assert.Len(t, reorgs[0].OrphanedHashes, 1)
+	assert.NotEmpty(t, links["next"].Href)
+
+	resp, cleanup = client.Get("/v2/chains/evm/42424242/reorgs")
+	t.Cleanup(cleanup)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	resp, cleanup = client.Get("/v2/chains/solana/" + chainID.String() + "/reorgs")
+	t.Cleanup(cleanup)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
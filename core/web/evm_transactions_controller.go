@@ -3,7 +3,10 @@ package web
 import (
 	"database/sql"
 	"net/http"
+	"strconv"
 
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 
@@ -47,3 +50,82 @@ func (tc *TransactionsController) Show(c *gin.Context) {
 
 	jsonAPIResponse(c, presenters.NewEthTxResourceFromAttempt(*ethTxAttempt), "transaction")
 }
+
+// Cancel requests the replacement of an unconfirmed Ethereum Transaction with a 0-value self-transfer at the same
+// nonce. The replacement is sent by the transaction manager of the chain on its next head.
+// Example:
+//
+//	"<application>/transactions/evm/:ID/cancel"
+func (tc *TransactionsController) Cancel(c *gin.Context) {
+	tc.replace(c, true)
+}
+
+// SpeedUp requests the replacement of an unconfirmed Ethereum Transaction with a copy with a bumped fee at the same
+// nonce. The replacement is sent by the transaction manager of the chain on its next head.
+// Example:
+//
+//	"<application>/transactions/evm/:ID/speedup"
+func (tc *TransactionsController) SpeedUp(c *gin.Context) {
+	tc.replace(c, false)
+}
+
+func (tc *TransactionsController) replace(c *gin.Context, cancel bool) {
+	id, err := strconv.ParseInt(c.Param("ID"), 10, 64)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	etx, err := tc.App.TxmStorageService().FindTxWithAttempts(c, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("Transaction not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	chain, err := tc.App.GetRelayers().LegacyEVMChains().Get(etx.ChainID.String())
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	replacer, ok := chain.TxManager().(txmgr.TxReplacer)
+	if !ok {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("the transaction manager of chain %s can't replace transactions", etx.ChainID))
+		return
+	}
+
+	if cancel {
+		etx, err = replacer.CancelTransaction(c, id)
+	} else {
+		etx, err = replacer.SpeedUpTransaction(c, id)
+	}
+	if errors.Is(err, txmgr.ErrTxNotFound) {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	}
+	if errors.Is(err, txmgr.ErrTxNotReplaceable) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	event := audit.EthTransactionSpedUp
+	if cancel {
+		event = audit.EthTransactionCancelled
+	}
+	tc.App.GetAuditLogger().Audit(event, map[string]interface{}{
+		"ethTxID":     etx.ID,
+		"fromAddress": etx.FromAddress,
+		"nonce":       etx.Sequence,
+	})
+
+	// The replacement is sent on the next head, so the latest attempt is still the one being replaced
+	etx.TxAttempts[0].Tx = etx
+	jsonAPIResponseWithStatus(c, presenters.NewEthTxResourceFromAttempt(etx.TxAttempts[0]), "transaction", http.StatusAccepted)
+}
@@ -129,3 +129,41 @@ func TestTransactionsController_Show_NotFound(t *testing.T) {
 	t.Cleanup(cleanup)
 	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
 }
+
+func TestTransactionsController_Cancel(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	ctx := testutils.Context(t)
+	require.NoError(t, app.Start(ctx))
+
+	txStore := cltest.NewTestTxStore(t, app.GetDB())
+	client := app.NewHTTPClient(nil)
+	_, from := cltest.MustInsertRandomKey(t, app.KeyStore.Eth())
+
+	t.Run("not found", func(t *testing.T) {
+		resp, cleanup := client.Post("/v2/transactions/evm/424242/cancel", nil)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+	})
+
+	t.Run("confirmed transaction", func(t *testing.T) {
+		tx := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, txStore, 0, 1, from)
+		resp, cleanup := client.Post(fmt.Sprintf("/v2/transactions/evm/%d/cancel", tx.ID), nil)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+	})
+
+	t.Run("unconfirmed transaction", func(t *testing.T) {
+		tx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 1, from)
+		resp, cleanup := client.Post(fmt.Sprintf("/v2/transactions/evm/%d/cancel", tx.ID), nil)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusAccepted)
+
+		// The replacement is sent by the transaction manager on its next head
+		ptx := presenters.EthTxResource{}
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &ptx))
+		assert.Equal(t, tx.TxAttempts[0].Hash, ptx.Hash)
+		assert.Equal(t, "1", ptx.Nonce)
+	})
+}
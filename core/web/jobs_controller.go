@@ -27,6 +27,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/validate"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocrbootstrap"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/services/standardcapabilities"
 	"github.com/smartcontractkit/chainlink/v2/core/services/streams"
 	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
@@ -216,6 +217,41 @@ func (jc *JobsController) Update(c *gin.Context) {
 	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
 }
 
+// SimulateJobRequest represents a request to dry-run the pipeline of a job spec (V2).
+type SimulateJobRequest struct {
+	TOML  string                                `json:"toml"`
+	Vars  map[string]interface{}                `json:"vars"`
+	Stubs map[string]pipeline.SimulatedResponse `json:"stubs"`
+}
+
+// Simulate executes the pipeline of a job spec in-memory against stubbed
+// responses and returns the result of every task. Nothing is persisted and no
+// transactions are sent.
+// Example:
+// "POST <application>/jobs/simulate"
+func (jc *JobsController) Simulate(c *gin.Context) {
+	request := SimulateJobRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	spec, err := job.SimulationSpec(request.TOML)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "failed to parse TOML"))
+		return
+	}
+
+	sim := pipeline.NewSimulation(request.Stubs)
+	run, trrs, err := jc.App.SimulateJobV2(c.Request.Context(), spec, request.Vars, sim)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewPipelineSimulationResource(*run, trrs, sim, jc.App.GetLogger()), "pipelineSimulation")
+}
+
 func (jc *JobsController) validateJobSpec(ctx context.Context, tomlString string) (jb job.Job, statusCode int, err error) {
 	jobType, err := job.ValidateSpec(tomlString)
 	if err != nil {
@@ -0,0 +1,75 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+	webauth "github.com/smartcontractkit/chainlink/v2/core/web/auth"
+)
+
+// KeystoreController manages the keystore of the node.
+type KeystoreController struct {
+	App chainlink.Application
+}
+
+// RotateKeystorePasswordRequest defines the request to re-encrypt the keystore
+// with a new password. The scrypt parameters of the encryption default to the
+// ones of the node config when unset, and only apply until the node restarts.
+type RotateKeystorePasswordRequest struct {
+	OldPassword string `json:"oldPassword"`
+	NewPassword string `json:"newPassword"`
+	ScryptN     int    `json:"scryptN,omitempty"`
+	ScryptP     int    `json:"scryptP,omitempty"`
+}
+
+// RotatePassword re-encrypts the keystore with a new password, without
+// restarting the node. The new password must be set as the Password.Keystore
+// secret before the node is next started.
+func (kc *KeystoreController) RotatePassword(c *gin.Context) {
+	var request RotateKeystorePasswordRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	var email string
+	if user, ok := webauth.GetAuthenticatedUser(c); ok {
+		email = user.Email
+	}
+	if err := utils.VerifyPasswordComplexity(request.NewPassword); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	scryptParams := utils.GetScryptParams(kc.App.GetConfig())
+	if request.ScryptN != 0 {
+		scryptParams.N = request.ScryptN
+	}
+	if request.ScryptP != 0 {
+		scryptParams.P = request.ScryptP
+	}
+	if err := scryptParams.Validate(); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	err := kc.App.GetKeyStore().RotatePassword(c.Request.Context(), request.OldPassword, request.NewPassword, scryptParams)
+	if errors.Is(err, keystore.ErrPasswordMismatch) {
+		kc.App.GetAuditLogger().Audit(audit.KeystorePasswordRotateAttemptFailedMismatch, map[string]interface{}{"user": email})
+		jsonAPIError(c, http.StatusConflict, errors.New("old keystore password does not match"))
+		return
+	} else if err != nil {
+		kc.App.GetLogger().Errorw("Failed to rotate keystore password", "err", err)
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	kc.App.GetAuditLogger().Audit(audit.KeystorePasswordRotated, map[string]interface{}{"user": email})
+	jsonAPIResponseWithStatus(c, nil, "keystore", http.StatusNoContent)
+}
@@ -0,0 +1,92 @@
+package web_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+func TestKeystoreController_RotatePassword(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(ctx))
+	key, err := app.KeyStore.CSA().Create(ctx)
+	require.NoError(t, err)
+
+	client := app.NewHTTPClient(nil)
+	const newPassword = "rotated-keystore-p4SsW0rD"
+
+	testCases := []struct {
+		name           string
+		reqBody        string
+		wantStatusCode int
+		wantErrMessage string
+	}{
+		{
+			name:           "Invalid request",
+			reqBody:        "",
+			wantStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "Insufficient length of new password",
+			reqBody:        fmt.Sprintf(`{"newPassword": "foo", "oldPassword": "%s"}`, cltest.Password),
+			wantStatusCode: http.StatusUnprocessableEntity,
+			wantErrMessage: fmt.Sprintf("%s	%s\n", utils.ErrMsgHeader, "password is less than 16 characters long"),
+		},
+		{
+			name:           "Incorrect old password",
+			reqBody:        fmt.Sprintf(`{"newPassword": "%s", "oldPassword": "wrong password"}`, newPassword),
+			wantStatusCode: http.StatusConflict,
+			wantErrMessage: "old keystore password does not match",
+		},
+		{
+			name:           "Invalid scrypt params",
+			reqBody:        fmt.Sprintf(`{"newPassword": "%s", "oldPassword": "%s", "scryptN": 3}`, newPassword, cltest.Password),
+			wantStatusCode: http.StatusUnprocessableEntity,
+			wantErrMessage: "scrypt N parameter must be a power of two greater than 1, got 3",
+		},
+		{
+			name:           "Success",
+			reqBody:        fmt.Sprintf(`{"newPassword": "%s", "oldPassword": "%s", "scryptN": 4, "scryptP": 1}`, newPassword, cltest.Password),
+			wantStatusCode: http.StatusNoContent,
+		},
+		{
+			name:           "Rotated password is required",
+			reqBody:        fmt.Sprintf(`{"newPassword": "%s", "oldPassword": "%s"}`, newPassword, cltest.Password),
+			wantStatusCode: http.StatusConflict,
+			wantErrMessage: "old keystore password does not match",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, cleanup := client.Post("/v2/keystore/password", bytes.NewBufferString(tc.reqBody))
+			t.Cleanup(cleanup)
+
+			require.Equal(t, tc.wantStatusCode, resp.StatusCode)
+			if tc.wantStatusCode == http.StatusNoContent {
+				return
+			}
+			errors := cltest.ParseJSONAPIErrors(t, resp.Body)
+			require.Len(t, errors.Errors, 1)
+			if tc.wantErrMessage != "" {
+				assert.Equal(t, tc.wantErrMessage, errors.Errors[0].Detail)
+			}
+		})
+	}
+
+	// the keystore is unlocked with the new password, and still holds its keys
+	require.NoError(t, app.KeyStore.Unlock(ctx, newPassword))
+	_, err = app.KeyStore.CSA().Get(key.ID())
+	assert.NoError(t, err)
+}
@@ -0,0 +1,118 @@
+package web
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/evm/utils/big"
+)
+
+type LogsArchiveController struct {
+	App chainlink.Application
+}
+
+// Export streams a gzipped archive of the finalized logs matching the given filters, and their blocks.
+// All filters are exported when none are given, and toBlock defaults to the latest finalized block.
+// Example:
+//
+//	"<application>/v2/logs/export?evmChainID=1&filter=name&fromBlock=100&toBlock=200"
+func (lac *LogsArchiveController) Export(c *gin.Context) {
+	chain, err := getChain(lac.App.GetRelayers().LegacyEVMChains(), c.Query("evmChainID"))
+	if err != nil {
+		if errors.Is(err, ErrInvalidChainID) || errors.Is(err, ErrMultipleChains) || errors.Is(err, ErrMissingChainID) {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	var fromBlock, toBlock int64
+	if s := c.Query("fromBlock"); s != "" {
+		if fromBlock, err = strconv.ParseInt(s, 10, 64); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, fmt.Errorf("fromBlock is not valid: %w", err))
+			return
+		}
+	}
+	if s := c.Query("toBlock"); s != "" {
+		if toBlock, err = strconv.ParseInt(s, 10, 64); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, fmt.Errorf("toBlock is not valid: %w", err))
+			return
+		}
+	}
+
+	c.Header("Content-Type", "application/gzip")
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="logs-%s.jsonl.gz"`, chain.ID()))
+	if _, err = chain.LogPoller().ExportLogs(c.Request.Context(), c.Writer, c.QueryArray("filter"), fromBlock, toBlock); err != nil {
+		if c.Writer.Written() {
+			// The archive is already partially sent, so the error can only be logged. The archive
+			// is truncated, and rejected on import.
+			lac.App.GetLogger().Errorw("Failed to export logs", "err", err)
+			return
+		}
+		c.Header("Content-Disposition", "")
+		jsonAPIError(c, http.StatusInternalServerError, err)
+	}
+}
+
+// Import saves the logs of registered filters from an archive created by Export, after checking them against the chain.
+// The archive is streamed from the request body, so its size isn't limited by WebServer.HTTPMaxSize.
+// Example:
+//
+//	"<application>/v2/logs/import?evmChainID=1"
+func (lac *LogsArchiveController) Import(c *gin.Context) {
+	chain, err := getChain(lac.App.GetRelayers().LegacyEVMChains(), c.Query("evmChainID"))
+	if err != nil {
+		if errors.Is(err, ErrInvalidChainID) || errors.Is(err, ErrMultipleChains) || errors.Is(err, ErrMissingChainID) {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	summary, err := chain.LogPoller().ImportLogs(c.Request.Context(), c.Request.Body)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	response := LogsArchiveResponse{
+		EVMChainID: big.New(chain.ID()),
+		FromBlock:  summary.FromBlock,
+		ToBlock:    summary.ToBlock,
+		Filters:    summary.Filters,
+		Blocks:     summary.Blocks,
+		Logs:       summary.Logs,
+	}
+	jsonAPIResponse(c, &response, "response")
+}
+
+type LogsArchiveResponse struct {
+	EVMChainID *big.Big `json:"evmChainID"`
+	FromBlock  int64    `json:"fromBlock"`
+	ToBlock    int64    `json:"toBlock"`
+	Filters    []string `json:"filters"`
+	Blocks     int      `json:"blocks"`
+	Logs       int      `json:"logs"`
+}
+
+// GetID returns the jsonapi ID.
+func (s LogsArchiveResponse) GetID() string {
+	return "LogsArchiveResponseID"
+}
+
+// GetName returns the collection name for jsonapi.
+func (LogsArchiveResponse) GetName() string {
+	return "logs_archive_response"
+}
+
+// SetID is used to conform to the UnmarshallIdentifier interface for
+// deserializing from jsonapi documents.
+func (*LogsArchiveResponse) SetID(string) error {
+	return nil
+}
@@ -0,0 +1,95 @@
+package web
+
+import (
+	"crypto/subtle"
+	"errors"
+	"net/http"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	clsessions "github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+const (
+	// oidcStateCookie is the cookie holding the state of the pending OIDC login
+	oidcStateCookie = "oidc_state"
+	// oidcNonceCookie is the cookie holding the nonce of the pending OIDC login
+	oidcNonceCookie = "oidc_nonce"
+	// oidcLoginMaxAge is how long the user has to log in with the issuer, in seconds
+	oidcLoginMaxAge = 10 * 60
+)
+
+// OIDCController manages the OpenID Connect login, when the authentication provider delegates it to an issuer.
+type OIDCController struct {
+	App chainlink.Application
+}
+
+// Login redirects the user to the issuer, keeping the state and nonce of the login in short-lived cookies. Unlike the
+// session cookie, they are SameSite=Lax, so that the browser sends them back with the redirect from the issuer.
+func (oc *OIDCController) Login(c *gin.Context) {
+	provider, ok := oc.App.AuthenticationProvider().(clsessions.RedirectAuthenticationProvider)
+	if !ok {
+		jsonAPIError(c, http.StatusNotFound, errors.New("OIDC authentication is not enabled"))
+		return
+	}
+
+	state, nonce := utils.NewBytes32ID(), utils.NewBytes32ID()
+	authURL, err := provider.AuthCodeURL(c.Request.Context(), state, nonce)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadGateway, err)
+		return
+	}
+
+	oc.setLoginCookies(c, state, nonce, oidcLoginMaxAge)
+	c.Redirect(http.StatusFound, authURL)
+}
+
+// setLoginCookies sets the cookies of the pending login, or deletes them if maxAge is negative.
+func (oc *OIDCController) setLoginCookies(c *gin.Context, state, nonce string, maxAge int) {
+	secure := oc.App.GetConfig().WebServer().SecureCookies()
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(oidcStateCookie, state, maxAge, "/", "", secure, true)
+	c.SetCookie(oidcNonceCookie, nonce, maxAge, "/", "", secure, true)
+}
+
+// Callback creates a session for the user redirected back from the issuer with an authorization code, and returns
+// its ID in the session cookie, as for the credentials login.
+func (oc *OIDCController) Callback(c *gin.Context) {
+	defer oc.App.WakeSessionReaper()
+
+	provider, ok := oc.App.AuthenticationProvider().(clsessions.RedirectAuthenticationProvider)
+	if !ok {
+		jsonAPIError(c, http.StatusNotFound, errors.New("OIDC authentication is not enabled"))
+		return
+	}
+
+	state, _ := c.Cookie(oidcStateCookie)
+	nonce, _ := c.Cookie(oidcNonceCookie)
+	oc.setLoginCookies(c, "", "", -1)
+
+	if errParam := c.Query("error"); errParam != "" {
+		oc.App.GetLogger().Infof("OIDC login failed with issuer error %s: %s", errParam, c.Query("error_description"))
+		jsonAPIError(c, http.StatusUnauthorized, errors.New("unable to log in with OIDC issuer"))
+		return
+	}
+	if state == "" || subtle.ConstantTimeCompare([]byte(state), []byte(c.Query("state"))) != 1 {
+		jsonAPIError(c, http.StatusUnauthorized, errors.New("invalid OIDC login state, please login again"))
+		return
+	}
+
+	sid, err := provider.CreateSessionFromCode(c.Request.Context(), c.Query("code"), nonce)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnauthorized, err)
+		return
+	}
+
+	if err := saveSessionID(sessions.Default(c), sid); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, multierr.Append(errors.New("unable to save session id"), err))
+		return
+	}
+	c.Redirect(http.StatusFound, "/")
+}
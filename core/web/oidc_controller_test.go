@@ -0,0 +1,113 @@
+package web_test
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/oidcauth/oidcauthtest"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+)
+
+func TestOIDCController_Login(t *testing.T) {
+	t.Parallel()
+
+	issuer := oidcauthtest.NewIssuer(t)
+	app := cltest.NewApplicationWithConfig(t, configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.Insecure.DevWebServer = ptr(true)
+		c.WebServer.AuthenticationMethod = ptr(string(sessions.OIDCAuth))
+		c.WebServer.OIDC.Issuer = commonconfig.MustParseURL(issuer.URL)
+		c.WebServer.OIDC.ClientID = ptr(oidcauthtest.ClientID)
+		c.WebServer.OIDC.RedirectURL = commonconfig.MustParseURL("http://localhost:6688/oidc/callback")
+		clientSecret := models.Secret(oidcauthtest.ClientSecret)
+		s.WebServer.OIDC.ClientSecret = &clientSecret
+	}))
+	ctx := testutils.Context(t)
+	require.NoError(t, app.Start(ctx))
+
+	client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }}
+	get := func(u string, cookies ...*http.Cookie) *http.Response {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+		require.NoError(t, err)
+		for _, cookie := range cookies {
+			req.AddCookie(cookie)
+		}
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		t.Cleanup(func() { assert.NoError(t, resp.Body.Close()) })
+		return resp
+	}
+	// login follows the redirects to the issuer and back, and returns the response of the callback
+	login := func() *http.Response {
+		resp := get(app.Server.URL + "/oidc/login")
+		require.Equal(t, http.StatusFound, resp.StatusCode)
+		loginCookies := resp.Cookies()
+		require.Len(t, loginCookies, 2)
+
+		resp = get(resp.Header.Get("Location"))
+		require.Equal(t, http.StatusFound, resp.StatusCode)
+		callback, err := url.Parse(resp.Header.Get("Location"))
+		require.NoError(t, err)
+		return get(app.Server.URL+callback.RequestURI(), loginCookies...)
+	}
+
+	t.Run("login cookies are sent back with the redirect from the issuer", func(t *testing.T) {
+		resp := get(app.Server.URL + "/oidc/login")
+		require.Equal(t, http.StatusFound, resp.StatusCode)
+		assert.Nil(t, web.FindSessionCookie(resp.Cookies()))
+		names := make([]string, 0, 2)
+		for _, cookie := range resp.Cookies() {
+			names = append(names, cookie.Name)
+			assert.Equal(t, http.SameSiteLaxMode, cookie.SameSite, cookie.Name)
+			assert.True(t, cookie.HttpOnly, cookie.Name)
+			assert.Equal(t, 600, cookie.MaxAge, cookie.Name)
+			assert.NotEmpty(t, cookie.Value, cookie.Name)
+		}
+		assert.ElementsMatch(t, []string{"oidc_state", "oidc_nonce"}, names)
+	})
+
+	user := cltest.MustRandomUser(t)
+	issuer.SetUser(user.Email, "NodeRunners")
+	resp := login()
+	require.Equal(t, http.StatusFound, resp.StatusCode)
+	assert.Equal(t, "/", resp.Header.Get("Location"))
+
+	sessionCookie := web.FindSessionCookie(resp.Cookies())
+	require.NotNil(t, sessionCookie)
+	sessionID, err := cltest.DecodeSessionCookie(sessionCookie.Value)
+	require.NoError(t, err)
+	found, err := app.AuthenticationProvider().AuthorizedUserWithSession(ctx, sessionID)
+	require.NoError(t, err)
+	assert.Equal(t, user.Email, found.Email)
+	assert.Equal(t, sessions.UserRoleRun, found.Role)
+
+	for _, cookie := range resp.Cookies() {
+		if cookie.Name == "oidc_state" || cookie.Name == "oidc_nonce" {
+			assert.Negative(t, cookie.MaxAge, "login cookies must be deleted")
+		}
+	}
+
+	resp = get(app.Server.URL+"/v2/features", sessionCookie)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	t.Run("no role groups", func(t *testing.T) {
+		issuer.SetUser(user.Email, "Other")
+		resp := login()
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+
+	t.Run("state mismatch", func(t *testing.T) {
+		resp := get(app.Server.URL + "/oidc/callback?code=code&state=other")
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+}
@@ -0,0 +1,47 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker"
+	"github.com/smartcontractkit/chainlink/v2/evm/utils/big"
+)
+
+// EVMReorgResource is an EVM chain reorg JSONAPI resource.
+type EVMReorgResource struct {
+	JAID
+	EVMChainID           big.Big       `json:"evmChainId"`
+	CommonAncestorHash   common.Hash   `json:"commonAncestorHash"`
+	CommonAncestorNumber int64         `json:"commonAncestorNumber"`
+	Depth                int64         `json:"depth"`
+	PreviousHeadHash     common.Hash   `json:"previousHeadHash"`
+	PreviousHeadNumber   int64         `json:"previousHeadNumber"`
+	NewHeadHash          common.Hash   `json:"newHeadHash"`
+	NewHeadNumber        int64         `json:"newHeadNumber"`
+	OrphanedHashes       []common.Hash `json:"orphanedHashes"`
+	DetectedAt           time.Time     `json:"detectedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r EVMReorgResource) GetName() string {
+	return "evm_reorg"
+}
+
+// NewEVMReorgResource returns a new EVMReorgResource for the reorg.
+func NewEVMReorgResource(reorg headtracker.Reorg) EVMReorgResource {
+	return EVMReorgResource{
+		JAID:                 NewJAIDInt64(reorg.ID),
+		EVMChainID:           reorg.EVMChainID,
+		CommonAncestorHash:   reorg.CommonAncestorHash,
+		CommonAncestorNumber: reorg.CommonAncestorNumber,
+		Depth:                reorg.Depth,
+		PreviousHeadHash:     reorg.PreviousHeadHash,
+		PreviousHeadNumber:   reorg.PreviousHeadNumber,
+		NewHeadHash:          reorg.NewHeadHash,
+		NewHeadNumber:        reorg.NewHeadNumber,
+		OrphanedHashes:       reorg.OrphanedHashes,
+		DetectedAt:           reorg.DetectedAt,
+	}
+}
@@ -3,6 +3,7 @@ package presenters
 import (
 	"time"
 
+	"github.com/google/uuid"
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
@@ -67,6 +68,7 @@ type PipelineTaskRunResource struct {
 	Output     *string           `json:"output"`
 	Error      *string           `json:"error"`
 	DotID      string            `json:"dotId"`
+	Attempts   int               `json:"attempts"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -92,6 +94,7 @@ func NewPipelineTaskRunResource(tr pipeline.TaskRun) PipelineTaskRunResource {
 		Output:     output,
 		Error:      errString,
 		DotID:      tr.GetDotID(),
+		Attempts:   tr.AttemptCount(),
 	}
 }
 
@@ -104,3 +107,106 @@ func NewPipelineRunResources(prs []pipeline.Run, lggr logger.Logger) []PipelineR
 
 	return out
 }
+
+// PipelineSimulationResource is the trace of a simulated pipeline run
+type PipelineSimulationResource struct {
+	JAID
+	Outputs      []*string                          `json:"outputs"`
+	AllErrors    []*string                          `json:"allErrors"`
+	FatalErrors  []*string                          `json:"fatalErrors"`
+	Inputs       jsonserializable.JSONSerializable  `json:"inputs"`
+	TaskRuns     []PipelineSimulatedTaskRunResource `json:"taskRuns"`
+	Transactions []pipeline.SimulatedTx             `json:"transactions"`
+	CreatedAt    time.Time                          `json:"createdAt"`
+	FinishedAt   null.Time                          `json:"finishedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r PipelineSimulationResource) GetName() string {
+	return "pipelineSimulation"
+}
+
+func NewPipelineSimulationResource(pr pipeline.Run, trrs pipeline.TaskRunResults, sim *pipeline.Simulation, lggr logger.Logger) PipelineSimulationResource {
+	lggr = lggr.Named("PipelineSimulationResource")
+	var trs []PipelineSimulatedTaskRunResource
+	for _, trr := range trrs {
+		trs = append(trs, NewPipelineSimulatedTaskRunResource(trr, sim.Inputs(trr.Task.DotID())))
+	}
+
+	outputs, err := pr.StringOutputs()
+	if err != nil {
+		lggr.Errorw(err.Error(), "out", pr.Outputs)
+	}
+
+	return PipelineSimulationResource{
+		JAID:         NewJAID(uuid.New().String()),
+		Outputs:      outputs,
+		AllErrors:    pr.StringAllErrors(),
+		FatalErrors:  pr.StringFatalErrors(),
+		Inputs:       pr.Inputs,
+		TaskRuns:     trs,
+		Transactions: sim.Transactions(),
+		CreatedAt:    pr.CreatedAt,
+		FinishedAt:   pr.FinishedAt,
+	}
+}
+
+// PipelineSimulatedTaskRunResource is the trace of a single task of a simulated run
+type PipelineSimulatedTaskRunResource struct {
+	Type         pipeline.TaskType `json:"type"`
+	DotID        string            `json:"dotId"`
+	Index        int32             `json:"index"`
+	Dependencies []string          `json:"dependencies"`
+	Inputs       []PipelineResult  `json:"inputs"`
+	Output       *string           `json:"output"`
+	Error        *string           `json:"error"`
+	Attempts     uint              `json:"attempts"`
+	CreatedAt    time.Time         `json:"createdAt"`
+	FinishedAt   null.Time         `json:"finishedAt"`
+}
+
+func NewPipelineSimulatedTaskRunResource(trr pipeline.TaskRunResult, inputs []pipeline.Result) PipelineSimulatedTaskRunResource {
+	var deps []string
+	for _, dep := range trr.Task.Inputs() {
+		deps = append(deps, dep.InputTask.DotID())
+	}
+	var results []PipelineResult
+	for _, input := range inputs {
+		results = append(results, NewPipelineResult(input))
+	}
+	result := NewPipelineResult(trr.Result)
+	return PipelineSimulatedTaskRunResource{
+		Type:         trr.Task.Type(),
+		DotID:        trr.Task.DotID(),
+		Index:        trr.Task.OutputIndex(),
+		Dependencies: deps,
+		Inputs:       results,
+		Output:       result.Value,
+		Error:        result.Error,
+		Attempts:     trr.Attempts,
+		CreatedAt:    trr.CreatedAt,
+		FinishedAt:   trr.FinishedAt,
+	}
+}
+
+// PipelineResult is a task result rendered as JSON
+type PipelineResult struct {
+	Value *string `json:"value"`
+	Error *string `json:"error"`
+}
+
+func NewPipelineResult(r pipeline.Result) PipelineResult {
+	var value *string
+	if output := r.OutputDB(); output.Valid {
+		if outputBytes, err := output.MarshalJSON(); err == nil {
+			outputStr := string(outputBytes)
+			value = &outputStr
+		}
+	}
+	var errString *string
+	if r.Error != nil {
+		errStr := r.Error.Error()
+		errString = &errStr
+	}
+	return PipelineResult{Value: value, Error: errString}
+}
@@ -46,3 +46,41 @@ func NewUserResources(users []sessions.User) []UserResource {
 	}
 	return us
 }
+
+// APITokenResource represents a named API token JSONAPI resource. The secret of the token is only set on its creation.
+type APITokenResource struct {
+	JAID
+	Name       string     `json:"name"`
+	AccessKey  string     `json:"accessKey"`
+	Secret     string     `json:"secret,omitempty"`
+	Scopes     []string   `json:"scopes"`
+	ExpiresAt  *time.Time `json:"expiresAt"`
+	LastUsedAt *time.Time `json:"lastUsedAt"`
+	CreatedAt  time.Time  `json:"createdAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r APITokenResource) GetName() string {
+	return "api_tokens"
+}
+
+// NewAPITokenResource constructs a new APITokenResource.
+func NewAPITokenResource(t sessions.APIToken) *APITokenResource {
+	return &APITokenResource{
+		JAID:       NewJAIDInt64(t.ID),
+		Name:       t.Name,
+		AccessKey:  t.TokenKey,
+		Scopes:     t.Scopes.Strings(),
+		ExpiresAt:  t.ExpiresAt.Ptr(),
+		LastUsedAt: t.LastUsedAt.Ptr(),
+		CreatedAt:  t.CreatedAt,
+	}
+}
+
+func NewAPITokenResources(tokens []sessions.APIToken) []APITokenResource {
+	rs := []APITokenResource{}
+	for _, token := range tokens {
+		rs = append(rs, *NewAPITokenResource(token))
+	}
+	return rs
+}
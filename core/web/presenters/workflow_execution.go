@@ -0,0 +1,94 @@
+package presenters
+
+import (
+	"sort"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+// WorkflowExecutionResource represents a workflow execution JSONAPI resource.
+type WorkflowExecutionResource struct {
+	JAID
+	WorkflowID string                          `json:"workflowID"`
+	Status     string                          `json:"status"`
+	CreatedAt  *time.Time                      `json:"createdAt"`
+	UpdatedAt  *time.Time                      `json:"updatedAt"`
+	FinishedAt *time.Time                      `json:"finishedAt"`
+	Steps      []WorkflowExecutionStepResource `json:"steps"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r WorkflowExecutionResource) GetName() string {
+	return "workflowExecutions"
+}
+
+// WorkflowExecutionStepResource represents the state of a single step of a
+// workflow execution.
+type WorkflowExecutionStepResource struct {
+	Ref     string      `json:"ref"`
+	Status  string      `json:"status"`
+	Inputs  interface{} `json:"inputs"`
+	Outputs interface{} `json:"outputs"`
+	Error   *string     `json:"error"`
+	// Attempts is only set for steps that were retried
+	Attempts []store.StepAttempt `json:"attempts,omitempty"`
+}
+
+// NewWorkflowExecutionResource constructs a new WorkflowExecutionResource.
+// Steps are sorted by ref.
+func NewWorkflowExecutionResource(we store.WorkflowExecution) WorkflowExecutionResource {
+	steps := make([]WorkflowExecutionStepResource, 0, len(we.Steps))
+	for _, step := range we.Steps {
+		steps = append(steps, NewWorkflowExecutionStepResource(*step))
+	}
+	sort.Slice(steps, func(i, j int) bool { return steps[i].Ref < steps[j].Ref })
+
+	return WorkflowExecutionResource{
+		JAID:       NewJAID(we.ExecutionID),
+		WorkflowID: we.WorkflowID,
+		Status:     we.Status,
+		CreatedAt:  we.CreatedAt,
+		UpdatedAt:  we.UpdatedAt,
+		FinishedAt: we.FinishedAt,
+		Steps:      steps,
+	}
+}
+
+// NewWorkflowExecutionResources constructs a slice of WorkflowExecutionResources.
+func NewWorkflowExecutionResources(wes []store.WorkflowExecution) []WorkflowExecutionResource {
+	rs := make([]WorkflowExecutionResource, len(wes))
+	for i, we := range wes {
+		rs[i] = NewWorkflowExecutionResource(we)
+	}
+	return rs
+}
+
+// NewWorkflowExecutionStepResource constructs a new WorkflowExecutionStepResource.
+// Values which can't be unwrapped are reported in place of the value.
+func NewWorkflowExecutionStepResource(step store.WorkflowExecutionStep) WorkflowExecutionStepResource {
+	r := WorkflowExecutionStepResource{
+		Ref:      step.Ref,
+		Status:   step.Status,
+		Attempts: step.Attempts,
+	}
+	if step.Inputs != nil {
+		r.Inputs = unwrapValue(step.Inputs)
+	}
+	r.Outputs = unwrapValue(step.Outputs.Value)
+	if step.Outputs.Err != nil {
+		errMsg := step.Outputs.Err.Error()
+		r.Error = &errMsg
+	}
+	return r
+}
+
+func unwrapValue(v values.Value) interface{} {
+	unwrapped, err := values.Unwrap(v)
+	if err != nil {
+		return "error: unable to unwrap value: " + err.Error()
+	}
+	return unwrapped
+}
@@ -1,6 +1,17 @@
 package resolver
 
-import "github.com/smartcontractkit/chainlink/v2/core/auth"
+import (
+	"context"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/utils/stringutils"
+	webauth "github.com/smartcontractkit/chainlink/v2/core/web/auth"
+)
 
 type APITokenResolver struct {
 	token auth.Token
@@ -107,3 +118,181 @@ func NewDeleteAPITokenSuccess(token *auth.Token) *DeleteAPITokenSuccessResolver
 func (r *DeleteAPITokenSuccessResolver) Token() *APITokenResolver {
 	return NewAPIToken(*r.token)
 }
+
+type UserAPITokenResolver struct {
+	token sessions.APIToken
+}
+
+func NewUserAPIToken(token sessions.APIToken) *UserAPITokenResolver {
+	return &UserAPITokenResolver{token}
+}
+
+func NewUserAPITokens(tokens []sessions.APIToken) []*UserAPITokenResolver {
+	var resolvers []*UserAPITokenResolver
+	for _, token := range tokens {
+		resolvers = append(resolvers, NewUserAPIToken(token))
+	}
+
+	return resolvers
+}
+
+func (r *UserAPITokenResolver) ID() graphql.ID {
+	return graphql.ID(stringutils.FromInt64(r.token.ID))
+}
+
+func (r *UserAPITokenResolver) Name() string {
+	return r.token.Name
+}
+
+func (r *UserAPITokenResolver) AccessKey() string {
+	return r.token.TokenKey
+}
+
+func (r *UserAPITokenResolver) Scopes() []string {
+	return r.token.Scopes.Strings()
+}
+
+func (r *UserAPITokenResolver) ExpiresAt() *graphql.Time {
+	return nullTime(r.token.ExpiresAt)
+}
+
+func (r *UserAPITokenResolver) LastUsedAt() *graphql.Time {
+	return nullTime(r.token.LastUsedAt)
+}
+
+func (r *UserAPITokenResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: r.token.CreatedAt}
+}
+
+func nullTime(t null.Time) *graphql.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &graphql.Time{Time: t.Time}
+}
+
+// -- UserAPITokens Query --
+
+type UserAPITokensPayloadResolver struct {
+	tokens []sessions.APIToken
+}
+
+func NewUserAPITokensPayload(tokens []sessions.APIToken) *UserAPITokensPayloadResolver {
+	return &UserAPITokensPayloadResolver{tokens}
+}
+
+func (r *UserAPITokensPayloadResolver) Results() []*UserAPITokenResolver {
+	return NewUserAPITokens(r.tokens)
+}
+
+// -- CreateUserAPIToken Mutation --
+
+type CreateUserAPITokenPayloadResolver struct {
+	token     *auth.Token
+	apiToken  sessions.APIToken
+	inputErrs map[string]string
+}
+
+func NewCreateUserAPITokenPayload(token *auth.Token, apiToken sessions.APIToken, inputErrs map[string]string) *CreateUserAPITokenPayloadResolver {
+	return &CreateUserAPITokenPayloadResolver{token, apiToken, inputErrs}
+}
+
+func (r *CreateUserAPITokenPayloadResolver) ToCreateUserAPITokenSuccess() (*CreateUserAPITokenSuccessResolver, bool) {
+	if r.inputErrs != nil {
+		return nil, false
+	}
+
+	return NewCreateUserAPITokenSuccess(r.token, r.apiToken), true
+}
+
+func (r *CreateUserAPITokenPayloadResolver) ToInputErrors() (*InputErrorsResolver, bool) {
+	if r.inputErrs != nil {
+		var errs []*InputErrorResolver
+
+		for path, message := range r.inputErrs {
+			errs = append(errs, NewInputError(path, message))
+		}
+
+		return NewInputErrors(errs), true
+	}
+
+	return nil, false
+}
+
+type CreateUserAPITokenSuccessResolver struct {
+	token    *auth.Token
+	apiToken sessions.APIToken
+}
+
+func NewCreateUserAPITokenSuccess(token *auth.Token, apiToken sessions.APIToken) *CreateUserAPITokenSuccessResolver {
+	return &CreateUserAPITokenSuccessResolver{token, apiToken}
+}
+
+func (r *CreateUserAPITokenSuccessResolver) UserAPIToken() *UserAPITokenResolver {
+	return NewUserAPIToken(r.apiToken)
+}
+
+func (r *CreateUserAPITokenSuccessResolver) Token() *APITokenResolver {
+	return NewAPIToken(*r.token)
+}
+
+// -- DeleteUserAPIToken Mutation --
+
+type DeleteUserAPITokenPayloadResolver struct {
+	apiToken  *sessions.APIToken
+	inputErrs map[string]string
+	NotFoundErrorUnionType
+}
+
+func NewDeleteUserAPITokenPayload(apiToken *sessions.APIToken, inputErrs map[string]string, err error) *DeleteUserAPITokenPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "API token not found"}
+
+	return &DeleteUserAPITokenPayloadResolver{apiToken: apiToken, inputErrs: inputErrs, NotFoundErrorUnionType: e}
+}
+
+func (r *DeleteUserAPITokenPayloadResolver) ToDeleteUserAPITokenSuccess() (*DeleteUserAPITokenSuccessResolver, bool) {
+	if r.apiToken != nil {
+		return NewDeleteUserAPITokenSuccess(*r.apiToken), true
+	}
+
+	return nil, false
+}
+
+func (r *DeleteUserAPITokenPayloadResolver) ToInputErrors() (*InputErrorsResolver, bool) {
+	if r.inputErrs != nil {
+		var errs []*InputErrorResolver
+
+		for path, message := range r.inputErrs {
+			errs = append(errs, NewInputError(path, message))
+		}
+
+		return NewInputErrors(errs), true
+	}
+
+	return nil, false
+}
+
+type DeleteUserAPITokenSuccessResolver struct {
+	apiToken sessions.APIToken
+}
+
+func NewDeleteUserAPITokenSuccess(apiToken sessions.APIToken) *DeleteUserAPITokenSuccessResolver {
+	return &DeleteUserAPITokenSuccessResolver{apiToken}
+}
+
+func (r *DeleteUserAPITokenSuccessResolver) UserAPIToken() *UserAPITokenResolver {
+	return NewUserAPIToken(r.apiToken)
+}
+
+// apiTokenProvider returns the authentication provider managing the named API tokens, and the user of the session
+func (r *Resolver) apiTokenProvider(ctx context.Context) (sessions.APITokenProvider, *sessions.User, error) {
+	session, ok := webauth.GetGQLAuthenticatedSession(ctx)
+	if !ok {
+		return nil, nil, errors.New("Failed to obtain current user from context")
+	}
+	provider, ok := r.App.AuthenticationProvider().(sessions.APITokenProvider)
+	if !ok {
+		return nil, nil, sessions.ErrNotSupported
+	}
+	return provider, session.User, nil
+}
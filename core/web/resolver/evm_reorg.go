@@ -0,0 +1,98 @@
+package resolver
+
+import (
+	"strconv"
+
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker"
+)
+
+type EVMReorgResolver struct {
+	reorg headtracker.Reorg
+}
+
+func NewEVMReorg(reorg headtracker.Reorg) *EVMReorgResolver {
+	return &EVMReorgResolver{reorg: reorg}
+}
+
+func NewEVMReorgs(reorgs []headtracker.Reorg) []*EVMReorgResolver {
+	var resolvers []*EVMReorgResolver
+	for _, reorg := range reorgs {
+		resolvers = append(resolvers, NewEVMReorg(reorg))
+	}
+
+	return resolvers
+}
+
+func (r *EVMReorgResolver) ID() graphql.ID {
+	return int64GQLID(r.reorg.ID)
+}
+
+func (r *EVMReorgResolver) EVMChainID() graphql.ID {
+	return graphql.ID(r.reorg.EVMChainID.String())
+}
+
+func (r *EVMReorgResolver) CommonAncestorHash() string {
+	return r.reorg.CommonAncestorHash.Hex()
+}
+
+func (r *EVMReorgResolver) CommonAncestorNumber() string {
+	return strconv.FormatInt(r.reorg.CommonAncestorNumber, 10)
+}
+
+// Depth resolves the number of blocks orphaned by the reorg.
+func (r *EVMReorgResolver) Depth() int32 {
+	return int32(r.reorg.Depth)
+}
+
+func (r *EVMReorgResolver) PreviousHeadHash() string {
+	return r.reorg.PreviousHeadHash.Hex()
+}
+
+func (r *EVMReorgResolver) PreviousHeadNumber() string {
+	return strconv.FormatInt(r.reorg.PreviousHeadNumber, 10)
+}
+
+func (r *EVMReorgResolver) NewHeadHash() string {
+	return r.reorg.NewHeadHash.Hex()
+}
+
+func (r *EVMReorgResolver) NewHeadNumber() string {
+	return strconv.FormatInt(r.reorg.NewHeadNumber, 10)
+}
+
+func (r *EVMReorgResolver) OrphanedHashes() []string {
+	hashes := []string{}
+	for _, hash := range r.reorg.OrphanedHashes {
+		hashes = append(hashes, hash.Hex())
+	}
+
+	return hashes
+}
+
+func (r *EVMReorgResolver) DetectedAt() graphql.Time {
+	return graphql.Time{Time: r.reorg.DetectedAt}
+}
+
+// -- EVMReorgs query --
+
+// EVMReorgsPayloadResolver resolves a page of the reorgs of an EVM chain
+type EVMReorgsPayloadResolver struct {
+	reorgs []headtracker.Reorg
+	total  int32
+}
+
+func NewEVMReorgsPayload(reorgs []headtracker.Reorg, total int32) *EVMReorgsPayloadResolver {
+	return &EVMReorgsPayloadResolver{reorgs: reorgs, total: total}
+}
+
+// Results returns the reorgs.
+func (r *EVMReorgsPayloadResolver) Results() []*EVMReorgResolver {
+	return NewEVMReorgs(r.reorgs)
+}
+
+// Metadata returns the pagination metadata.
+func (r *EVMReorgsPayloadResolver) Metadata() *PaginationMetadataResolver {
+	return NewPaginationMetadata(r.total)
+}
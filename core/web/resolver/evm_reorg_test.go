@@ -0,0 +1,90 @@
+package resolver
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/graph-gophers/graphql-go"
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/evm/types"
+	ubig "github.com/smartcontractkit/chainlink/v2/evm/utils/big"
+)
+
+func TestQuery_EVMReorgs(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetEVMReorgs($evmChainID: ID!) {
+			evmReorgs(evmChainID: $evmChainID) {
+				results {
+					id
+					depth
+				}
+				metadata {
+					total
+				}
+			}
+		}`
+
+	invalidID := errors.New("invalid EVM chain ID foo")
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query, variables: map[string]interface{}{"evmChainID": "1"}}, "evmReorgs"),
+		{
+			name:          "invalid chain ID",
+			authenticated: true,
+			query:         query,
+			variables:     map[string]interface{}{"evmChainID": "foo"},
+			result:        `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					Extensions:    nil,
+					ResolverError: invalidID,
+					Path:          []interface{}{"evmReorgs"},
+					Message:       invalidID.Error(),
+				},
+			},
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestEVMReorgsPayloadResolver(t *testing.T) {
+	t.Parallel()
+
+	detectedAt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	orphaned := common.HexToHash("0x02")
+	payload := NewEVMReorgsPayload([]headtracker.Reorg{{
+		ID:                   7,
+		EVMChainID:           *ubig.NewI(1),
+		CommonAncestorHash:   common.HexToHash("0x01"),
+		CommonAncestorNumber: 99,
+		Depth:                1,
+		PreviousHeadHash:     orphaned,
+		PreviousHeadNumber:   100,
+		NewHeadHash:          common.HexToHash("0x03"),
+		NewHeadNumber:        100,
+		OrphanedHashes:       evmtypes.HashArray{orphaned},
+		DetectedAt:           detectedAt,
+	}}, 1)
+
+	assert.Equal(t, int32(1), payload.Metadata().Total())
+	results := payload.Results()
+	require.Len(t, results, 1)
+	reorg := results[0]
+	assert.Equal(t, graphql.ID("7"), reorg.ID())
+	assert.Equal(t, graphql.ID("1"), reorg.EVMChainID())
+	assert.Equal(t, common.HexToHash("0x01").Hex(), reorg.CommonAncestorHash())
+	assert.Equal(t, "99", reorg.CommonAncestorNumber())
+	assert.Equal(t, int32(1), reorg.Depth())
+	assert.Equal(t, "100", reorg.NewHeadNumber())
+	assert.Equal(t, []string{orphaned.Hex()}, reorg.OrphanedHashes())
+	assert.Equal(t, graphql.Time{Time: detectedAt}, reorg.DetectedAt())
+}
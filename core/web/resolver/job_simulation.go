@@ -0,0 +1,222 @@
+package resolver
+
+import (
+	"strconv"
+
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+// -- SimulateJob Mutation --
+
+type SimulateJobPayloadResolver struct {
+	run       *pipeline.Run
+	trrs      pipeline.TaskRunResults
+	sim       *pipeline.Simulation
+	inputErrs map[string]string
+}
+
+func NewSimulateJobPayload(run *pipeline.Run, trrs pipeline.TaskRunResults, sim *pipeline.Simulation, inputErrs map[string]string) *SimulateJobPayloadResolver {
+	return &SimulateJobPayloadResolver{run: run, trrs: trrs, sim: sim, inputErrs: inputErrs}
+}
+
+func (r *SimulateJobPayloadResolver) ToSimulateJobSuccess() (*SimulateJobSuccessResolver, bool) {
+	if r.inputErrs != nil {
+		return nil, false
+	}
+
+	return NewSimulateJobSuccess(r.run, r.trrs, r.sim), true
+}
+
+func (r *SimulateJobPayloadResolver) ToInputErrors() (*InputErrorsResolver, bool) {
+	if r.inputErrs == nil {
+		return nil, false
+	}
+
+	var errs []*InputErrorResolver
+
+	for path, message := range r.inputErrs {
+		errs = append(errs, NewInputError(path, message))
+	}
+
+	return NewInputErrors(errs), true
+}
+
+type SimulateJobSuccessResolver struct {
+	run  *pipeline.Run
+	trrs pipeline.TaskRunResults
+	sim  *pipeline.Simulation
+}
+
+func NewSimulateJobSuccess(run *pipeline.Run, trrs pipeline.TaskRunResults, sim *pipeline.Simulation) *SimulateJobSuccessResolver {
+	return &SimulateJobSuccessResolver{run: run, trrs: trrs, sim: sim}
+}
+
+func (r *SimulateJobSuccessResolver) Outputs() []*string {
+	if !r.run.Outputs.Valid {
+		return []*string{&outputRetrievalErrorStr}
+	}
+
+	outputs, err := r.run.StringOutputs()
+	if err != nil {
+		errMsg := err.Error()
+		return []*string{&errMsg}
+	}
+
+	return outputs
+}
+
+func (r *SimulateJobSuccessResolver) FatalErrors() []string {
+	var errs []string
+
+	for _, err := range r.run.StringFatalErrors() {
+		if err != nil {
+			errs = append(errs, *err)
+		}
+	}
+
+	return errs
+}
+
+func (r *SimulateJobSuccessResolver) AllErrors() []string {
+	var errs []string
+
+	for _, err := range r.run.StringAllErrors() {
+		if err != nil {
+			errs = append(errs, *err)
+		}
+	}
+
+	return errs
+}
+
+func (r *SimulateJobSuccessResolver) TaskRuns() []*SimulatedTaskRunResolver {
+	resolvers := []*SimulatedTaskRunResolver{}
+
+	for _, trr := range r.trrs {
+		resolvers = append(resolvers, NewSimulatedTaskRun(trr, r.sim.Inputs(trr.Task.DotID())))
+	}
+
+	return resolvers
+}
+
+func (r *SimulateJobSuccessResolver) Transactions() []*SimulatedTransactionResolver {
+	resolvers := []*SimulatedTransactionResolver{}
+
+	for _, tx := range r.sim.Transactions() {
+		resolvers = append(resolvers, &SimulatedTransactionResolver{tx: tx})
+	}
+
+	return resolvers
+}
+
+type SimulatedTaskRunResolver struct {
+	trr    pipeline.TaskRunResult
+	inputs []pipeline.Result
+}
+
+func NewSimulatedTaskRun(trr pipeline.TaskRunResult, inputs []pipeline.Result) *SimulatedTaskRunResolver {
+	return &SimulatedTaskRunResolver{trr: trr, inputs: inputs}
+}
+
+func (r *SimulatedTaskRunResolver) DotID() string {
+	return r.trr.Task.DotID()
+}
+
+func (r *SimulatedTaskRunResolver) Type() string {
+	return string(r.trr.Task.Type())
+}
+
+func (r *SimulatedTaskRunResolver) Index() int32 {
+	return r.trr.Task.OutputIndex()
+}
+
+func (r *SimulatedTaskRunResolver) Dependencies() []string {
+	deps := []string{}
+
+	for _, dep := range r.trr.Task.Inputs() {
+		deps = append(deps, dep.InputTask.DotID())
+	}
+
+	return deps
+}
+
+func (r *SimulatedTaskRunResolver) Inputs() []*SimulatedTaskResultResolver {
+	resolvers := []*SimulatedTaskResultResolver{}
+
+	for _, input := range r.inputs {
+		resolvers = append(resolvers, &SimulatedTaskResultResolver{result: input})
+	}
+
+	return resolvers
+}
+
+func (r *SimulatedTaskRunResolver) Output() string {
+	val, err := r.trr.Result.OutputDB().MarshalJSON()
+	if err != nil {
+		return "error: unable to retrieve output"
+	}
+	return string(val)
+}
+
+func (r *SimulatedTaskRunResolver) Error() *string {
+	return r.trr.Result.ErrorDB().Ptr()
+}
+
+func (r *SimulatedTaskRunResolver) Attempts() int32 {
+	return int32(r.trr.Attempts)
+}
+
+func (r *SimulatedTaskRunResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: r.trr.CreatedAt}
+}
+
+func (r *SimulatedTaskRunResolver) FinishedAt() *graphql.Time {
+	return &graphql.Time{Time: r.trr.FinishedAt.ValueOrZero()}
+}
+
+type SimulatedTaskResultResolver struct {
+	result pipeline.Result
+}
+
+func (r *SimulatedTaskResultResolver) Value() *string {
+	output := r.result.OutputDB()
+	if !output.Valid {
+		return nil
+	}
+	val, err := output.MarshalJSON()
+	if err != nil {
+		return nil
+	}
+	s := string(val)
+	return &s
+}
+
+func (r *SimulatedTaskResultResolver) Error() *string {
+	return r.result.ErrorDB().Ptr()
+}
+
+type SimulatedTransactionResolver struct {
+	tx pipeline.SimulatedTx
+}
+
+func (r *SimulatedTransactionResolver) DotID() string {
+	return r.tx.DotID
+}
+
+func (r *SimulatedTransactionResolver) To() string {
+	return r.tx.To.Hex()
+}
+
+func (r *SimulatedTransactionResolver) Data() string {
+	return r.tx.Data.String()
+}
+
+func (r *SimulatedTransactionResolver) GasLimit() *string {
+	if r.tx.GasLimit == nil {
+		return nil
+	}
+	s := strconv.FormatUint(*r.tx.GasLimit, 10)
+	return &s
+}
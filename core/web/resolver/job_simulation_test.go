@@ -0,0 +1,174 @@
+package resolver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestResolver_SimulateJob(t *testing.T) {
+	t.Parallel()
+
+	mutation := `
+		mutation SimulateJob($input: SimulateJobInput!) {
+			simulateJob(input: $input) {
+				... on SimulateJobSuccess {
+					outputs
+					allErrors
+					fatalErrors
+					taskRuns {
+						dotID
+						type
+						index
+						dependencies
+						output
+						error
+						attempts
+					}
+					transactions {
+						dotID
+						to
+						data
+						gasLimit
+					}
+				}
+				... on InputErrors {
+					errors {
+						path
+						message
+						code
+					}
+				}
+			}
+		}`
+	toml := `
+type = "webhook"
+schemaVersion = 1
+observationSource = """
+ds [type=http method=GET url="https://chain.link"]
+"""
+`
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"TOML":  toml,
+			"stubs": `{"ds": {"value": "42"}}`,
+		},
+	}
+
+	outputs := jsonserializable.JSONSerializable{}
+	err := outputs.UnmarshalJSON([]byte(`["42"]`))
+	require.NoError(t, err)
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "simulateJob"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				task := &pipeline.HTTPTask{BaseTask: pipeline.NewBaseTask(0, "ds", nil, nil, 0)}
+				f.App.On("SimulateJobV2", mock.Anything, mock.Anything, (map[string]interface{})(nil), mock.Anything).
+					Return(func(ctx context.Context, spec pipeline.Spec, vars map[string]interface{}, sim *pipeline.Simulation) (*pipeline.Run, pipeline.TaskRunResults, error) {
+						require.Contains(t, spec.DotDagSource, "ds [type=http")
+						require.Equal(t, "42", sim.Stubs["ds"].Value)
+						now := time.Now()
+						return &pipeline.Run{
+							Outputs:     outputs,
+							AllErrors:   pipeline.RunErrors{null.String{}},
+							FatalErrors: pipeline.RunErrors{null.String{}},
+						}, pipeline.TaskRunResults{{
+							Task:       task,
+							Result:     pipeline.Result{Value: "42"},
+							Attempts:   1,
+							CreatedAt:  now,
+							FinishedAt: null.TimeFrom(now),
+						}}, nil
+					})
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"simulateJob": {
+						"outputs": ["42"],
+						"allErrors": [],
+						"fatalErrors": [],
+						"taskRuns": [{
+							"dotID": "ds",
+							"type": "http",
+							"index": 0,
+							"dependencies": [],
+							"output": "\"42\"",
+							"error": null,
+							"attempts": 1
+						}],
+						"transactions": []
+					}
+				}`,
+		},
+		{
+			name:          "invalid TOML",
+			authenticated: true,
+			query:         mutation,
+			variables: map[string]interface{}{
+				"input": map[string]interface{}{
+					"TOML": `type = "webhook"`,
+				},
+			},
+			result: `
+				{
+					"simulateJob": {
+						"errors": [{
+							"path": "TOML spec",
+							"message": "failed to parse TOML: pipeline spec not specified",
+							"code": "INVALID_INPUT"
+						}]
+					}
+				}`,
+		},
+		{
+			name:          "invalid stubs",
+			authenticated: true,
+			query:         mutation,
+			variables: map[string]interface{}{
+				"input": map[string]interface{}{
+					"TOML":  toml,
+					"stubs": `not json`,
+				},
+			},
+			result: `
+				{
+					"simulateJob": {
+						"errors": [{
+							"path": "stubs",
+							"message": "invalid JSON: invalid character 'o' in literal null (expecting 'u')",
+							"code": "INVALID_INPUT"
+						}]
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestSimulatedTransactionResolver(t *testing.T) {
+	gasLimit := uint64(21000)
+	r := &SimulatedTransactionResolver{tx: pipeline.SimulatedTx{
+		DotID:    "submit",
+		To:       common.HexToAddress("0x613a38AC1659769640aaE063C651F48E0250454C"),
+		Data:     []byte{0xde, 0xad},
+		GasLimit: &gasLimit,
+	}}
+	require.Equal(t, "submit", r.DotID())
+	require.Equal(t, "0x613a38AC1659769640aaE063C651F48E0250454C", r.To())
+	require.Equal(t, "0xdead", r.Data())
+	require.Equal(t, "21000", *r.GasLimit())
+}
@@ -38,11 +38,13 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/validate"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocrbootstrap"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/services/standardcapabilities"
 	"github.com/smartcontractkit/chainlink/v2/core/services/streams"
 	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
 	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/crypto"
@@ -689,6 +691,98 @@ func (r *Resolver) DeleteBridge(ctx context.Context, args struct {
 	return NewDeleteBridgePayload(&bt, nil), nil
 }
 
+func (r *Resolver) CreateUserAPIToken(ctx context.Context, args struct {
+	Input struct {
+		Name      string
+		Scopes    []string
+		ExpiresAt *graphql.Time
+		Password  string
+	}
+}) (*CreateUserAPITokenPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	provider, user, err := r.apiTokenProvider(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	scopes, err := sessions.ParseAPITokenScopes(args.Input.Scopes)
+	if err != nil {
+		return NewCreateUserAPITokenPayload(nil, sessions.APIToken{}, map[string]string{
+			"scopes": err.Error(),
+		}), nil
+	}
+
+	err = r.App.AuthenticationProvider().TestPassword(ctx, user.Email, args.Input.Password)
+	if err != nil {
+		r.App.GetAuditLogger().Audit(audit.APITokenCreateAttemptPasswordMismatch, map[string]interface{}{"user": user.Email})
+
+		return NewCreateUserAPITokenPayload(nil, sessions.APIToken{}, map[string]string{
+			"password": "incorrect password",
+		}), nil
+	}
+
+	var expiresAt null.Time
+	if args.Input.ExpiresAt != nil {
+		expiresAt = null.TimeFrom(args.Input.ExpiresAt.Time)
+	}
+	token, apiToken, err := provider.CreateAPIToken(ctx, user.Email, args.Input.Name, scopes, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	r.App.GetAuditLogger().Audit(audit.APITokenCreated, map[string]interface{}{
+		"user":   user.Email,
+		"name":   apiToken.Name,
+		"scopes": apiToken.Scopes.Strings(),
+	})
+	return NewCreateUserAPITokenPayload(token, apiToken, nil), nil
+}
+
+func (r *Resolver) DeleteUserAPIToken(ctx context.Context, args struct {
+	Input struct {
+		ID       graphql.ID
+		Password string
+	}
+}) (*DeleteUserAPITokenPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	id, err := stringutils.ToInt64(string(args.Input.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	provider, user, err := r.apiTokenProvider(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.App.AuthenticationProvider().TestPassword(ctx, user.Email, args.Input.Password)
+	if err != nil {
+		r.App.GetAuditLogger().Audit(audit.APITokenDeleteAttemptPasswordMismatch, map[string]interface{}{"user": user.Email})
+
+		return NewDeleteUserAPITokenPayload(nil, map[string]string{
+			"password": "incorrect password",
+		}, nil), nil
+	}
+
+	apiToken, err := provider.DeleteAPIToken(ctx, user.Email, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewDeleteUserAPITokenPayload(nil, nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	r.App.GetAuditLogger().Audit(audit.APITokenDeleted, map[string]interface{}{"user": user.Email, "name": apiToken.Name})
+	return NewDeleteUserAPITokenPayload(&apiToken, nil, nil), nil
+}
+
 func (r *Resolver) CreateP2PKey(ctx context.Context) (*CreateP2PKeyPayloadResolver, error) {
 	if err := authenticateUserCanEdit(ctx); err != nil {
 		return nil, err
@@ -1235,6 +1329,51 @@ func (r *Resolver) RunJob(ctx context.Context, args struct {
 	return NewRunJobPayload(&plnRun, r.App, nil), nil
 }
 
+func (r *Resolver) SimulateJob(ctx context.Context, args struct {
+	Input struct {
+		TOML  string
+		Vars  *string
+		Stubs *string
+	}
+}) (*SimulateJobPayloadResolver, error) {
+	if err := authenticateUserCanRun(ctx); err != nil {
+		return nil, err
+	}
+
+	spec, err := job.SimulationSpec(args.Input.TOML)
+	if err != nil {
+		return NewSimulateJobPayload(nil, nil, nil, map[string]string{
+			"TOML spec": errors.Wrap(err, "failed to parse TOML").Error(),
+		}), nil
+	}
+
+	var vars map[string]interface{}
+	if args.Input.Vars != nil {
+		if err = json.Unmarshal([]byte(*args.Input.Vars), &vars); err != nil {
+			return NewSimulateJobPayload(nil, nil, nil, map[string]string{
+				"vars": errors.Wrap(err, "invalid JSON").Error(),
+			}), nil
+		}
+	}
+
+	var stubs map[string]pipeline.SimulatedResponse
+	if args.Input.Stubs != nil {
+		if err = json.Unmarshal([]byte(*args.Input.Stubs), &stubs); err != nil {
+			return NewSimulateJobPayload(nil, nil, nil, map[string]string{
+				"stubs": errors.Wrap(err, "invalid JSON").Error(),
+			}), nil
+		}
+	}
+
+	sim := pipeline.NewSimulation(stubs)
+	run, trrs, err := r.App.SimulateJobV2(ctx, spec, vars, sim)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSimulateJobPayload(run, trrs, sim, nil), nil
+}
+
 func (r *Resolver) SetGlobalLogLevel(ctx context.Context, args struct {
 	Level LogLevel
 }) (*SetGlobalLogLevelPayloadResolver, error) {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"math/big"
 	"sort"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -15,9 +16,11 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	"github.com/smartcontractkit/chainlink/v2/core/chains"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/vrfkey"
 	evmrelay "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm"
+	workflowstore "github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/stringutils"
 	"github.com/smartcontractkit/chainlink/v2/core/web/loader"
 )
@@ -565,6 +568,29 @@ func (r *Resolver) EthTransactionsAttempts(ctx context.Context, args struct {
 	return NewEthTransactionsAttemptsPayload(attempts, int32(count)), nil
 }
 
+// EVMReorgs retrieves a page of the reorgs detected by the head tracker of an EVM chain, most recent first.
+func (r *Resolver) EVMReorgs(ctx context.Context, args struct {
+	EVMChainID graphql.ID
+	Offset     *int32
+	Limit      *int32
+}) (*EVMReorgsPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	chainID, ok := new(big.Int).SetString(string(args.EVMChainID), 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid EVM chain ID %s", args.EVMChainID)
+	}
+
+	reorgs, count, err := headtracker.NewORM(*chainID, r.App.GetDB()).Reorgs(ctx, pageOffset(args.Offset), pageLimit(args.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	return NewEVMReorgsPayload(reorgs, int32(count)), nil
+}
+
 func (r *Resolver) GlobalLogLevel(ctx context.Context) (*GlobalLogLevelPayloadResolver, error) {
 	if err := authenticateUser(ctx); err != nil {
 		return nil, err
@@ -638,6 +664,25 @@ func (r *Resolver) TronKeys(ctx context.Context) (*TronKeysPayloadResolver, erro
 	return NewTronKeysPayload(keys), nil
 }
 
+// UserAPITokens retrieves the named API tokens of the current user
+func (r *Resolver) UserAPITokens(ctx context.Context) (*UserAPITokensPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	provider, user, err := r.apiTokenProvider(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := provider.ListAPITokens(ctx, user.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewUserAPITokensPayload(tokens), nil
+}
+
 func (r *Resolver) SQLLogging(ctx context.Context) (*GetSQLLoggingPayloadResolver, error) {
 	if err := authenticateUser(ctx); err != nil {
 		return nil, err
@@ -661,3 +706,48 @@ func (r *Resolver) OCR2KeyBundles(ctx context.Context) (*OCR2KeyBundlesPayloadRe
 
 	return NewOCR2KeyBundlesPayload(ekbs), nil
 }
+
+// WorkflowExecution retrieves a workflow execution along with its steps.
+func (r *Resolver) WorkflowExecution(ctx context.Context, args struct{ ID graphql.ID }) (*WorkflowExecutionPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	execution, err := r.App.WorkflowORM().Get(ctx, string(args.ID))
+	if err != nil {
+		if errors.Is(err, workflowstore.ErrExecutionNotFound) {
+			return NewWorkflowExecutionPayload(nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	return NewWorkflowExecutionPayload(&execution, nil), nil
+}
+
+// WorkflowExecutions retrieves a page of workflow executions, most recent first.
+func (r *Resolver) WorkflowExecutions(ctx context.Context, args struct {
+	WorkflowID *string
+	Statuses   *[]string
+	Offset     *int32
+	Limit      *int32
+}) (*WorkflowExecutionsPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	var filter workflowstore.ExecutionsFilter
+	if args.WorkflowID != nil {
+		filter.WorkflowID = *args.WorkflowID
+	}
+	if args.Statuses != nil {
+		filter.Statuses = *args.Statuses
+	}
+
+	executions, count, err := r.App.WorkflowORM().ListExecutions(ctx, filter, pageOffset(args.Offset), pageLimit(args.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	return NewWorkflowExecutionsPayload(executions, int32(count)), nil
+}
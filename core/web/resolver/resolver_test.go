@@ -24,6 +24,7 @@ import (
 	keystoreMocks "github.com/smartcontractkit/chainlink/v2/core/services/keystore/mocks"
 	pipelineMocks "github.com/smartcontractkit/chainlink/v2/core/services/pipeline/mocks"
 	webhookmocks "github.com/smartcontractkit/chainlink/v2/core/services/webhook/mocks"
+	workflowStoreMocks "github.com/smartcontractkit/chainlink/v2/core/services/workflows/store/mocks"
 	clsessions "github.com/smartcontractkit/chainlink/v2/core/sessions"
 	authProviderMocks "github.com/smartcontractkit/chainlink/v2/core/sessions/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/web/auth"
@@ -62,6 +63,7 @@ type mocks struct {
 	eIMgr                *webhookmocks.ExternalInitiatorManager
 	balM                 *evmMonMocks.BalanceMonitor
 	txmStore             *evmtxmgrmocks.EvmTxStore
+	workflowORM          *workflowStoreMocks.Store
 	auditLogger          *audit.AuditLoggerService
 }
 
@@ -121,6 +123,7 @@ func setupFramework(t *testing.T) *gqlTestFramework {
 		eIMgr:                webhookmocks.NewExternalInitiatorManager(t),
 		balM:                 evmMonMocks.NewBalanceMonitor(t),
 		txmStore:             evmtxmgrmocks.NewEvmTxStore(t),
+		workflowORM:          workflowStoreMocks.NewStore(t),
 		auditLogger:          &audit.AuditLoggerService{},
 	}
 
@@ -56,6 +56,10 @@ func (r *TaskRunResolver) FinishedAt() *graphql.Time {
 	return &graphql.Time{Time: r.tr.FinishedAt.ValueOrZero()}
 }
 
+func (r *TaskRunResolver) Attempts() int32 {
+	return int32(r.tr.AttemptCount())
+}
+
 func (r *TaskRunResolver) DotID() string {
 	return r.tr.GetDotID()
 }
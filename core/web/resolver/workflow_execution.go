@@ -0,0 +1,177 @@
+package resolver
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+type WorkflowExecutionResolver struct {
+	execution store.WorkflowExecution
+}
+
+func NewWorkflowExecution(execution store.WorkflowExecution) *WorkflowExecutionResolver {
+	return &WorkflowExecutionResolver{execution: execution}
+}
+
+func NewWorkflowExecutions(executions []store.WorkflowExecution) []*WorkflowExecutionResolver {
+	var resolvers []*WorkflowExecutionResolver
+	for _, execution := range executions {
+		resolvers = append(resolvers, NewWorkflowExecution(execution))
+	}
+
+	return resolvers
+}
+
+func (r *WorkflowExecutionResolver) ID() graphql.ID {
+	return graphql.ID(r.execution.ExecutionID)
+}
+
+func (r *WorkflowExecutionResolver) WorkflowID() string {
+	return r.execution.WorkflowID
+}
+
+func (r *WorkflowExecutionResolver) Status() string {
+	return r.execution.Status
+}
+
+func (r *WorkflowExecutionResolver) CreatedAt() *graphql.Time {
+	return gqlTime(r.execution.CreatedAt)
+}
+
+func (r *WorkflowExecutionResolver) UpdatedAt() *graphql.Time {
+	return gqlTime(r.execution.UpdatedAt)
+}
+
+func (r *WorkflowExecutionResolver) FinishedAt() *graphql.Time {
+	return gqlTime(r.execution.FinishedAt)
+}
+
+// Steps resolves the steps of the execution, sorted by ref.
+func (r *WorkflowExecutionResolver) Steps() []*WorkflowExecutionStepResolver {
+	resolvers := []*WorkflowExecutionStepResolver{}
+	for _, step := range r.execution.Steps {
+		resolvers = append(resolvers, &WorkflowExecutionStepResolver{step: *step})
+	}
+	sort.Slice(resolvers, func(i, j int) bool { return resolvers[i].step.Ref < resolvers[j].step.Ref })
+
+	return resolvers
+}
+
+func gqlTime(t *time.Time) *graphql.Time {
+	if t == nil {
+		return nil
+	}
+	return &graphql.Time{Time: *t}
+}
+
+type WorkflowExecutionStepResolver struct {
+	step store.WorkflowExecutionStep
+}
+
+func (r *WorkflowExecutionStepResolver) Ref() string {
+	return r.step.Ref
+}
+
+func (r *WorkflowExecutionStepResolver) Status() string {
+	return r.step.Status
+}
+
+// Inputs resolves the inputs of the step as a JSON string.
+func (r *WorkflowExecutionStepResolver) Inputs() *string {
+	if r.step.Inputs == nil {
+		return nil
+	}
+	return valueToJSON(r.step.Inputs)
+}
+
+// Outputs resolves the output value of the step as a JSON string.
+func (r *WorkflowExecutionStepResolver) Outputs() *string {
+	if r.step.Outputs.Value == nil {
+		return nil
+	}
+	return valueToJSON(r.step.Outputs.Value)
+}
+
+func (r *WorkflowExecutionStepResolver) Error() *string {
+	if r.step.Outputs.Err == nil {
+		return nil
+	}
+	errMsg := r.step.Outputs.Err.Error()
+	return &errMsg
+}
+
+// Attempts resolves how many times the step ran.
+func (r *WorkflowExecutionStepResolver) Attempts() int32 {
+	if len(r.step.Attempts) == 0 {
+		return 1
+	}
+	return int32(len(r.step.Attempts))
+}
+
+func valueToJSON(v values.Value) *string {
+	var out string
+	unwrapped, err := values.Unwrap(v)
+	if err == nil {
+		var b []byte
+		if b, err = json.Marshal(unwrapped); err == nil {
+			out = string(b)
+		}
+	}
+	if err != nil {
+		out = "error: unable to retrieve value: " + err.Error()
+	}
+	return &out
+}
+
+// -- WorkflowExecution query --
+
+type WorkflowExecutionPayloadResolver struct {
+	execution *store.WorkflowExecution
+	NotFoundErrorUnionType
+}
+
+func NewWorkflowExecutionPayload(execution *store.WorkflowExecution, err error) *WorkflowExecutionPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "workflow execution not found", isExpectedErrorFn: func(err error) bool {
+		return errors.Is(err, store.ErrExecutionNotFound)
+	}}
+
+	return &WorkflowExecutionPayloadResolver{execution: execution, NotFoundErrorUnionType: e}
+}
+
+func (r *WorkflowExecutionPayloadResolver) ToWorkflowExecution() (*WorkflowExecutionResolver, bool) {
+	if r.err != nil {
+		return nil, false
+	}
+
+	return NewWorkflowExecution(*r.execution), true
+}
+
+// -- WorkflowExecutions query --
+
+// WorkflowExecutionsPayloadResolver resolves a page of workflow executions
+type WorkflowExecutionsPayloadResolver struct {
+	executions []store.WorkflowExecution
+	total      int32
+}
+
+func NewWorkflowExecutionsPayload(executions []store.WorkflowExecution, total int32) *WorkflowExecutionsPayloadResolver {
+	return &WorkflowExecutionsPayloadResolver{executions: executions, total: total}
+}
+
+// Results returns the workflow executions.
+func (r *WorkflowExecutionsPayloadResolver) Results() []*WorkflowExecutionResolver {
+	return NewWorkflowExecutions(r.executions)
+}
+
+// Metadata returns the pagination metadata.
+func (r *WorkflowExecutionsPayloadResolver) Metadata() *PaginationMetadataResolver {
+	return NewPaginationMetadata(r.total)
+}
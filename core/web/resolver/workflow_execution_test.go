@@ -0,0 +1,202 @@
+package resolver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+func TestQuery_PaginatedWorkflowExecutions(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetWorkflowExecutions($workflowID: String, $statuses: [String!]) {
+			workflowExecutions(workflowID: $workflowID, statuses: $statuses) {
+				results {
+					id
+					workflowID
+					status
+					createdAt
+					finishedAt
+				}
+				metadata {
+					total
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"workflowID": "wf-1",
+		"statuses":   []interface{}{"errored", "timeout"},
+	}
+	filter := store.ExecutionsFilter{WorkflowID: "wf-1", Statuses: []string{"errored", "timeout"}}
+
+	gError := errors.New("error")
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query, variables: variables}, "workflowExecutions"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				createdAt := f.Timestamp()
+				f.Mocks.workflowORM.On("ListExecutions", mock.Anything, filter, PageDefaultOffset, PageDefaultLimit).Return([]store.WorkflowExecution{
+					{
+						ExecutionID: "exec-1",
+						WorkflowID:  "wf-1",
+						Status:      store.StatusErrored,
+						CreatedAt:   &createdAt,
+					},
+				}, 1, nil)
+				f.App.On("WorkflowORM").Return(f.Mocks.workflowORM)
+			},
+			query:     query,
+			variables: variables,
+			result: `
+				{
+					"workflowExecutions": {
+						"results": [{
+							"id": "exec-1",
+							"workflowID": "wf-1",
+							"status": "errored",
+							"createdAt": "2021-01-01T00:00:00Z",
+							"finishedAt": null
+						}],
+						"metadata": {
+							"total": 1
+						}
+					}
+				}`,
+		},
+		{
+			name:          "generic error on ListExecutions()",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.Mocks.workflowORM.On("ListExecutions", mock.Anything, filter, PageDefaultOffset, PageDefaultLimit).Return(nil, 0, gError)
+				f.App.On("WorkflowORM").Return(f.Mocks.workflowORM)
+			},
+			query:     query,
+			variables: variables,
+			result:    `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					Extensions:    nil,
+					ResolverError: gError,
+					Path:          []interface{}{"workflowExecutions"},
+					Message:       gError.Error(),
+				},
+			},
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_WorkflowExecution(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetWorkflowExecution($id: ID!) {
+			workflowExecution(id: $id) {
+				... on WorkflowExecution {
+					id
+					status
+					steps {
+						ref
+						status
+						inputs
+						outputs
+						error
+					}
+				}
+				... on NotFoundError {
+					code
+					message
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"id": "exec-1",
+	}
+
+	inputs, err := values.NewMap(map[string]any{"feedID": "0x1234"})
+	require.NoError(t, err)
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query, variables: variables}, "workflowExecution"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.Mocks.workflowORM.On("Get", mock.Anything, "exec-1").Return(store.WorkflowExecution{
+					ExecutionID: "exec-1",
+					Status:      store.StatusErrored,
+					Steps: map[string]*store.WorkflowExecutionStep{
+						"trigger": {
+							Ref:     "trigger",
+							Status:  store.StatusCompleted,
+							Outputs: store.StepOutput{Value: values.NewString("ok")},
+						},
+						"consensus": {
+							Ref:     "consensus",
+							Status:  store.StatusErrored,
+							Inputs:  inputs,
+							Outputs: store.StepOutput{Err: errors.New("not enough observations")},
+						},
+					},
+				}, nil)
+				f.App.On("WorkflowORM").Return(f.Mocks.workflowORM)
+			},
+			query:     query,
+			variables: variables,
+			result: `
+				{
+					"workflowExecution": {
+						"id": "exec-1",
+						"status": "errored",
+						"steps": [{
+							"ref": "consensus",
+							"status": "errored",
+							"inputs": "{\"feedID\":\"0x1234\"}",
+							"outputs": null,
+							"error": "not enough observations"
+						}, {
+							"ref": "trigger",
+							"status": "completed",
+							"inputs": null,
+							"outputs": "\"ok\"",
+							"error": null
+						}]
+					}
+				}`,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.Mocks.workflowORM.On("Get", mock.Anything, "exec-1").
+					Return(store.WorkflowExecution{}, fmt.Errorf("could not find workflow execution with id exec-1: %w", store.ErrExecutionNotFound))
+				f.App.On("WorkflowORM").Return(f.Mocks.workflowORM)
+			},
+			query:     query,
+			variables: variables,
+			result: `
+				{
+					"workflowExecution": {
+						"code": "NOT_FOUND",
+						"message": "workflow execution not found"
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
@@ -12,6 +12,7 @@ import (
 	"net/url"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"strings"
 	"time"
 
@@ -64,7 +65,7 @@ func NewRouter(app chainlink.Application, prometheus *ginprom.Prometheus) (*gin.
 	engine.Use(
 		otelgin.Middleware("chainlink-web-routes",
 			otelgin.WithTracerProvider(otel.GetTracerProvider())),
-		limits.RequestSizeLimiter(config.WebServer().HTTPMaxSize()),
+		requestSizeLimiter(config.WebServer().HTTPMaxSize()),
 		loggerFunc(app.GetLogger()),
 		gin.Recovery(),
 		cors,
@@ -138,6 +139,25 @@ func rateLimiter(period time.Duration, limit int64) gin.HandlerFunc {
 	return mgin.NewMiddleware(limiter.New(store, rate))
 }
 
+// unlimitedRequestSizeRoutes stream their request body, so it isn't limited to
+// WebServer.HTTPMaxSize.
+var unlimitedRequestSizeRoutes = []string{
+	"/v2/logs/import",
+}
+
+// requestSizeLimiter limits the size of request bodies to limit, except for the
+// unlimitedRequestSizeRoutes
+func requestSizeLimiter(limit int64) gin.HandlerFunc {
+	limiter := limits.RequestSizeLimiter(limit)
+	return func(c *gin.Context) {
+		if slices.Contains(unlimitedRequestSizeRoutes, c.FullPath()) {
+			c.Next()
+			return
+		}
+		limiter(c)
+	}
+}
+
 // secureOptions configure security options for the secure middleware, mostly
 // for TLS redirection
 func secureOptions(tlsRedirect bool, tlsHost string, devWebServer bool) secure.Options {
@@ -211,6 +231,9 @@ func sessionRoutes(app chainlink.Application, r *gin.RouterGroup) {
 	))
 	sc := NewSessionsController(app)
 	unauth.POST("/sessions", sc.Create)
+	oc := OIDCController{app}
+	unauth.GET("/oidc/login", oc.Login)
+	unauth.GET("/oidc/callback", oc.Callback)
 	auth := r.Group("/", auth.Authenticate(app.AuthenticationProvider(), auth.AuthenticateBySession))
 	auth.DELETE("/sessions", sc.Destroy)
 }
@@ -251,6 +274,11 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.POST("/user/token", uc.NewAPIToken)
 		authv2.POST("/user/token/delete", uc.DeleteAPIToken)
 
+		atc := APITokensController{app}
+		authv2.GET("/user/tokens", atc.Index)
+		authv2.POST("/user/tokens", atc.Create)
+		authv2.POST("/user/tokens/:tokenID/delete", atc.Destroy)
+
 		wa := NewWebAuthnController(app)
 		authv2.GET("/enroll_webauthn", wa.BeginRegistration)
 		authv2.POST("/enroll_webauthn", wa.FinishRegistration)
@@ -286,6 +314,8 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		txs := TransactionsController{app}
 		authv2.GET("/transactions/evm", paginatedRequest(txs.Index))
 		authv2.GET("/transactions/evm/:TxHash", txs.Show)
+		authv2.POST("/transactions/evm/:ID/cancel", auth.RequiresAdminRole(txs.Cancel))
+		authv2.POST("/transactions/evm/:ID/speedup", auth.RequiresAdminRole(txs.SpeedUp))
 		authv2.GET("/transactions", paginatedRequest(txs.Index))
 		authv2.GET("/transactions/:TxHash", txs.Show)
 
@@ -293,6 +323,12 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.POST("/replay_from_block/:number", auth.RequiresRunRole(rc.ReplayFromBlock))
 		lcaC := LCAController{app}
 		authv2.GET("/find_lca", auth.RequiresRunRole(lcaC.FindLCA))
+		lac := LogsArchiveController{app}
+		authv2.GET("/logs/export", auth.RequiresRunRole(lac.Export))
+		authv2.POST("/logs/import", auth.RequiresAdminRole(lac.Import))
+
+		ksc := KeystoreController{app}
+		authv2.POST("/keystore/password", auth.RequiresAdminRole(ksc.RotatePassword))
 
 		csakc := CSAKeysController{app}
 		authv2.GET("/keys/csa", csakc.Index)
@@ -371,6 +407,7 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.GET("/jobs", paginatedRequest(jc.Index))
 		authv2.GET("/jobs/:ID", jc.Show)
 		authv2.POST("/jobs", auth.RequiresEditRole(jc.Create))
+		authv2.POST("/jobs/simulate", auth.RequiresRunRole(jc.Simulate))
 		authv2.PUT("/jobs/:ID", auth.RequiresEditRole(jc.Update))
 		authv2.DELETE("/jobs/:ID", auth.RequiresEditRole(jc.Delete))
 
@@ -379,6 +416,11 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.GET("/jobs/:ID/runs", paginatedRequest(prc.Index))
 		authv2.GET("/jobs/:ID/runs/:runID", prc.Show)
 
+		wec := WorkflowExecutionsController{app}
+		authv2.GET("/workflows/executions", paginatedRequest(wec.Index))
+		authv2.GET("/workflows/executions/:ID", wec.Show)
+		authv2.POST("/workflows/executions/:ID/replay", auth.RequiresAdminRole(wec.Replay))
+
 		// FeaturesController
 		fc := FeaturesController{app}
 		authv2.GET("/features", fc.Index)
@@ -409,6 +451,9 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		nodes.GET("/:network", paginatedRequest(nodesController.Index))
 		chains.GET("/:network/:ID/nodes", paginatedRequest(nodesController.Index))
 
+		erc := EVMReorgsController{app}
+		chains.GET("/:network/:ID/reorgs", paginatedRequest(erc.Index))
+
 		efc := EVMForwardersController{app}
 		authv2.GET("/nodes/evm/forwarders", paginatedRequest(efc.Index))
 		authv2.POST("/nodes/evm/forwarders/track", auth.RequiresEditRole(efc.Track))
@@ -0,0 +1,106 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// WorkflowExecutionsController displays the execution history of workflows.
+type WorkflowExecutionsController struct {
+	App chainlink.Application
+}
+
+// Index returns a page of workflow executions, most recent first. The steps
+// of the executions are not included.
+// Example:
+//
+//	"GET <application>/workflows/executions?workflowID=<id>&status=errored,timeout"
+func (wec *WorkflowExecutionsController) Index(c *gin.Context, size, page, offset int) {
+	filter := store.ExecutionsFilter{WorkflowID: c.Query("workflowID")}
+	for _, status := range c.QueryArray("status") {
+		for _, s := range strings.Split(status, ",") {
+			if s = strings.TrimSpace(s); s == "" {
+				continue
+			}
+			if !store.ValidStatuses[s] {
+				jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("invalid status "+s))
+				return
+			}
+			filter.Statuses = append(filter.Statuses, s)
+		}
+	}
+
+	executions, count, err := wec.App.WorkflowORM().ListExecutions(c, filter, offset, size)
+	paginatedResponse(c, "workflowExecutions", size, page, presenters.NewWorkflowExecutionResources(executions), count, err)
+}
+
+// Show returns a workflow execution along with the inputs, outputs and errors of its steps.
+// Example:
+//
+//	"GET <application>/workflows/executions/:ID"
+func (wec *WorkflowExecutionsController) Show(c *gin.Context) {
+	execution, err := wec.App.WorkflowORM().Get(c, c.Param("ID"))
+	if errors.Is(err, store.ErrExecutionNotFound) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("workflow execution not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewWorkflowExecutionResource(execution), "workflowExecution")
+}
+
+// ReplayWorkflowExecutionRequest is the body of a request replaying a workflow execution.
+type ReplayWorkflowExecutionRequest struct {
+	StepRef string `json:"stepRef"`
+}
+
+// Replay re-runs a failed workflow execution from the given step, reusing the
+// outputs of the steps it depends on.
+// Example:
+//
+//	"POST <application>/workflows/executions/:ID/replay"
+func (wec *WorkflowExecutionsController) Replay(c *gin.Context) {
+	var request ReplayWorkflowExecutionRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if request.StepRef == "" {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("stepRef is required"))
+		return
+	}
+
+	err := wec.App.WorkflowReplayer().Replay(c, c.Param("ID"), request.StepRef)
+	switch {
+	case errors.Is(err, store.ErrExecutionNotFound):
+		jsonAPIError(c, http.StatusNotFound, errors.New("workflow execution not found"))
+		return
+	case errors.Is(err, workflows.ErrInvalidReplay):
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	case errors.Is(err, workflows.ErrEngineNotRunning), errors.Is(err, store.ErrExecutionNotReplayable):
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	case err != nil:
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	execution, err := wec.App.WorkflowORM().Get(c, c.Param("ID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewWorkflowExecutionResource(execution), "workflowExecution")
+}
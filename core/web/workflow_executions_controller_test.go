@@ -0,0 +1,132 @@
+package web_test
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func setupWorkflowExecutionsControllerTest(t *testing.T) (cltest.HTTPClientCleaner, []string) {
+	t.Helper()
+
+	app := cltest.NewApplication(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	inputs, err := values.NewMap(map[string]any{"feedID": "0x1234"})
+	require.NoError(t, err)
+
+	var ids []string
+	for _, status := range []string{store.StatusCompleted, store.StatusErrored} {
+		id := uuid.NewString()
+		_, err = app.WorkflowORM().Add(testutils.Context(t), &store.WorkflowExecution{
+			ExecutionID: id,
+			Status:      status,
+			Steps: map[string]*store.WorkflowExecutionStep{
+				"trigger": {
+					ExecutionID: id,
+					Ref:         "trigger",
+					Status:      store.StatusCompleted,
+					Inputs:      inputs,
+					Outputs:     store.StepOutput{Value: values.NewString("ok")},
+				},
+				"consensus": {
+					ExecutionID: id,
+					Ref:         "consensus",
+					Status:      status,
+					Outputs:     store.StepOutput{Err: errors.New("not enough observations")},
+				},
+			},
+		})
+		require.NoError(t, err)
+		ids = append(ids, id)
+	}
+
+	return app.NewHTTPClient(nil), ids
+}
+
+func TestWorkflowExecutionsController_Index(t *testing.T) {
+	t.Parallel()
+
+	client, ids := setupWorkflowExecutionsControllerTest(t)
+
+	resp, cleanup := client.Get("/v2/workflows/executions?size=10")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var links jsonapi.Links
+	var executions []presenters.WorkflowExecutionResource
+	require.NoError(t, web.ParsePaginatedResponse(cltest.ParseResponseBody(t, resp), &executions, &links))
+	assert.Len(t, executions, 2)
+
+	resp, cleanup = client.Get("/v2/workflows/executions?status=errored")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	executions = nil
+	require.NoError(t, web.ParsePaginatedResponse(cltest.ParseResponseBody(t, resp), &executions, &links))
+	require.Len(t, executions, 1)
+	assert.Equal(t, ids[1], executions[0].ID)
+	assert.Equal(t, store.StatusErrored, executions[0].Status)
+
+	resp, cleanup = client.Get("/v2/workflows/executions?status=bogus")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+}
+
+func TestWorkflowExecutionsController_Show(t *testing.T) {
+	t.Parallel()
+
+	client, ids := setupWorkflowExecutionsControllerTest(t)
+
+	resp, cleanup := client.Get("/v2/workflows/executions/" + ids[1])
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var execution presenters.WorkflowExecutionResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &execution))
+	assert.Equal(t, ids[1], execution.ID)
+	require.Len(t, execution.Steps, 2)
+	assert.Equal(t, "consensus", execution.Steps[0].Ref)
+	require.NotNil(t, execution.Steps[0].Error)
+	assert.Equal(t, "not enough observations", *execution.Steps[0].Error)
+	assert.Equal(t, "trigger", execution.Steps[1].Ref)
+	assert.Equal(t, map[string]any{"feedID": "0x1234"}, execution.Steps[1].Inputs)
+	assert.Equal(t, "ok", execution.Steps[1].Outputs)
+
+	resp, cleanup = client.Get("/v2/workflows/executions/missing")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}
+
+func TestWorkflowExecutionsController_Replay(t *testing.T) {
+	t.Parallel()
+
+	client, ids := setupWorkflowExecutionsControllerTest(t)
+
+	resp, cleanup := client.Post("/v2/workflows/executions/"+ids[1]+"/replay", bytes.NewBufferString(`{}`))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+	resp, cleanup = client.Post("/v2/workflows/executions/missing/replay", bytes.NewBufferString(`{"stepRef":"consensus"}`))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+
+	// the executions don't belong to a running workflow
+	resp, cleanup = client.Post("/v2/workflows/executions/"+ids[1]+"/replay", bytes.NewBufferString(`{"stepRef":"consensus"}`))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusConflict)
+}
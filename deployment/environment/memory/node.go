@@ -196,7 +196,7 @@ func NewNode(
 	ctx := tests.Context(t)
 	require.NoError(t, master.Unlock(ctx, "password"))
 	require.NoError(t, master.CSA().EnsureKey(ctx))
-	beholderAuthHeaders, csaPubKeyHex, err := keystore.BuildBeholderAuth(master)
+	beholderAuthHeaders, csaPubKeyHex, err := keystore.BuildBeholderAuth(ctx, master)
 	require.NoError(t, err)
 
 	loopRegistry := plugins.NewLoopRegistry(lggr.Named("LoopRegistry"), cfg.Database(), cfg.Tracing(), cfg.Telemetry(), beholderAuthHeaders, csaPubKeyHex)
@@ -1,7 +1,11 @@
 package config
 
 import (
+	"net/url"
+
+	"github.com/smartcontractkit/chainlink/v2/evm/assets"
 	"github.com/smartcontractkit/chainlink/v2/evm/config/toml"
+	"github.com/smartcontractkit/chainlink/v2/evm/types"
 )
 
 type balanceMonitorConfig struct {
@@ -11,3 +15,23 @@ type balanceMonitorConfig struct {
 func (b *balanceMonitorConfig) Enabled() bool {
 	return *b.c.Enabled
 }
+
+func (b *balanceMonitorConfig) AlertThreshold() *assets.Wei {
+	return b.c.AlertThreshold
+}
+
+func (b *balanceMonitorConfig) AlertWebhookURL() *url.URL {
+	return b.c.AlertWebhookURL.URL()
+}
+
+func (b *balanceMonitorConfig) FunderAddress() *types.EIP55Address {
+	return b.c.FunderAddress
+}
+
+func (b *balanceMonitorConfig) TopUpThreshold() *assets.Wei {
+	return b.c.TopUpThreshold
+}
+
+func (b *balanceMonitorConfig) TopUpTarget() *assets.Wei {
+	return b.c.TopUpTarget
+}
@@ -43,6 +43,10 @@ func (g *gasEstimatorConfig) FeeHistory() FeeHistory {
 	return &feeHistoryConfig{c: g.c.FeeHistory}
 }
 
+func (g *gasEstimatorConfig) Composite() Composite {
+	return &compositeConfig{c: g.c.Composite}
+}
+
 func (g *gasEstimatorConfig) DAOracle() DAOracle {
 	return &daOracleConfig{c: g.c.DAOracle}
 }
@@ -99,6 +103,10 @@ func (g *gasEstimatorConfig) PriceMin() *assets.Wei {
 	return g.c.PriceMin
 }
 
+func (g *gasEstimatorConfig) BlobPriceMax() *assets.Wei {
+	return g.c.BlobPriceMax
+}
+
 func (g *gasEstimatorConfig) PriceMax() *assets.Wei {
 	return g.c.PriceMax
 }
@@ -216,3 +224,30 @@ type feeHistoryConfig struct {
 func (u *feeHistoryConfig) CacheTimeout() time.Duration {
 	return u.c.CacheTimeout.Duration()
 }
+
+type compositeConfig struct {
+	c toml.CompositeEstimator
+}
+
+func (c *compositeConfig) Estimators() []string {
+	return c.c.Estimators
+}
+
+func (c *compositeConfig) Policy() string {
+	if c.c.Policy == nil {
+		return ""
+	}
+	return *c.c.Policy
+}
+
+// OutlierPercent returns 0, disabling outlier detection, if unset.
+func (c *compositeConfig) OutlierPercent() uint16 {
+	if c.c.OutlierPercent == nil {
+		return 0
+	}
+	return *c.c.OutlierPercent
+}
+
+func (c *compositeConfig) Weights() []uint32 {
+	return c.c.Weights
+}
@@ -4,6 +4,8 @@ import (
 	"net/url"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+
 	"github.com/smartcontractkit/chainlink/v2/evm/config/toml"
 )
 
@@ -64,6 +66,40 @@ func (t *transactionManagerV2Config) DualBroadcast() *bool {
 	return t.c.DualBroadcast
 }
 
+func (t *transactionsConfig) AccountAbstraction() AccountAbstraction {
+	return &accountAbstractionConfig{c: t.c.AccountAbstraction}
+}
+
+type accountAbstractionConfig struct {
+	c toml.AccountAbstractionConfig
+}
+
+func (a *accountAbstractionConfig) Enabled() bool {
+	return a.c.Enabled != nil && *a.c.Enabled
+}
+
+func (a *accountAbstractionConfig) BundlerURL() *url.URL {
+	return a.c.BundlerURL.URL()
+}
+
+func (a *accountAbstractionConfig) PaymasterURL() *url.URL {
+	return a.c.PaymasterURL.URL()
+}
+
+func (a *accountAbstractionConfig) EntryPoint() common.Address {
+	if a.c.EntryPoint == nil {
+		return common.Address{}
+	}
+	return a.c.EntryPoint.Address()
+}
+
+func (a *accountAbstractionConfig) AccountFactory() common.Address {
+	if a.c.AccountFactory == nil {
+		return common.Address{}
+	}
+	return a.c.AccountFactory.Address()
+}
+
 func (t *transactionsConfig) AutoPurge() AutoPurgeConfig {
 	return &autoPurgeConfig{c: t.c.AutoPurge}
 }
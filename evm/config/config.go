@@ -82,6 +82,11 @@ type HeadTracker interface {
 
 type BalanceMonitor interface {
 	Enabled() bool
+	AlertThreshold() *assets.Wei
+	AlertWebhookURL() *url.URL
+	FunderAddress() *types.EIP55Address
+	TopUpThreshold() *assets.Wei
+	TopUpTarget() *assets.Wei
 }
 
 type ClientErrors interface {
@@ -112,6 +117,7 @@ type Transactions interface {
 	MaxQueued() uint64
 	AutoPurge() AutoPurgeConfig
 	TransactionManagerV2() TransactionManagerV2
+	AccountAbstraction() AccountAbstraction
 }
 
 type AutoPurgeConfig interface {
@@ -128,9 +134,18 @@ type TransactionManagerV2 interface {
 	DualBroadcast() *bool
 }
 
+type AccountAbstraction interface {
+	Enabled() bool
+	BundlerURL() *url.URL
+	PaymasterURL() *url.URL
+	EntryPoint() gethcommon.Address
+	AccountFactory() gethcommon.Address
+}
+
 type GasEstimator interface {
 	BlockHistory() BlockHistory
 	FeeHistory() FeeHistory
+	Composite() Composite
 	LimitJobType() LimitJobType
 
 	EIP1559DynamicFees() bool
@@ -148,6 +163,7 @@ type GasEstimator interface {
 	TipCapMin() *assets.Wei
 	PriceMax() *assets.Wei
 	PriceMin() *assets.Wei
+	BlobPriceMax() *assets.Wei
 	Mode() string
 	PriceMaxKey(gethcommon.Address) *assets.Wei
 	EstimateLimit() bool
@@ -183,6 +199,13 @@ type FeeHistory interface {
 	CacheTimeout() time.Duration
 }
 
+type Composite interface {
+	Estimators() []string
+	Policy() string
+	OutlierPercent() uint16
+	Weights() []uint32
+}
+
 type Workflow interface {
 	FromAddress() *types.EIP55Address
 	ForwarderAddress() *types.EIP55Address
@@ -24,6 +24,53 @@ func (_m *GasEstimator) EXPECT() *GasEstimator_Expecter {
 	return &GasEstimator_Expecter{mock: &_m.Mock}
 }
 
+// BlobPriceMax provides a mock function with no fields
+func (_m *GasEstimator) BlobPriceMax() *assets.Wei {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BlobPriceMax")
+	}
+
+	var r0 *assets.Wei
+	if rf, ok := ret.Get(0).(func() *assets.Wei); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*assets.Wei)
+		}
+	}
+
+	return r0
+}
+
+// GasEstimator_BlobPriceMax_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BlobPriceMax'
+type GasEstimator_BlobPriceMax_Call struct {
+	*mock.Call
+}
+
+// BlobPriceMax is a helper method to define mock.On call
+func (_e *GasEstimator_Expecter) BlobPriceMax() *GasEstimator_BlobPriceMax_Call {
+	return &GasEstimator_BlobPriceMax_Call{Call: _e.mock.On("BlobPriceMax")}
+}
+
+func (_c *GasEstimator_BlobPriceMax_Call) Run(run func()) *GasEstimator_BlobPriceMax_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GasEstimator_BlobPriceMax_Call) Return(_a0 *assets.Wei) *GasEstimator_BlobPriceMax_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GasEstimator_BlobPriceMax_Call) RunAndReturn(run func() *assets.Wei) *GasEstimator_BlobPriceMax_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // BlockHistory provides a mock function with no fields
 func (_m *GasEstimator) BlockHistory() config.BlockHistory {
 	ret := _m.Called()
@@ -253,6 +300,53 @@ func (_c *GasEstimator_BumpTxDepth_Call) RunAndReturn(run func() uint32) *GasEst
 	return _c
 }
 
+// Composite provides a mock function with no fields
+func (_m *GasEstimator) Composite() config.Composite {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Composite")
+	}
+
+	var r0 config.Composite
+	if rf, ok := ret.Get(0).(func() config.Composite); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.Composite)
+		}
+	}
+
+	return r0
+}
+
+// GasEstimator_Composite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Composite'
+type GasEstimator_Composite_Call struct {
+	*mock.Call
+}
+
+// Composite is a helper method to define mock.On call
+func (_e *GasEstimator_Expecter) Composite() *GasEstimator_Composite_Call {
+	return &GasEstimator_Composite_Call{Call: _e.mock.On("Composite")}
+}
+
+func (_c *GasEstimator_Composite_Call) Run(run func()) *GasEstimator_Composite_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GasEstimator_Composite_Call) Return(_a0 config.Composite) *GasEstimator_Composite_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GasEstimator_Composite_Call) RunAndReturn(run func() config.Composite) *GasEstimator_Composite_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DAOracle provides a mock function with no fields
 func (_m *GasEstimator) DAOracle() config.DAOracle {
 	ret := _m.Called()
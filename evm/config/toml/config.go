@@ -504,6 +504,7 @@ type Transactions struct {
 
 	AutoPurge            AutoPurgeConfig            `toml:",omitempty"`
 	TransactionManagerV2 TransactionManagerV2Config `toml:",omitempty"`
+	AccountAbstraction   AccountAbstractionConfig   `toml:",omitempty"`
 }
 
 func (t *Transactions) setFrom(f *Transactions) {
@@ -530,6 +531,7 @@ func (t *Transactions) setFrom(f *Transactions) {
 	}
 	t.AutoPurge.setFrom(&f.AutoPurge)
 	t.TransactionManagerV2.setFrom(&f.TransactionManagerV2)
+	t.AccountAbstraction.setFrom(&f.AccountAbstraction)
 }
 
 type AutoPurgeConfig struct {
@@ -589,6 +591,48 @@ func (t *TransactionManagerV2Config) ValidateConfig() (err error) {
 	return
 }
 
+type AccountAbstractionConfig struct {
+	Enabled        *bool               `toml:",omitempty"`
+	BundlerURL     *commonconfig.URL   `toml:",omitempty"`
+	PaymasterURL   *commonconfig.URL   `toml:",omitempty"`
+	EntryPoint     *types.EIP55Address `toml:",omitempty"`
+	AccountFactory *types.EIP55Address `toml:",omitempty"`
+}
+
+func (a *AccountAbstractionConfig) setFrom(f *AccountAbstractionConfig) {
+	if v := f.Enabled; v != nil {
+		a.Enabled = v
+	}
+	if v := f.BundlerURL; v != nil {
+		a.BundlerURL = v
+	}
+	if v := f.PaymasterURL; v != nil {
+		a.PaymasterURL = v
+	}
+	if v := f.EntryPoint; v != nil {
+		a.EntryPoint = v
+	}
+	if v := f.AccountFactory; v != nil {
+		a.AccountFactory = v
+	}
+}
+
+func (a *AccountAbstractionConfig) ValidateConfig() (err error) {
+	if a.Enabled == nil || !*a.Enabled {
+		return
+	}
+	if a.BundlerURL == nil {
+		err = multierr.Append(err, commonconfig.ErrMissing{Name: "BundlerURL", Msg: "must be set if AccountAbstraction is enabled"})
+	}
+	if a.EntryPoint == nil {
+		err = multierr.Append(err, commonconfig.ErrMissing{Name: "EntryPoint", Msg: "must be set if AccountAbstraction is enabled"})
+	}
+	if a.AccountFactory == nil {
+		err = multierr.Append(err, commonconfig.ErrMissing{Name: "AccountFactory", Msg: "must be set if AccountAbstraction is enabled"})
+	}
+	return
+}
+
 type OCR2 struct {
 	Automation Automation `toml:",omitempty"`
 }
@@ -627,13 +671,57 @@ func (m *Workflow) setFrom(f *Workflow) {
 }
 
 type BalanceMonitor struct {
-	Enabled *bool
+	Enabled         *bool
+	AlertThreshold  *assets.Wei         `toml:",omitempty"`
+	AlertWebhookURL *commonconfig.URL   `toml:",omitempty"`
+	FunderAddress   *types.EIP55Address `toml:",omitempty"`
+	TopUpThreshold  *assets.Wei         `toml:",omitempty"`
+	TopUpTarget     *assets.Wei         `toml:",omitempty"`
 }
 
 func (m *BalanceMonitor) setFrom(f *BalanceMonitor) {
 	if v := f.Enabled; v != nil {
 		m.Enabled = v
 	}
+	if v := f.AlertThreshold; v != nil {
+		m.AlertThreshold = v
+	}
+	if v := f.AlertWebhookURL; v != nil {
+		m.AlertWebhookURL = v
+	}
+	if v := f.FunderAddress; v != nil {
+		m.FunderAddress = v
+	}
+	if v := f.TopUpThreshold; v != nil {
+		m.TopUpThreshold = v
+	}
+	if v := f.TopUpTarget; v != nil {
+		m.TopUpTarget = v
+	}
+}
+
+func (m *BalanceMonitor) ValidateConfig() (err error) {
+	if m.AlertWebhookURL != nil {
+		if m.AlertThreshold == nil {
+			err = multierr.Append(err, commonconfig.ErrMissing{Name: "AlertThreshold", Msg: "must be set if AlertWebhookURL is set"})
+		}
+		switch m.AlertWebhookURL.Scheme {
+		case "http", "https":
+		default:
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: "AlertWebhookURL", Value: m.AlertWebhookURL.Scheme, Msg: "must be http or https"})
+		}
+	}
+	if m.FunderAddress != nil {
+		if m.TopUpThreshold == nil {
+			err = multierr.Append(err, commonconfig.ErrMissing{Name: "TopUpThreshold", Msg: "must be set if FunderAddress is set"})
+		}
+		if m.TopUpTarget == nil {
+			err = multierr.Append(err, commonconfig.ErrMissing{Name: "TopUpTarget", Msg: "must be set if FunderAddress is set"})
+		} else if m.TopUpThreshold != nil && m.TopUpTarget.Cmp(m.TopUpThreshold) <= 0 {
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: "TopUpTarget", Value: m.TopUpTarget, Msg: "must be greater than TopUpThreshold"})
+		}
+	}
+	return
 }
 
 type GasEstimator struct {
@@ -642,6 +730,7 @@ type GasEstimator struct {
 	PriceDefault *assets.Wei
 	PriceMax     *assets.Wei
 	PriceMin     *assets.Wei
+	BlobPriceMax *assets.Wei
 
 	LimitDefault    *uint64
 	LimitMax        *uint64
@@ -663,6 +752,7 @@ type GasEstimator struct {
 
 	BlockHistory BlockHistoryEstimator `toml:",omitempty"`
 	FeeHistory   FeeHistoryEstimator   `toml:",omitempty"`
+	Composite    CompositeEstimator    `toml:",omitempty"`
 	DAOracle     DAOracle              `toml:",omitempty"`
 }
 
@@ -696,10 +786,13 @@ func (e *GasEstimator) ValidateConfig() (err error) {
 		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "PriceMax", Value: e.PriceMin,
 			Msg: "must be greater than or equal to PriceDefault"})
 	}
-	if *e.Mode == "BlockHistory" && *e.BlockHistory.BlockHistorySize <= 0 {
+	if (*e.Mode == "BlockHistory" || (*e.Mode == "Composite" && slices.Contains(e.Composite.Estimators, "BlockHistory"))) && *e.BlockHistory.BlockHistorySize <= 0 {
 		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "BlockHistory.BlockHistorySize", Value: *e.BlockHistory.BlockHistorySize,
 			Msg: "must be greater than or equal to 1 with BlockHistory Mode"})
 	}
+	if *e.Mode == "Composite" {
+		err = multierr.Append(err, e.Composite.validate())
+	}
 
 	return
 }
@@ -756,9 +849,13 @@ func (e *GasEstimator) setFrom(f *GasEstimator) {
 	if v := f.PriceMin; v != nil {
 		e.PriceMin = v
 	}
+	if v := f.BlobPriceMax; v != nil {
+		e.BlobPriceMax = v
+	}
 	e.LimitJobType.setFrom(&f.LimitJobType)
 	e.BlockHistory.setFrom(&f.BlockHistory)
 	e.FeeHistory.setFrom(&f.FeeHistory)
+	e.Composite.setFrom(&f.Composite)
 	e.DAOracle.setFrom(&f.DAOracle)
 }
 
@@ -832,6 +929,72 @@ func (u *FeeHistoryEstimator) setFrom(f *FeeHistoryEstimator) {
 	}
 }
 
+type CompositeEstimator struct {
+	Estimators     []string `toml:",omitempty"`
+	Policy         *string  `toml:",omitempty"`
+	OutlierPercent *uint16  `toml:",omitempty"`
+	Weights        []uint32 `toml:",omitempty"`
+}
+
+func (c *CompositeEstimator) setFrom(f *CompositeEstimator) {
+	if v := f.Estimators; v != nil {
+		c.Estimators = v
+	}
+	if v := f.Policy; v != nil {
+		c.Policy = v
+	}
+	if v := f.OutlierPercent; v != nil {
+		c.OutlierPercent = v
+	}
+	if v := f.Weights; v != nil {
+		c.Weights = v
+	}
+}
+
+// validate is only called when the Composite Mode is selected, since the section is optional otherwise.
+func (c *CompositeEstimator) validate() (err error) {
+	if len(c.Estimators) < 2 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Composite.Estimators", Value: c.Estimators,
+			Msg: "must list at least two estimators with Composite Mode"})
+	}
+	for i, estimator := range c.Estimators {
+		switch estimator {
+		case "Arbitrum", "BlockHistory", "FeeHistory", "FixedPrice", "L2Suggested", "SuggestedPrice":
+		default:
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Composite.Estimators", Value: estimator,
+				Msg: "must be one of Arbitrum, BlockHistory, FeeHistory, FixedPrice, L2Suggested or SuggestedPrice"})
+		}
+		if slices.Index(c.Estimators, estimator) != i {
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Composite.Estimators", Value: estimator,
+				Msg: "must not be listed twice"})
+		}
+	}
+	if c.Policy == nil {
+		err = multierr.Append(err, commonconfig.ErrMissing{Name: "Composite.Policy", Msg: "must be set with Composite Mode"})
+		return
+	}
+	switch *c.Policy {
+	case "Max", "Median":
+		if c.Weights != nil {
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Composite.Weights", Value: c.Weights,
+				Msg: "must only be set with the Weighted Policy"})
+		}
+	case "Weighted":
+		if len(c.Weights) != len(c.Estimators) {
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Composite.Weights", Value: c.Weights,
+				Msg: "must have one weight per estimator with the Weighted Policy"})
+		}
+		if slices.Contains(c.Weights, 0) {
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Composite.Weights", Value: c.Weights,
+				Msg: "must all be greater than 0"})
+		}
+	default:
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Composite.Policy", Value: *c.Policy,
+			Msg: "must be one of Max, Median or Weighted"})
+	}
+	return
+}
+
 type DAOracle struct {
 	OracleType             *DAOracleType
 	OracleAddress          *types.EIP55Address
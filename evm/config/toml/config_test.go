@@ -7,7 +7,9 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/config"
+	"github.com/smartcontractkit/chainlink/v2/evm/assets"
 	"github.com/smartcontractkit/chainlink/v2/evm/config/toml"
+	"github.com/smartcontractkit/chainlink/v2/evm/types"
 )
 
 func TestEVMConfig_ValidateConfig(t *testing.T) {
@@ -28,3 +30,65 @@ func TestEVMConfig_ValidateConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestBalanceMonitor_ValidateConfig(t *testing.T) {
+	funder := types.MustEIP55Address("0x2a3e23c6f242F5345320814aC8a1b4E58707D292")
+
+	t.Run("valid", func(t *testing.T) {
+		assert.NoError(t, config.Validate(&toml.BalanceMonitor{
+			AlertThreshold:  assets.NewWeiI(1),
+			AlertWebhookURL: config.MustParseURL("https://alerts.test"),
+			FunderAddress:   &funder,
+			TopUpThreshold:  assets.NewWeiI(1),
+			TopUpTarget:     assets.NewWeiI(2),
+		}))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		err := config.Validate(&toml.BalanceMonitor{
+			AlertWebhookURL: config.MustParseURL("ftp://alerts.test"),
+			FunderAddress:   &funder,
+			TopUpThreshold:  assets.NewWeiI(2),
+			TopUpTarget:     assets.NewWeiI(2),
+		})
+		assert.ErrorContains(t, err, "AlertThreshold: missing: must be set if AlertWebhookURL is set")
+		assert.ErrorContains(t, err, "AlertWebhookURL: invalid value (ftp): must be http or https")
+		assert.ErrorContains(t, err, "TopUpTarget: invalid value (2 wei): must be greater than TopUpThreshold")
+	})
+}
+
+func TestGasEstimator_ValidateConfig_Composite(t *testing.T) {
+	newGasEstimator := func(composite toml.CompositeEstimator) *toml.GasEstimator {
+		ge := toml.Defaults(nil).GasEstimator
+		ge.Mode = ptr("Composite")
+		ge.Composite = composite
+		return &ge
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		assert.NoError(t, config.Validate(newGasEstimator(toml.CompositeEstimator{
+			Estimators:     []string{"BlockHistory", "SuggestedPrice"},
+			Policy:         ptr("Weighted"),
+			OutlierPercent: ptr[uint16](50),
+			Weights:        []uint32{2, 1},
+		})))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		err := config.Validate(newGasEstimator(toml.CompositeEstimator{
+			Estimators: []string{"SuggestedPrice", "Composite", "SuggestedPrice"},
+			Policy:     ptr("Weighted"),
+			Weights:    []uint32{1, 0},
+		}))
+		assert.ErrorContains(t, err, "Composite.Estimators: invalid value (Composite): must be one of Arbitrum, BlockHistory, FeeHistory, FixedPrice, L2Suggested or SuggestedPrice")
+		assert.ErrorContains(t, err, "Composite.Estimators: invalid value (SuggestedPrice): must not be listed twice")
+		assert.ErrorContains(t, err, "Composite.Weights: invalid value ([1 0]): must have one weight per estimator with the Weighted Policy")
+		assert.ErrorContains(t, err, "Composite.Weights: invalid value ([1 0]): must all be greater than 0")
+
+		err = config.Validate(newGasEstimator(toml.CompositeEstimator{Estimators: []string{"BlockHistory"}}))
+		assert.ErrorContains(t, err, "Composite.Estimators: invalid value ([BlockHistory]): must list at least two estimators with Composite Mode")
+		assert.ErrorContains(t, err, "Composite.Policy: missing: must be set with Composite Mode")
+	})
+}
+
+func ptr[T any](t T) *T { return &t }
@@ -0,0 +1,69 @@
+package gas
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/smartcontractkit/chainlink-framework/chains/fees"
+
+	"github.com/smartcontractkit/chainlink/v2/evm/assets"
+)
+
+// blobFeeCapMultiplier is applied to the blob base fee, so that the blob fee cap of new blob transactions
+// covers a few blocks of blob base fee increases, like the fee cap of dynamic fee transactions.
+const blobFeeCapMultiplier = 2
+
+// blobFeeBumpMultiplier is applied to the blob fee cap of replaced blob transactions, as the blob pool only
+// accepts replacements with all their fees bumped by at least 100%.
+const blobFeeBumpMultiplier = 2
+
+// GetBlobFee returns the max fee per blob gas of a new blob transaction, based on the current blob base fee.
+// The fee cap is capped to maxBlobFeeCap.
+func (e *evmFeeEstimator) GetBlobFee(ctx context.Context, maxBlobFeeCap *assets.Wei) (*assets.Wei, error) {
+	baseFee, err := e.blobBaseFee(ctx)
+	if err != nil {
+		return nil, err
+	}
+	feeCap := baseFee.Mul(big.NewInt(blobFeeCapMultiplier))
+	if maxBlobFeeCap != nil && feeCap.Cmp(maxBlobFeeCap) > 0 {
+		e.lggr.Warnw("Blob fee cap capped by max blob price", "blobBaseFee", baseFee, "blobFeeCap", feeCap, "maxBlobFeeCap", maxBlobFeeCap)
+		feeCap = maxBlobFeeCap
+	}
+	return feeCap, nil
+}
+
+// BumpBlobFee returns the max fee per blob gas of a blob transaction replacing one with the original blob fee cap.
+// The fee cap is at least doubled, and follows the current blob base fee if it increased even more.
+func (e *evmFeeEstimator) BumpBlobFee(ctx context.Context, originalBlobFeeCap, maxBlobFeeCap *assets.Wei) (*assets.Wei, error) {
+	if originalBlobFeeCap == nil {
+		return nil, fmt.Errorf("%w: original blob fee cap is missing", fees.ErrBump)
+	}
+	bumped := originalBlobFeeCap.Mul(big.NewInt(blobFeeBumpMultiplier))
+	baseFee, err := e.blobBaseFee(ctx)
+	if err != nil {
+		e.lggr.Warnw("Failed to fetch blob base fee, bumping blob fee cap from the original one", "err", err)
+	} else if current := baseFee.Mul(big.NewInt(blobFeeCapMultiplier)); current.Cmp(bumped) > 0 {
+		bumped = current
+	}
+	if maxBlobFeeCap != nil && bumped.Cmp(maxBlobFeeCap) > 0 {
+		return nil, fmt.Errorf("%w: bumped blob fee cap of %s would exceed configured max of %s", fees.ErrBumpFeeExceedsLimit, bumped, maxBlobFeeCap)
+	}
+	return bumped, nil
+}
+
+// blobBaseFee returns the blob base fee of the pending block.
+func (e *evmFeeEstimator) blobBaseFee(ctx context.Context) (*assets.Wei, error) {
+	var baseFee hexutil.Big
+	if err := e.ethClient.CallContext(ctx, &baseFee, "eth_blobBaseFee"); err != nil {
+		return nil, fmt.Errorf("failed to fetch blob base fee: %w", err)
+	}
+	fee := assets.NewWei(baseFee.ToInt())
+	if minFee := assets.NewWeiI(params.BlobTxMinBlobGasprice); fee.Cmp(minFee) < 0 {
+		return minFee, nil
+	}
+	return fee, nil
+}
@@ -0,0 +1,88 @@
+package gas_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-framework/chains/fees"
+
+	"github.com/smartcontractkit/chainlink/v2/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/evm/gas"
+	"github.com/smartcontractkit/chainlink/v2/evm/gas/mocks"
+)
+
+func TestEvmFeeEstimator_BlobFee(t *testing.T) {
+	t.Parallel()
+
+	newEstimator := func(t *testing.T, blobBaseFee int64) gas.EvmFeeEstimator {
+		client := mocks.NewFeeEstimatorClient(t)
+		client.On("CallContext", mock.Anything, mock.Anything, "eth_blobBaseFee").Return(nil).Run(func(args mock.Arguments) {
+			res := args.Get(1).(*hexutil.Big)
+			(*big.Int)(res).SetInt64(blobBaseFee)
+		})
+		getEst := func(logger.Logger) gas.EvmEstimator { return mocks.NewEvmEstimator(t) }
+		return gas.NewEvmFeeEstimator(logger.Test(t), getEst, true, gas.NewMockGasConfig(), client)
+	}
+
+	t.Run("GetBlobFee doubles the blob base fee", func(t *testing.T) {
+		fee, err := newEstimator(t, 100).GetBlobFee(tests.Context(t), assets.NewWeiI(1000))
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(200), fee)
+	})
+
+	t.Run("GetBlobFee caps the fee to the max", func(t *testing.T) {
+		fee, err := newEstimator(t, 100).GetBlobFee(tests.Context(t), assets.NewWeiI(150))
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(150), fee)
+	})
+
+	t.Run("GetBlobFee uses the min blob gas price", func(t *testing.T) {
+		fee, err := newEstimator(t, 0).GetBlobFee(tests.Context(t), assets.NewWeiI(1000))
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(2), fee)
+	})
+
+	t.Run("GetBlobFee fails if the blob base fee can't be fetched", func(t *testing.T) {
+		client := mocks.NewFeeEstimatorClient(t)
+		client.On("CallContext", mock.Anything, mock.Anything, "eth_blobBaseFee").Return(errors.New("method not found"))
+		getEst := func(logger.Logger) gas.EvmEstimator { return mocks.NewEvmEstimator(t) }
+		estimator := gas.NewEvmFeeEstimator(logger.Test(t), getEst, true, gas.NewMockGasConfig(), client)
+
+		_, err := estimator.GetBlobFee(tests.Context(t), assets.NewWeiI(1000))
+		require.ErrorContains(t, err, "method not found")
+	})
+
+	t.Run("BumpBlobFee doubles the original fee", func(t *testing.T) {
+		fee, err := newEstimator(t, 10).BumpBlobFee(tests.Context(t), assets.NewWeiI(100), assets.NewWeiI(1000))
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(200), fee)
+	})
+
+	t.Run("BumpBlobFee follows the blob base fee", func(t *testing.T) {
+		fee, err := newEstimator(t, 300).BumpBlobFee(tests.Context(t), assets.NewWeiI(100), assets.NewWeiI(1000))
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(600), fee)
+	})
+
+	t.Run("BumpBlobFee fails above the max", func(t *testing.T) {
+		_, err := newEstimator(t, 10).BumpBlobFee(tests.Context(t), assets.NewWeiI(600), assets.NewWeiI(1000))
+		require.ErrorIs(t, err, fees.ErrBumpFeeExceedsLimit)
+	})
+
+	t.Run("BumpBlobFee fails without original fee", func(t *testing.T) {
+		getEst := func(logger.Logger) gas.EvmEstimator { return mocks.NewEvmEstimator(t) }
+		estimator := gas.NewEvmFeeEstimator(logger.Test(t), getEst, true, gas.NewMockGasConfig(), mocks.NewFeeEstimatorClient(t))
+
+		_, err := estimator.BumpBlobFee(tests.Context(t), nil, assets.NewWeiI(1000))
+		require.ErrorIs(t, err, fees.ErrBump)
+	})
+}
@@ -0,0 +1,294 @@
+package gas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink-framework/chains/fees"
+
+	"github.com/smartcontractkit/chainlink/v2/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/evm/gas/rollups"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/evm/types"
+)
+
+var (
+	promCompositeEstimatorSourceFee = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "gas_composite_estimator_source_fee",
+		Help: "Latest fee (in Wei) estimated by each source of the composite estimator",
+	},
+		[]string{"evmChainID", "source", "fee"},
+	)
+	promCompositeEstimatorSourceErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "gas_composite_estimator_source_errors",
+		Help: "Number of times a source of the composite estimator failed to estimate a fee",
+	},
+		[]string{"evmChainID", "source"},
+	)
+	promCompositeEstimatorSourceOutliers = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "gas_composite_estimator_source_outliers",
+		Help: "Number of times a fee estimated by a source of the composite estimator was discarded as an outlier",
+	},
+		[]string{"evmChainID", "source", "fee"},
+	)
+	promCompositeEstimatorFee = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "gas_composite_estimator_fee",
+		Help: "Latest fee (in Wei) combined by the composite estimator",
+	},
+		[]string{"evmChainID", "fee"},
+	)
+)
+
+var _ EvmEstimator = &CompositeEstimator{}
+
+type compositeConfig interface {
+	Policy() string
+	OutlierPercent() uint16
+}
+
+// CompositeSource is one of the estimators queried by the CompositeEstimator.
+type CompositeSource struct {
+	Name      string
+	Weight    uint32 // only used by the Weighted policy
+	Estimator EvmEstimator
+}
+
+// CompositeEstimator queries several estimators and combines their fees according to a policy, so a single
+// misbehaving estimator or RPC can't spike or starve transactions:
+//   - Max uses the highest fee.
+//   - Median uses the median fee.
+//   - Weighted uses the average of the fees, weighted by the weight of their source.
+//
+// With OutlierPercent set, fees deviating from the median by more than this percentage are discarded before being
+// combined, as long as at least three sources returned a fee. Sources failing to estimate a fee are skipped.
+type CompositeEstimator struct {
+	services.StateMachine
+	lggr     logger.SugaredLogger
+	cfg      compositeConfig
+	chainID  string
+	sources  []CompositeSource
+	l1Oracle rollups.L1Oracle
+}
+
+// NewCompositeEstimator returns a new Estimator combining the fees of the given sources.
+func NewCompositeEstimator(lggr logger.Logger, cfg compositeConfig, chainID *big.Int, sources []CompositeSource, l1Oracle rollups.L1Oracle) EvmEstimator {
+	return &CompositeEstimator{
+		lggr:     logger.Sugared(logger.Named(lggr, "CompositeEstimator")),
+		cfg:      cfg,
+		chainID:  chainID.String(),
+		sources:  sources,
+		l1Oracle: l1Oracle,
+	}
+}
+
+func (c *CompositeEstimator) Name() string {
+	return c.lggr.Name()
+}
+
+func (c *CompositeEstimator) L1Oracle() rollups.L1Oracle {
+	return c.l1Oracle
+}
+
+func (c *CompositeEstimator) Start(ctx context.Context) error {
+	return c.StartOnce("CompositeEstimator", func() error {
+		var ms services.MultiStart
+		for _, source := range c.sources {
+			if err := ms.Start(ctx, source.Estimator); err != nil {
+				return fmt.Errorf("failed to start %s estimator: %w", source.Name, err)
+			}
+		}
+		return nil
+	})
+}
+
+func (c *CompositeEstimator) Close() error {
+	return c.StopOnce("CompositeEstimator", func() error {
+		closers := make([]services.Service, len(c.sources))
+		for i, source := range c.sources {
+			closers[i] = source.Estimator
+		}
+		return services.MultiCloser(closers).Close()
+	})
+}
+
+func (c *CompositeEstimator) HealthReport() map[string]error {
+	report := map[string]error{c.Name(): c.Healthy()}
+	for _, source := range c.sources {
+		services.CopyHealth(report, source.Estimator.HealthReport())
+	}
+	return report
+}
+
+func (c *CompositeEstimator) OnNewLongestChain(ctx context.Context, head *evmtypes.Head) {
+	for _, source := range c.sources {
+		source.Estimator.OnNewLongestChain(ctx, head)
+	}
+}
+
+func (c *CompositeEstimator) GetLegacyGas(ctx context.Context, calldata []byte, gasLimit uint64, maxGasPriceWei *assets.Wei, opts ...fees.Opt) (*assets.Wei, uint64, error) {
+	var gasPrices []compositeFee
+	var chainSpecificGasLimit uint64
+	var errs error
+	for _, source := range c.sources {
+		gasPrice, limit, err := source.Estimator.GetLegacyGas(ctx, calldata, gasLimit, maxGasPriceWei, opts...)
+		if err != nil {
+			errs = errors.Join(errs, c.sourceFailed(source, err))
+			continue
+		}
+		gasPrices = append(gasPrices, compositeFee{source, gasPrice})
+		chainSpecificGasLimit = max(chainSpecificGasLimit, limit)
+	}
+	if len(gasPrices) == 0 {
+		return nil, 0, fmt.Errorf("all estimators failed to estimate gas price: %w", errs)
+	}
+	return c.combine("gas_price", gasPrices), chainSpecificGasLimit, nil
+}
+
+func (c *CompositeEstimator) BumpLegacyGas(ctx context.Context, originalGasPrice *assets.Wei, gasLimit uint64, maxGasPriceWei *assets.Wei, attempts []EvmPriorAttempt) (*assets.Wei, uint64, error) {
+	var gasPrices []compositeFee
+	var chainSpecificGasLimit uint64
+	var errs error
+	for _, source := range c.sources {
+		gasPrice, limit, err := source.Estimator.BumpLegacyGas(ctx, originalGasPrice, gasLimit, maxGasPriceWei, attempts)
+		if errors.Is(err, fees.ErrConnectivity) {
+			// Any estimator detecting a connectivity issue halts bumping, as bumping wouldn't help.
+			return nil, 0, err
+		} else if err != nil {
+			errs = errors.Join(errs, c.sourceFailed(source, err))
+			continue
+		}
+		gasPrices = append(gasPrices, compositeFee{source, gasPrice})
+		chainSpecificGasLimit = max(chainSpecificGasLimit, limit)
+	}
+	if len(gasPrices) == 0 {
+		return nil, 0, fmt.Errorf("all estimators failed to bump gas price: %w", errs)
+	}
+	return c.combine("gas_price", gasPrices), chainSpecificGasLimit, nil
+}
+
+func (c *CompositeEstimator) GetDynamicFee(ctx context.Context, maxGasPriceWei *assets.Wei) (DynamicFee, error) {
+	var feeCaps, tipCaps []compositeFee
+	var errs error
+	for _, source := range c.sources {
+		fee, err := source.Estimator.GetDynamicFee(ctx, maxGasPriceWei)
+		if err != nil {
+			errs = errors.Join(errs, c.sourceFailed(source, err))
+			continue
+		}
+		feeCaps = append(feeCaps, compositeFee{source, fee.GasFeeCap})
+		tipCaps = append(tipCaps, compositeFee{source, fee.GasTipCap})
+	}
+	if len(feeCaps) == 0 {
+		return DynamicFee{}, fmt.Errorf("all estimators failed to estimate dynamic fee: %w", errs)
+	}
+	return c.combineDynamicFee(feeCaps, tipCaps), nil
+}
+
+func (c *CompositeEstimator) BumpDynamicFee(ctx context.Context, original DynamicFee, maxGasPriceWei *assets.Wei, attempts []EvmPriorAttempt) (DynamicFee, error) {
+	var feeCaps, tipCaps []compositeFee
+	var errs error
+	for _, source := range c.sources {
+		fee, err := source.Estimator.BumpDynamicFee(ctx, original, maxGasPriceWei, attempts)
+		if errors.Is(err, fees.ErrConnectivity) {
+			// Any estimator detecting a connectivity issue halts bumping, as bumping wouldn't help.
+			return DynamicFee{}, err
+		} else if err != nil {
+			errs = errors.Join(errs, c.sourceFailed(source, err))
+			continue
+		}
+		feeCaps = append(feeCaps, compositeFee{source, fee.GasFeeCap})
+		tipCaps = append(tipCaps, compositeFee{source, fee.GasTipCap})
+	}
+	if len(feeCaps) == 0 {
+		return DynamicFee{}, fmt.Errorf("all estimators failed to bump dynamic fee: %w", errs)
+	}
+	return c.combineDynamicFee(feeCaps, tipCaps), nil
+}
+
+func (c *CompositeEstimator) sourceFailed(source CompositeSource, err error) error {
+	promCompositeEstimatorSourceErrors.WithLabelValues(c.chainID, source.Name).Inc()
+	c.lggr.Debugw("Estimator failed to estimate fee", "source", source.Name, "err", err)
+	return fmt.Errorf("%s: %w", source.Name, err)
+}
+
+// combineDynamicFee combines fee and tip caps separately, since sources may disagree on either of them.
+func (c *CompositeEstimator) combineDynamicFee(feeCaps, tipCaps []compositeFee) DynamicFee {
+	fee := DynamicFee{
+		GasFeeCap: c.combine("fee_cap", feeCaps),
+		GasTipCap: c.combine("tip_cap", tipCaps),
+	}
+	if fee.GasTipCap.Cmp(fee.GasFeeCap) > 0 {
+		fee.GasTipCap = fee.GasFeeCap
+	}
+	return fee
+}
+
+type compositeFee struct {
+	source CompositeSource
+	value  *assets.Wei
+}
+
+// combine discards the outliers among the estimates, and combines the remaining ones according to the policy.
+func (c *CompositeEstimator) combine(name string, estimates []compositeFee) *assets.Wei {
+	for _, e := range estimates {
+		promCompositeEstimatorSourceFee.WithLabelValues(c.chainID, e.source.Name, name).Set(float64(e.value.Int64()))
+	}
+
+	if pct := c.cfg.OutlierPercent(); pct > 0 && len(estimates) >= 3 {
+		median := medianFee(estimates)
+		maxDeviation := new(big.Int).Div(median.Mul(big.NewInt(int64(pct))).ToInt(), big.NewInt(100))
+		estimates = slices.DeleteFunc(slices.Clone(estimates), func(e compositeFee) bool {
+			deviation := new(big.Int).Abs(e.value.Sub(median).ToInt())
+			if deviation.Cmp(maxDeviation) <= 0 {
+				return false
+			}
+			promCompositeEstimatorSourceOutliers.WithLabelValues(c.chainID, e.source.Name, name).Inc()
+			c.lggr.Warnw("Discarding outlier fee", "source", e.source.Name, "fee", name, "value", e.value, "median", median)
+			return true
+		})
+	}
+
+	var combined *assets.Wei
+	switch c.cfg.Policy() {
+	case "Max":
+		combined = slices.MaxFunc(estimates, func(a, b compositeFee) int { return a.value.Cmp(b.value) }).value
+	case "Weighted":
+		sum, weights := new(big.Int), new(big.Int)
+		for _, e := range estimates {
+			weight := new(big.Int).SetUint64(uint64(e.source.Weight))
+			sum.Add(sum, new(big.Int).Mul(e.value.ToInt(), weight))
+			weights.Add(weights, weight)
+		}
+		if weights.Sign() == 0 {
+			combined = medianFee(estimates)
+			break
+		}
+		combined = assets.NewWei(sum.Div(sum, weights))
+	default:
+		combined = medianFee(estimates)
+	}
+	promCompositeEstimatorFee.WithLabelValues(c.chainID, name).Set(float64(combined.Int64()))
+	return combined
+}
+
+// medianFee returns the median of the estimates, averaging the two middle ones for an even number of estimates.
+func medianFee(estimates []compositeFee) *assets.Wei {
+	values := make([]*assets.Wei, len(estimates))
+	for i, e := range estimates {
+		values[i] = e.value
+	}
+	slices.SortFunc(values, func(a, b *assets.Wei) int { return a.Cmp(b) })
+	mid := len(values) / 2
+	if len(values)%2 == 1 {
+		return values[mid]
+	}
+	return assets.NewWei(new(big.Int).Div(values[mid-1].Add(values[mid]).ToInt(), big.NewInt(2)))
+}
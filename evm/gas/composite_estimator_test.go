@@ -0,0 +1,107 @@
+package gas_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-framework/chains/fees"
+
+	"github.com/smartcontractkit/chainlink/v2/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/evm/gas"
+	"github.com/smartcontractkit/chainlink/v2/evm/gas/mocks"
+	"github.com/smartcontractkit/chainlink/v2/evm/testutils"
+)
+
+type compositeConfig struct {
+	policy         string
+	outlierPercent uint16
+}
+
+func (c *compositeConfig) Policy() string         { return c.policy }
+func (c *compositeConfig) OutlierPercent() uint16 { return c.outlierPercent }
+
+func Test_CompositeEstimator(t *testing.T) {
+	t.Parallel()
+
+	maxGasPrice := assets.NewWeiI(1000)
+	// newSources returns sources estimating the given gas prices and dynamic fees, with increasing weights
+	newSources := func(t *testing.T, prices ...int64) []gas.CompositeSource {
+		sources := make([]gas.CompositeSource, len(prices))
+		for i, price := range prices {
+			estimator := mocks.NewEvmEstimator(t)
+			estimator.On("GetLegacyGas", mock.Anything, mock.Anything, uint64(10), maxGasPrice).Return(assets.NewWeiI(price), uint64(10), nil).Maybe()
+			estimator.On("GetDynamicFee", mock.Anything, maxGasPrice).Return(gas.DynamicFee{GasFeeCap: assets.NewWeiI(price), GasTipCap: assets.NewWeiI(price / 2)}, nil).Maybe()
+			sources[i] = gas.CompositeSource{Name: string(rune('a' + i)), Weight: uint32(i + 1), Estimator: estimator}
+		}
+		return sources
+	}
+
+	for _, tc := range []struct {
+		policy         string
+		outlierPercent uint16
+		prices         []int64
+		expected       int64
+	}{
+		{"Max", 0, []int64{10, 30, 20}, 30},
+		{"Median", 0, []int64{10, 30, 20}, 20},
+		{"Median", 0, []int64{10, 30, 20, 40}, 25},
+		{"Weighted", 0, []int64{10, 30, 20}, (10*1 + 30*2 + 20*3) / 6},
+		{"Max", 50, []int64{10, 12, 11, 900}, 12},
+		{"Max", 50, []int64{10, 900}, 900}, // outliers can't be detected with less than 3 estimates
+	} {
+		t.Run(tc.policy, func(t *testing.T) {
+			cfg := &compositeConfig{policy: tc.policy, outlierPercent: tc.outlierPercent}
+			estimator := gas.NewCompositeEstimator(logger.Test(t), cfg, testutils.FixtureChainID, newSources(t, tc.prices...), nil)
+
+			gasPrice, gasLimit, err := estimator.GetLegacyGas(tests.Context(t), nil, 10, maxGasPrice)
+			require.NoError(t, err)
+			assert.Equal(t, assets.NewWeiI(tc.expected), gasPrice)
+			assert.Equal(t, uint64(10), gasLimit)
+
+			fee, err := estimator.GetDynamicFee(tests.Context(t), maxGasPrice)
+			require.NoError(t, err)
+			assert.Equal(t, assets.NewWeiI(tc.expected), fee.GasFeeCap)
+		})
+	}
+
+	t.Run("skips failing sources", func(t *testing.T) {
+		sources := newSources(t, 10, 20)
+		failing := mocks.NewEvmEstimator(t)
+		failing.On("GetLegacyGas", mock.Anything, mock.Anything, uint64(10), maxGasPrice).Return(nil, uint64(0), errors.New("rpc down"))
+		sources = append(sources, gas.CompositeSource{Name: "failing", Estimator: failing})
+		estimator := gas.NewCompositeEstimator(logger.Test(t), &compositeConfig{policy: "Max"}, testutils.FixtureChainID, sources, nil)
+
+		gasPrice, _, err := estimator.GetLegacyGas(tests.Context(t), nil, 10, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(20), gasPrice)
+	})
+
+	t.Run("fails if all sources fail", func(t *testing.T) {
+		failing := mocks.NewEvmEstimator(t)
+		failing.On("GetDynamicFee", mock.Anything, maxGasPrice).Return(gas.DynamicFee{}, errors.New("rpc down"))
+		estimator := gas.NewCompositeEstimator(logger.Test(t), &compositeConfig{policy: "Median"}, testutils.FixtureChainID,
+			[]gas.CompositeSource{{Name: "failing", Estimator: failing}}, nil)
+
+		_, err := estimator.GetDynamicFee(tests.Context(t), maxGasPrice)
+		require.ErrorContains(t, err, "failing: rpc down")
+	})
+
+	t.Run("halts bumping on connectivity issues", func(t *testing.T) {
+		sources := newSources(t, 10)
+		sources[0].Estimator.(*mocks.EvmEstimator).On("BumpLegacyGas", mock.Anything, assets.NewWeiI(10), uint64(10), maxGasPrice, mock.Anything).Return(assets.NewWeiI(20), uint64(10), nil)
+		stuck := mocks.NewEvmEstimator(t)
+		stuck.On("BumpLegacyGas", mock.Anything, assets.NewWeiI(10), uint64(10), maxGasPrice, mock.Anything).Return(nil, uint64(0), fees.ErrConnectivity)
+		sources = append(sources, gas.CompositeSource{Name: "stuck", Estimator: stuck})
+		estimator := gas.NewCompositeEstimator(logger.Test(t), &compositeConfig{policy: "Max"}, testutils.FixtureChainID, sources, nil)
+
+		_, _, err := estimator.BumpLegacyGas(tests.Context(t), assets.NewWeiI(10), 10, maxGasPrice, nil)
+		require.ErrorIs(t, err, fees.ErrConnectivity)
+	})
+}
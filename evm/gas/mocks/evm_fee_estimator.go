@@ -35,6 +35,66 @@ func (_m *EvmFeeEstimator) EXPECT() *EvmFeeEstimator_Expecter {
 	return &EvmFeeEstimator_Expecter{mock: &_m.Mock}
 }
 
+// BumpBlobFee provides a mock function with given fields: ctx, originalBlobFeeCap, maxBlobFeeCap
+func (_m *EvmFeeEstimator) BumpBlobFee(ctx context.Context, originalBlobFeeCap *assets.Wei, maxBlobFeeCap *assets.Wei) (*assets.Wei, error) {
+	ret := _m.Called(ctx, originalBlobFeeCap, maxBlobFeeCap)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BumpBlobFee")
+	}
+
+	var r0 *assets.Wei
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *assets.Wei, *assets.Wei) (*assets.Wei, error)); ok {
+		return rf(ctx, originalBlobFeeCap, maxBlobFeeCap)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *assets.Wei, *assets.Wei) *assets.Wei); ok {
+		r0 = rf(ctx, originalBlobFeeCap, maxBlobFeeCap)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*assets.Wei)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *assets.Wei, *assets.Wei) error); ok {
+		r1 = rf(ctx, originalBlobFeeCap, maxBlobFeeCap)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EvmFeeEstimator_BumpBlobFee_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BumpBlobFee'
+type EvmFeeEstimator_BumpBlobFee_Call struct {
+	*mock.Call
+}
+
+// BumpBlobFee is a helper method to define mock.On call
+//   - ctx context.Context
+//   - originalBlobFeeCap *assets.Wei
+//   - maxBlobFeeCap *assets.Wei
+func (_e *EvmFeeEstimator_Expecter) BumpBlobFee(ctx interface{}, originalBlobFeeCap interface{}, maxBlobFeeCap interface{}) *EvmFeeEstimator_BumpBlobFee_Call {
+	return &EvmFeeEstimator_BumpBlobFee_Call{Call: _e.mock.On("BumpBlobFee", ctx, originalBlobFeeCap, maxBlobFeeCap)}
+}
+
+func (_c *EvmFeeEstimator_BumpBlobFee_Call) Run(run func(ctx context.Context, originalBlobFeeCap *assets.Wei, maxBlobFeeCap *assets.Wei)) *EvmFeeEstimator_BumpBlobFee_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*assets.Wei), args[2].(*assets.Wei))
+	})
+	return _c
+}
+
+func (_c *EvmFeeEstimator_BumpBlobFee_Call) Return(_a0 *assets.Wei, _a1 error) *EvmFeeEstimator_BumpBlobFee_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EvmFeeEstimator_BumpBlobFee_Call) RunAndReturn(run func(context.Context, *assets.Wei, *assets.Wei) (*assets.Wei, error)) *EvmFeeEstimator_BumpBlobFee_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // BumpFee provides a mock function with given fields: ctx, originalFee, feeLimit, maxFeePrice, attempts
 func (_m *EvmFeeEstimator) BumpFee(ctx context.Context, originalFee gas.EvmFee, feeLimit uint64, maxFeePrice *assets.Wei, attempts []gas.EvmPriorAttempt) (gas.EvmFee, uint64, error) {
 	ret := _m.Called(ctx, originalFee, feeLimit, maxFeePrice, attempts)
@@ -147,6 +207,65 @@ func (_c *EvmFeeEstimator_Close_Call) RunAndReturn(run func() error) *EvmFeeEsti
 	return _c
 }
 
+// GetBlobFee provides a mock function with given fields: ctx, maxBlobFeeCap
+func (_m *EvmFeeEstimator) GetBlobFee(ctx context.Context, maxBlobFeeCap *assets.Wei) (*assets.Wei, error) {
+	ret := _m.Called(ctx, maxBlobFeeCap)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlobFee")
+	}
+
+	var r0 *assets.Wei
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *assets.Wei) (*assets.Wei, error)); ok {
+		return rf(ctx, maxBlobFeeCap)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *assets.Wei) *assets.Wei); ok {
+		r0 = rf(ctx, maxBlobFeeCap)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*assets.Wei)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *assets.Wei) error); ok {
+		r1 = rf(ctx, maxBlobFeeCap)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EvmFeeEstimator_GetBlobFee_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBlobFee'
+type EvmFeeEstimator_GetBlobFee_Call struct {
+	*mock.Call
+}
+
+// GetBlobFee is a helper method to define mock.On call
+//   - ctx context.Context
+//   - maxBlobFeeCap *assets.Wei
+func (_e *EvmFeeEstimator_Expecter) GetBlobFee(ctx interface{}, maxBlobFeeCap interface{}) *EvmFeeEstimator_GetBlobFee_Call {
+	return &EvmFeeEstimator_GetBlobFee_Call{Call: _e.mock.On("GetBlobFee", ctx, maxBlobFeeCap)}
+}
+
+func (_c *EvmFeeEstimator_GetBlobFee_Call) Run(run func(ctx context.Context, maxBlobFeeCap *assets.Wei)) *EvmFeeEstimator_GetBlobFee_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*assets.Wei))
+	})
+	return _c
+}
+
+func (_c *EvmFeeEstimator_GetBlobFee_Call) Return(_a0 *assets.Wei, _a1 error) *EvmFeeEstimator_GetBlobFee_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EvmFeeEstimator_GetBlobFee_Call) RunAndReturn(run func(context.Context, *assets.Wei) (*assets.Wei, error)) *EvmFeeEstimator_GetBlobFee_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetFee provides a mock function with given fields: ctx, calldata, feeLimit, maxFeePrice, fromAddress, toAddress, opts
 func (_m *EvmFeeEstimator) GetFee(ctx context.Context, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, fromAddress *common.Address, toAddress *common.Address, opts ...fees.Opt) (gas.EvmFee, uint64, error) {
 	_va := make([]interface{}, len(opts))
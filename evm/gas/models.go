@@ -40,6 +40,11 @@ type EvmFeeEstimator interface {
 
 	// GetMaxCost returns the total value = max price x fee units + transferred value
 	GetMaxCost(ctx context.Context, amount assets.Eth, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, fromAddress, toAddress *common.Address, opts ...fees.Opt) (*big.Int, error)
+
+	// GetBlobFee returns the max fee per blob gas of a new EIP-4844 blob transaction.
+	GetBlobFee(ctx context.Context, maxBlobFeeCap *assets.Wei) (*assets.Wei, error)
+	// BumpBlobFee returns the max fee per blob gas of a blob transaction replacing one with the original blob fee cap.
+	BumpBlobFee(ctx context.Context, originalBlobFeeCap, maxBlobFeeCap *assets.Wei) (*assets.Wei, error)
 }
 
 type feeEstimatorClient interface {
@@ -87,7 +92,36 @@ func NewEstimator(lggr logger.Logger, ethClient feeEstimatorClient, chaintype ch
 	}
 
 	var newEstimator func(logger.Logger) EvmEstimator
-	switch s {
+	if s == "Composite" {
+		composite := geCfg.Composite()
+		names, weights := composite.Estimators(), composite.Weights()
+		newSources := make([]func(logger.Logger) EvmEstimator, len(names))
+		for i, name := range names {
+			if newSources[i], err = newModeEstimator(lggr, name, ethClient, chaintype, chainID, geCfg, l1Oracle); err != nil {
+				return nil, err
+			}
+		}
+		newEstimator = func(l logger.Logger) EvmEstimator {
+			sources := make([]CompositeSource, len(names))
+			for i, name := range names {
+				sources[i] = CompositeSource{Name: name, Estimator: newSources[i](l)}
+				if i < len(weights) {
+					sources[i].Weight = weights[i]
+				}
+			}
+			return NewCompositeEstimator(lggr, composite, chainID, sources, l1Oracle)
+		}
+	} else if newEstimator, err = newModeEstimator(lggr, s, ethClient, chaintype, chainID, geCfg, l1Oracle); err != nil {
+		return nil, err
+	}
+	return NewEvmFeeEstimator(lggr, newEstimator, df, geCfg, ethClient), nil
+}
+
+// newModeEstimator returns a constructor of the estimator of the given mode.
+func newModeEstimator(lggr logger.Logger, mode string, ethClient feeEstimatorClient, chaintype chaintype.ChainType, chainID *big.Int, geCfg evmconfig.GasEstimator, l1Oracle rollups.L1Oracle) (func(logger.Logger) EvmEstimator, error) {
+	bh := geCfg.BlockHistory()
+	var newEstimator func(logger.Logger) EvmEstimator
+	switch mode {
 	case "Arbitrum":
 		arbOracle, err := rollups.NewArbitrumL1GasOracle(lggr, ethClient)
 		if err != nil {
@@ -121,12 +155,12 @@ func NewEstimator(lggr logger.Logger, ethClient feeEstimatorClient, chaintype ch
 		}
 
 	default:
-		lggr.Warnf("GasEstimator: unrecognised mode '%s', falling back to FixedPriceEstimator", s)
+		lggr.Warnf("GasEstimator: unrecognised mode '%s', falling back to FixedPriceEstimator", mode)
 		newEstimator = func(l logger.Logger) EvmEstimator {
 			return NewFixedPriceEstimator(geCfg, ethClient, bh, lggr, l1Oracle)
 		}
 	}
-	return NewEvmFeeEstimator(lggr, newEstimator, df, geCfg, ethClient), nil
+	return newEstimator, nil
 }
 
 // DynamicFee encompasses both FeeCap and TipCap for EIP1559 transactions
@@ -176,9 +210,14 @@ var _ fees.Fee = (*EvmFee)(nil)
 type EvmFee struct {
 	GasPrice *assets.Wei
 	DynamicFee
+	// BlobFeeCap is the max fee per blob gas, only set for EIP-4844 blob transactions.
+	BlobFeeCap *assets.Wei
 }
 
 func (fee EvmFee) String() string {
+	if fee.BlobFeeCap != nil {
+		return fmt.Sprintf("{GasPrice: %s, GasFeeCap: %s, GasTipCap: %s, BlobFeeCap: %s}", fee.GasPrice, fee.GasFeeCap, fee.GasTipCap, fee.BlobFeeCap)
+	}
 	return fmt.Sprintf("{GasPrice: %s, GasFeeCap: %s, GasTipCap: %s}", fee.GasPrice, fee.GasFeeCap, fee.GasTipCap)
 }
 
@@ -1,10 +1,15 @@
 package monitor
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"math"
 	"math/big"
+	"net/http"
+	"net/url"
+	"slices"
 	"sync"
 	"time"
 
@@ -15,11 +20,16 @@ import (
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils"
 	"github.com/smartcontractkit/chainlink-framework/chains/headtracker"
+	"github.com/smartcontractkit/chainlink-framework/chains/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink-framework/chains/txmgr/types"
 
 	"github.com/smartcontractkit/chainlink/v2/evm/assets"
 	evmclient "github.com/smartcontractkit/chainlink/v2/evm/client"
+	"github.com/smartcontractkit/chainlink/v2/evm/config"
+	"github.com/smartcontractkit/chainlink/v2/evm/gas"
 	"github.com/smartcontractkit/chainlink/v2/evm/keystore"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/evm/types"
 )
@@ -33,6 +43,21 @@ type (
 		services.Service
 	}
 
+	// Config is the subset of the chain config used by the BalanceMonitor.
+	Config interface {
+		BalanceMonitor() config.BalanceMonitor
+		GasEstimator() config.GasEstimator
+	}
+
+	// NativeTokenSender sends native tokens between keys, and tracks the status of the transfers
+	// by idempotency key as well as by sender and recipient. It is implemented by the transaction
+	// manager.
+	NativeTokenSender interface {
+		CreateTransaction(ctx context.Context, txRequest txmgrtypes.TxRequest[common.Address, common.Hash]) (txmgrtypes.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error)
+		GetTransactionStatus(ctx context.Context, transactionID string) (commontypes.TransactionStatus, error)
+		HasUnconfirmedTransfer(ctx context.Context, fromAddress, toAddress common.Address) (bool, error)
+	}
+
 	balanceMonitor struct {
 		services.Service
 		eng *services.Engine
@@ -41,25 +66,43 @@ type (
 		chainID        *big.Int
 		chainIDStr     string
 		ethKeyStore    keystore.Eth
+		cfg            Config
+		sender         NativeTokenSender
+		httpClient     *http.Client
 		ethBalances    map[common.Address]*assets.Eth
+		lowBalances    map[common.Address]bool
 		ethBalancesMtx sync.RWMutex
 		sleeperTask    *utils.SleeperTask
 	}
 
+	// LowBalanceAlert is POSTed to the AlertWebhookURL when a key drops below the AlertThreshold.
+	LowBalanceAlert struct {
+		ChainID      string    `json:"chainID"`
+		Address      string    `json:"address"`
+		BalanceWei   string    `json:"balanceWei"`
+		ThresholdWei string    `json:"thresholdWei"`
+		Timestamp    time.Time `json:"timestamp"`
+	}
+
 	NullBalanceMonitor struct{}
 )
 
 var _ BalanceMonitor = (*balanceMonitor)(nil)
 
-// NewBalanceMonitor returns a new balanceMonitor
-func NewBalanceMonitor(ethClient evmclient.Client, ethKeyStore keystore.Eth, lggr logger.Logger) *balanceMonitor {
+// NewBalanceMonitor returns a new balanceMonitor. The sender is only used to top up keys if a
+// FunderAddress is configured.
+func NewBalanceMonitor(ethClient evmclient.Client, ethKeyStore keystore.Eth, cfg Config, sender NativeTokenSender, lggr logger.Logger) *balanceMonitor {
 	chainId := ethClient.ConfiguredChainID()
 	bm := &balanceMonitor{
 		ethClient:   ethClient,
 		chainID:     chainId,
 		chainIDStr:  chainId.String(),
 		ethKeyStore: ethKeyStore,
+		cfg:         cfg,
+		sender:      sender,
+		httpClient:  &http.Client{Timeout: alertWebhookTimeout},
 		ethBalances: make(map[common.Address]*assets.Eth),
+		lowBalances: make(map[common.Address]bool),
 	}
 	bm.Service, bm.eng = services.Config{
 		Name:  "BalanceMonitor",
@@ -90,7 +133,7 @@ func (bm *balanceMonitor) OnNewLongestChain(_ context.Context, _ *evmtypes.Head)
 	}
 }
 
-func (bm *balanceMonitor) updateBalance(ethBal assets.Eth, address common.Address) {
+func (bm *balanceMonitor) updateBalance(ctx context.Context, ethBal assets.Eth, address common.Address) {
 	bm.promUpdateEthBalance(&ethBal, address)
 
 	bm.ethBalancesMtx.Lock()
@@ -106,14 +149,187 @@ func (bm *balanceMonitor) updateBalance(ethBal assets.Eth, address common.Addres
 
 	if oldBal == nil {
 		lgr.Infof("ETH balance for %s: %s", address.Hex(), ethBal.String())
+	} else if ethBal.Cmp(oldBal) != 0 {
+		lgr.Infof("New ETH balance for %s: %s", address.Hex(), ethBal.String())
+	}
+
+	bm.checkLowBalance(ctx, &ethBal, address)
+}
+
+// Timeout of the requests to the AlertWebhookURL
+const alertWebhookTimeout = 10 * time.Second
+
+// checkLowBalance alerts once each time the balance of a key drops below the AlertThreshold.
+// The key degrades the health of the BalanceMonitor until its balance recovers.
+func (bm *balanceMonitor) checkLowBalance(ctx context.Context, balance *assets.Eth, address common.Address) {
+	threshold := bm.cfg.BalanceMonitor().AlertThreshold()
+	if threshold == nil {
 		return
 	}
+	condition := "LowBalance-" + address.Hex()
 
-	if ethBal.Cmp(oldBal) != 0 {
-		lgr.Infof("New ETH balance for %s: %s", address.Hex(), ethBal.String())
+	bm.ethBalancesMtx.Lock()
+	wasLow := bm.lowBalances[address]
+	isLow := balance.ToInt().Cmp(threshold.ToInt()) < 0
+	if isLow {
+		bm.lowBalances[address] = true
+	} else {
+		delete(bm.lowBalances, address)
+	}
+	bm.ethBalancesMtx.Unlock()
+
+	if !isLow {
+		if wasLow {
+			bm.eng.ClearHealthCond(condition)
+			bm.eng.Infow("BalanceMonitor: balance recovered above alert threshold", "address", address, "ethBalance", balance.String())
+		}
+		return
+	}
+
+	bm.eng.SetHealthCond(condition, fmt.Errorf("balance of %s is %s, below the alert threshold of %s", address.Hex(), balance.String(), threshold.String()))
+	if wasLow {
+		return
+	}
+	bm.eng.Criticalw(fmt.Sprintf("BalanceMonitor: balance of key %s is below the alert threshold", address.Hex()),
+		"address", address,
+		"ethBalance", balance.String(),
+		"threshold", threshold.String(),
+	)
+
+	if u := bm.cfg.BalanceMonitor().AlertWebhookURL(); u != nil {
+		alert := LowBalanceAlert{
+			ChainID:      bm.chainIDStr,
+			Address:      address.Hex(),
+			BalanceWei:   balance.ToInt().String(),
+			ThresholdWei: threshold.ToInt().String(),
+			Timestamp:    time.Now(),
+		}
+		if err := bm.postAlert(ctx, u, alert); err != nil {
+			bm.eng.Errorw("BalanceMonitor: failed to post low balance alert", "err", err, "address", address)
+		}
 	}
 }
 
+func (bm *balanceMonitor) postAlert(ctx context.Context, u *url.URL, alert LowBalanceAlert) error {
+	b, err := json.Marshal(alert)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := bm.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// Minimum interval between two top-ups of the same key, to leave enough time for the previous
+// one to be confirmed.
+const topUpCooldown = 10 * time.Minute
+
+// topUp transfers funds from the funder to each of the other keys below the TopUpThreshold, so
+// that they reach the TopUpTarget. Top-ups are created with an idempotency key per cooldown
+// window, so a key is topped up at most once per window, even across restarts, and not while
+// any transfer from the funder to it is still unconfirmed, however long it has been pending.
+func (bm *balanceMonitor) topUp(ctx context.Context, funder common.Address, addresses []common.Address) {
+	if bm.sender == nil {
+		return
+	}
+	if !slices.Contains(addresses, funder) {
+		bm.eng.Errorw("BalanceMonitor: funder key is not enabled for chain, skipping top-ups", "funder", funder, "evmChainID", bm.chainIDStr)
+		return
+	}
+	funderBal := bm.GetEthBalance(funder)
+	if funderBal == nil {
+		return
+	}
+	available := new(big.Int).Set(funderBal.ToInt())
+	threshold := bm.cfg.BalanceMonitor().TopUpThreshold().ToInt()
+	target := bm.cfg.BalanceMonitor().TopUpTarget().ToInt()
+	gasLimit := bm.cfg.GasEstimator().LimitTransfer()
+	// the gas of each top-up is reserved at the max gas price, as the funder pays for it on top
+	// of the amount
+	gasCost := new(big.Int).Mul(new(big.Int).SetUint64(gasLimit), bm.cfg.GasEstimator().PriceMax().ToInt())
+	window := time.Now().Unix() / int64(topUpCooldown/time.Second)
+
+	for _, address := range addresses {
+		if address == funder {
+			continue
+		}
+		bal := bm.GetEthBalance(address)
+		if bal == nil || bal.ToInt().Cmp(threshold) >= 0 {
+			continue
+		}
+		inFlight, err := bm.sender.HasUnconfirmedTransfer(ctx, funder, address)
+		if err != nil {
+			bm.eng.Errorw(fmt.Sprintf("BalanceMonitor: failed to look up unconfirmed top-ups of key %s", address.Hex()), "err", err, "funder", funder, "address", address)
+			continue
+		}
+		if inFlight {
+			continue
+		}
+		idempotencyKey := bm.topUpIdempotencyKey(funder, address, window)
+		if bm.topUpExists(ctx, idempotencyKey) {
+			continue
+		}
+
+		amount := new(big.Int).Sub(target, bal.ToInt())
+		cost := new(big.Int).Add(amount, gasCost)
+		if available.Cmp(cost) < 0 {
+			bm.eng.Errorw(fmt.Sprintf("BalanceMonitor: funder balance is too low to top up key %s", address.Hex()),
+				"funder", funder,
+				"address", address,
+				"funderBalance", (*assets.Eth)(available).String(),
+				"amount", (*assets.Eth)(amount).String(),
+				"maxGasCost", (*assets.Eth)(gasCost).String(),
+			)
+			continue
+		}
+		_, err = bm.sender.CreateTransaction(ctx, txmgrtypes.TxRequest[common.Address, common.Hash]{
+			IdempotencyKey: &idempotencyKey,
+			FromAddress:    funder,
+			ToAddress:      address,
+			EncodedPayload: []byte{},
+			Value:          *amount,
+			FeeLimit:       gasLimit,
+			Strategy:       txmgr.NewSendEveryStrategy(),
+		})
+		if err != nil {
+			bm.eng.Errorw(fmt.Sprintf("BalanceMonitor: failed to top up key %s", address.Hex()), "err", err, "funder", funder, "address", address)
+			continue
+		}
+		available.Sub(available, cost)
+		bm.eng.Infow(fmt.Sprintf("BalanceMonitor: topping up key %s", address.Hex()),
+			"funder", funder,
+			"address", address,
+			"ethBalance", bal.String(),
+			"amount", (*assets.Eth)(amount).String(),
+			"idempotencyKey", idempotencyKey,
+		)
+	}
+}
+
+// topUpIdempotencyKey identifies the top-up of a key by the funder during a cooldown window.
+func (bm *balanceMonitor) topUpIdempotencyKey(funder, address common.Address, window int64) string {
+	return fmt.Sprintf("balance-monitor-top-up-%s-%s-%s-%d", bm.chainIDStr, funder.Hex(), address.Hex(), window)
+}
+
+// topUpExists returns whether the top-up with the idempotency key was created.
+func (bm *balanceMonitor) topUpExists(ctx context.Context, idempotencyKey string) bool {
+	status, err := bm.sender.GetTransactionStatus(ctx, idempotencyKey)
+	// the transaction manager fails to find unknown idempotency keys, but also returns the error
+	// of failed transactions
+	return err == nil || status == commontypes.Fatal || status == commontypes.Failed
+}
+
 func (bm *balanceMonitor) GetEthBalance(address common.Address) *assets.Eth {
 	bm.ethBalancesMtx.RLock()
 	defer bm.ethBalancesMtx.RUnlock()
@@ -163,6 +379,10 @@ func (w *worker) Work(ctx context.Context) {
 		}(address)
 	}
 	wg.Wait()
+
+	if funder := w.bm.cfg.BalanceMonitor().FunderAddress(); funder != nil {
+		w.bm.topUp(ctx, funder.Address(), enabledAddresses)
+	}
 }
 
 // Approximately ETH block time
@@ -185,7 +405,7 @@ func (w *worker) checkAccountBalance(ctx context.Context, address common.Address
 		)
 	} else {
 		ethBal := assets.Eth(*bal)
-		w.bm.updateBalance(ethBal, address)
+		w.bm.updateBalance(ctx, ethBal, address)
 	}
 }
 
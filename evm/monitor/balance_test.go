@@ -2,7 +2,12 @@ package monitor_test
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -14,15 +19,22 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+	txmgrtypes "github.com/smartcontractkit/chainlink-framework/chains/txmgr/types"
 
 	"github.com/smartcontractkit/chainlink/v2/evm/assets"
 	"github.com/smartcontractkit/chainlink/v2/evm/client/clienttest"
+	"github.com/smartcontractkit/chainlink/v2/evm/config/toml"
+	"github.com/smartcontractkit/chainlink/v2/evm/gas"
 	ksmocks "github.com/smartcontractkit/chainlink/v2/evm/keystore/mocks"
 	"github.com/smartcontractkit/chainlink/v2/evm/monitor"
+	"github.com/smartcontractkit/chainlink/v2/evm/monitor/mocks"
 	"github.com/smartcontractkit/chainlink/v2/evm/testutils"
+	"github.com/smartcontractkit/chainlink/v2/evm/types"
 )
 
 var nilBigInt *big.Int
@@ -33,6 +45,14 @@ func newEthClientMock(t *testing.T) *clienttest.Client {
 	return mockEth
 }
 
+func newConfig(t *testing.T, fn func(c *toml.BalanceMonitor)) monitor.Config {
+	return testutils.NewTestChainScopedConfig(t, func(c *toml.EVMConfig) {
+		if fn != nil {
+			fn(&c.BalanceMonitor)
+		}
+	}).EVM()
+}
+
 func TestBalanceMonitor_Start(t *testing.T) {
 	t.Parallel()
 
@@ -44,7 +64,7 @@ func TestBalanceMonitor_Start(t *testing.T) {
 			Return([]common.Address{k0Addr, k1Addr}, nil)
 		ethClient := newEthClientMock(t)
 
-		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, logger.Test(t))
+		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, newConfig(t, nil), nil, logger.Test(t))
 
 		k0bal := big.NewInt(42)
 		k1bal := big.NewInt(43)
@@ -71,7 +91,7 @@ func TestBalanceMonitor_Start(t *testing.T) {
 			Return([]common.Address{k0Addr}, nil)
 		ethClient := newEthClientMock(t)
 
-		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, logger.Test(t))
+		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, newConfig(t, nil), nil, logger.Test(t))
 		k0bal := big.NewInt(42)
 
 		ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Once().Return(k0bal, nil)
@@ -90,7 +110,7 @@ func TestBalanceMonitor_Start(t *testing.T) {
 			Return([]common.Address{k0Addr}, nil)
 		ethClient := newEthClientMock(t)
 
-		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, logger.Test(t))
+		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, newConfig(t, nil), nil, logger.Test(t))
 		ctxCancelledAwaiter := testutils.NewAwaiter()
 
 		ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Once().Run(func(args mock.Arguments) {
@@ -119,7 +139,7 @@ func TestBalanceMonitor_Start(t *testing.T) {
 			Return([]common.Address{k0Addr}, nil)
 		ethClient := newEthClientMock(t)
 
-		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, logger.Test(t))
+		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, newConfig(t, nil), nil, logger.Test(t))
 
 		ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).
 			Once().
@@ -144,7 +164,7 @@ func TestBalanceMonitor_OnNewLongestChain_UpdatesBalance(t *testing.T) {
 			Return([]common.Address{k0Addr, k1Addr}, nil)
 		ethClient := newEthClientMock(t)
 
-		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, logger.Test(t))
+		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, newConfig(t, nil), nil, logger.Test(t))
 		k0bal := big.NewInt(42)
 		// Deliberately larger than a 64 bit unsigned integer to test overflow
 		k1bal := big.NewInt(0)
@@ -193,7 +213,7 @@ func TestBalanceMonitor_FewerRPCCallsWhenBehind(t *testing.T) {
 
 	ethClient := newEthClientMock(t)
 
-	bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, logger.Test(t))
+	bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, newConfig(t, nil), nil, logger.Test(t))
 	ethClient.On("BalanceAt", mock.Anything, mock.Anything, mock.Anything).
 		Once().
 		Return(big.NewInt(1), nil)
@@ -230,6 +250,121 @@ func TestBalanceMonitor_FewerRPCCallsWhenBehind(t *testing.T) {
 	assert.LessOrEqual(t, callCount.Load(), int32(1))
 }
 
+func TestBalanceMonitor_LowBalanceAlert(t *testing.T) {
+	t.Parallel()
+
+	alerts := make(chan monitor.LowBalanceAlert, 10)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var alert monitor.LowBalanceAlert
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&alert))
+		alerts <- alert
+	}))
+	t.Cleanup(srv.Close)
+	webhookURL, err := commonconfig.ParseURL(srv.URL)
+	require.NoError(t, err)
+
+	ethKeyStore := ksmocks.NewEth(t)
+	k0Addr := testutils.NewAddress()
+	ethKeyStore.On("EnabledAddressesForChain", mock.Anything, mock.Anything).
+		Return([]common.Address{k0Addr}, nil)
+	ethClient := newEthClientMock(t)
+
+	cfg := newConfig(t, func(c *toml.BalanceMonitor) {
+		c.AlertThreshold = assets.NewWeiI(100)
+		c.AlertWebhookURL = webhookURL
+	})
+	bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, cfg, nil, logger.Test(t))
+
+	ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Once().Return(big.NewInt(42), nil)
+	require.NoError(t, bm.Start(tests.Context(t)))
+	t.Cleanup(func() { assert.NoError(t, bm.Close()) })
+
+	alert := <-alerts
+	assert.Equal(t, k0Addr.Hex(), alert.Address)
+	assert.Equal(t, "42", alert.BalanceWei)
+	assert.Equal(t, "100", alert.ThresholdWei)
+	require.ErrorContains(t, bm.HealthReport()[bm.Name()], "below the alert threshold")
+
+	// still low, no new alert
+	ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Once().Return(big.NewInt(43), nil)
+	bm.OnNewLongestChain(tests.Context(t), testutils.Head(0))
+	<-bm.WorkDone()
+	assert.Empty(t, alerts)
+
+	// funded again
+	ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Once().Return(big.NewInt(142), nil)
+	bm.OnNewLongestChain(tests.Context(t), testutils.Head(1))
+	<-bm.WorkDone()
+	assert.NoError(t, bm.HealthReport()[bm.Name()])
+	assert.Empty(t, alerts)
+}
+
+func TestBalanceMonitor_TopUp(t *testing.T) {
+	t.Parallel()
+
+	ethKeyStore := ksmocks.NewEth(t)
+	funder := testutils.NewAddress()
+	k0Addr := testutils.NewAddress()
+	k1Addr := testutils.NewAddress()
+	k2Addr := testutils.NewAddress()
+	ethKeyStore.On("EnabledAddressesForChain", mock.Anything, mock.Anything).
+		Return([]common.Address{funder, k0Addr, k2Addr, k1Addr}, nil)
+	ethClient := newEthClientMock(t)
+
+	funderAddress := types.EIP55AddressFromAddress(funder)
+	cfg := newConfig(t, func(c *toml.BalanceMonitor) {
+		c.FunderAddress = &funderAddress
+		c.TopUpThreshold = assets.NewWeiI(100)
+		c.TopUpTarget = assets.NewWeiI(300)
+	})
+	gasLimit := cfg.GasEstimator().LimitTransfer()
+	gasCost := new(big.Int).Mul(new(big.Int).SetUint64(gasLimit), cfg.GasEstimator().PriceMax().ToInt())
+
+	// the sender tracks the top-ups it created by idempotency key and by recipient, like the
+	// transaction manager. k2 has a top-up still pending from an earlier cooldown window.
+	var created, unconfirmed sync.Map
+	unconfirmed.Store(k2Addr, true)
+	sender := mocks.NewNativeTokenSender(t)
+	sender.On("GetTransactionStatus", mock.Anything, mock.Anything).Maybe().
+		Return(func(_ context.Context, idempotencyKey string) (commontypes.TransactionStatus, error) {
+			if _, ok := created.Load(idempotencyKey); ok {
+				return commontypes.Pending, nil
+			}
+			return commontypes.Unknown, errors.New("not found")
+		})
+	sender.On("HasUnconfirmedTransfer", mock.Anything, funder, mock.Anything).
+		Return(func(_ context.Context, _, to common.Address) (bool, error) {
+			_, ok := unconfirmed.Load(to)
+			return ok, nil
+		})
+	sender.On("CreateTransaction", mock.Anything, mock.MatchedBy(func(req txmgrtypes.TxRequest[common.Address, common.Hash]) bool {
+		return req.FromAddress == funder && req.ToAddress == k0Addr && req.Value.Cmp(big.NewInt(260)) == 0 && req.FeeLimit == gasLimit && req.IdempotencyKey != nil
+	})).Once().Run(func(args mock.Arguments) {
+		req := args.Get(1).(txmgrtypes.TxRequest[common.Address, common.Hash])
+		created.Store(*req.IdempotencyKey, true)
+		unconfirmed.Store(req.ToAddress, true)
+	}).Return(txmgrtypes.Tx[*big.Int, common.Address, common.Hash, common.Hash, types.Nonce, gas.EvmFee]{}, nil)
+	bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, cfg, sender, logger.Test(t))
+
+	// the funder can pay for the top-ups of k0 and k2 and their gas, but not for k1's top-up as
+	// well. k2 isn't topped up again while its earlier top-up is pending.
+	funderBal := new(big.Int).Add(big.NewInt(480), new(big.Int).Mul(gasCost, big.NewInt(2)))
+	ethClient.On("BalanceAt", mock.Anything, funder, nilBigInt).Once().Return(funderBal, nil)
+	ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Once().Return(big.NewInt(40), nil)
+	ethClient.On("BalanceAt", mock.Anything, k1Addr, nilBigInt).Once().Return(big.NewInt(50), nil)
+	ethClient.On("BalanceAt", mock.Anything, k2Addr, nilBigInt).Once().Return(big.NewInt(90), nil)
+	require.NoError(t, bm.Start(tests.Context(t)))
+	t.Cleanup(func() { assert.NoError(t, bm.Close()) })
+
+	// the top-up of k0 is pending, so it isn't topped up twice
+	ethClient.On("BalanceAt", mock.Anything, funder, nilBigInt).Once().Return(big.NewInt(240), nil)
+	ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Once().Return(big.NewInt(40), nil)
+	ethClient.On("BalanceAt", mock.Anything, k1Addr, nilBigInt).Once().Return(big.NewInt(50), nil)
+	ethClient.On("BalanceAt", mock.Anything, k2Addr, nilBigInt).Once().Return(big.NewInt(90), nil)
+	bm.OnNewLongestChain(tests.Context(t), testutils.Head(0))
+	<-bm.WorkDone()
+}
+
 func Test_ApproximateFloat64(t *testing.T) {
 	t.Parallel()
 